@@ -19,16 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName                      = "/cosmos.distribution.v1beta1.Query/Params"
-	Query_ValidatorDistributionInfo_FullMethodName   = "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo"
-	Query_ValidatorOutstandingRewards_FullMethodName = "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards"
-	Query_ValidatorCommission_FullMethodName         = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
-	Query_ValidatorSlashes_FullMethodName            = "/cosmos.distribution.v1beta1.Query/ValidatorSlashes"
-	Query_DelegationRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
-	Query_DelegationTotalRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
-	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
-	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_Params_FullMethodName                           = "/cosmos.distribution.v1beta1.Query/Params"
+	Query_AllocationParams_FullMethodName                 = "/cosmos.distribution.v1beta1.Query/AllocationParams"
+	Query_ValidatorDistributionInfo_FullMethodName        = "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo"
+	Query_ValidatorOutstandingRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards"
+	Query_TotalOutstandingRewards_FullMethodName          = "/cosmos.distribution.v1beta1.Query/TotalOutstandingRewards"
+	Query_ValidatorsWithOutstandingRewards_FullMethodName = "/cosmos.distribution.v1beta1.Query/ValidatorsWithOutstandingRewards"
+	Query_ValidatorCommission_FullMethodName              = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
+	Query_ValidatorCurrentRewards_FullMethodName          = "/cosmos.distribution.v1beta1.Query/ValidatorCurrentRewards"
+	Query_ValidatorHistoricalRewards_FullMethodName       = "/cosmos.distribution.v1beta1.Query/ValidatorHistoricalRewards"
+	Query_ValidatorEstimatedRewardRate_FullMethodName     = "/cosmos.distribution.v1beta1.Query/ValidatorEstimatedRewardRate"
+	Query_ValidatorRewardsFrozen_FullMethodName           = "/cosmos.distribution.v1beta1.Query/ValidatorRewardsFrozen"
+	Query_ValidatorRewardMultiplier_FullMethodName        = "/cosmos.distribution.v1beta1.Query/ValidatorRewardMultiplier"
+	Query_ValidatorCommissionWithdrawals_FullMethodName   = "/cosmos.distribution.v1beta1.Query/ValidatorCommissionWithdrawals"
+	Query_ValidatorSlashes_FullMethodName                 = "/cosmos.distribution.v1beta1.Query/ValidatorSlashes"
+	Query_ValidatorSlashesByHeight_FullMethodName         = "/cosmos.distribution.v1beta1.Query/ValidatorSlashesByHeight"
+	Query_DelegationRewards_FullMethodName                = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
+	Query_DelegationRewardsProjection_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationRewardsProjection"
+	Query_DelegationTotalRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
+	Query_DelegatorValidators_FullMethodName              = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorWithdrawAddress_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
+	Query_DecimalPool_FullMethodName                      = "/cosmos.distribution.v1beta1.Query/DecimalPool"
+	Query_FeePoolCommunityPool_FullMethodName             = "/cosmos.distribution.v1beta1.Query/FeePoolCommunityPool"
+	Query_LastBlockFeesDistributed_FullMethodName         = "/cosmos.distribution.v1beta1.Query/LastBlockFeesDistributed"
+	Query_SimulateAllocation_FullMethodName               = "/cosmos.distribution.v1beta1.Query/SimulateAllocation"
+	Query_CommunityPool_FullMethodName                    = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_TotalCommunityFunds_FullMethodName              = "/cosmos.distribution.v1beta1.Query/TotalCommunityFunds"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,16 +53,53 @@ const (
 type QueryClient interface {
 	// Params queries params of the distribution module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AllocationParams queries the rates applied by the fee allocation, and the
+	// vote multiplier they derive.
+	AllocationParams(ctx context.Context, in *QueryAllocationParamsRequest, opts ...grpc.CallOption) (*QueryAllocationParamsResponse, error)
 	// ValidatorDistributionInfo queries validator commission and self-delegation rewards for validator
 	ValidatorDistributionInfo(ctx context.Context, in *QueryValidatorDistributionInfoRequest, opts ...grpc.CallOption) (*QueryValidatorDistributionInfoResponse, error)
 	// ValidatorOutstandingRewards queries rewards of a validator address.
 	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
+	// TotalOutstandingRewards queries the sum of the outstanding rewards of all
+	// validators, i.e. the rewards allocated but not yet withdrawn.
+	TotalOutstandingRewards(ctx context.Context, in *QueryTotalOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryTotalOutstandingRewardsResponse, error)
+	// ValidatorsWithOutstandingRewards queries the validators with non-zero
+	// outstanding rewards and their outstanding rewards, by operator address.
+	ValidatorsWithOutstandingRewards(ctx context.Context, in *QueryValidatorsWithOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorsWithOutstandingRewardsResponse, error)
 	// ValidatorCommission queries accumulated commission for a validator.
 	ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error)
+	// ValidatorCurrentRewards queries the rewards accumulated by a validator in
+	// its current period, i.e. since its last period increment.
+	ValidatorCurrentRewards(ctx context.Context, in *QueryValidatorCurrentRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorCurrentRewardsResponse, error)
+	// ValidatorHistoricalRewards queries the cumulative reward ratio and the
+	// reference count stored for a past period of a validator.
+	ValidatorHistoricalRewards(ctx context.Context, in *QueryValidatorHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorHistoricalRewardsResponse, error)
+	// ValidatorEstimatedRewardRate queries an estimate of the annual reward rate
+	// of a validator, derived from the rewards allocated to it over recent
+	// blocks. The estimate is not authoritative, past rewards do not predict the
+	// future ones.
+	ValidatorEstimatedRewardRate(ctx context.Context, in *QueryValidatorEstimatedRewardRateRequest, opts ...grpc.CallOption) (*QueryValidatorEstimatedRewardRateResponse, error)
+	// ValidatorRewardsFrozen queries whether the reward accrual of a validator
+	// is frozen, its allocations being redirected to the community pool.
+	ValidatorRewardsFrozen(ctx context.Context, in *QueryValidatorRewardsFrozenRequest, opts ...grpc.CallOption) (*QueryValidatorRewardsFrozenResponse, error)
+	// ValidatorRewardMultiplier queries the multiplier applied to the rewards
+	// allocated to a validator, one if none is set.
+	ValidatorRewardMultiplier(ctx context.Context, in *QueryValidatorRewardMultiplierRequest, opts ...grpc.CallOption) (*QueryValidatorRewardMultiplierResponse, error)
+	// ValidatorCommissionWithdrawals queries the recorded commission withdrawals
+	// of a validator.
+	ValidatorCommissionWithdrawals(ctx context.Context, in *QueryValidatorCommissionWithdrawalsRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionWithdrawalsResponse, error)
 	// ValidatorSlashes queries slash events of a validator.
 	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
+	// ValidatorSlashesByHeight queries the slash events of a validator that
+	// occurred in a range of block heights, together with their heights.
+	ValidatorSlashesByHeight(ctx context.Context, in *QueryValidatorSlashesByHeightRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesByHeightResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
 	DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error)
+	// DelegationRewardsProjection queries an estimate of the rewards a delegation
+	// will have accrued after a number of blocks, assuming that nothing changes.
+	// The estimate extrapolates the recent per-block rewards of the validator
+	// and is not authoritative, past rewards do not predict the future ones.
+	DelegationRewardsProjection(ctx context.Context, in *QueryDelegationRewardsProjectionRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsProjectionResponse, error)
 	// DelegationTotalRewards queries the total rewards accrued by each
 	// validator.
 	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
@@ -54,12 +107,30 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DecimalPool queries the decimal pool coins, i.e. the sub-unit remainder
+	// accumulated by the fee allocation.
+	DecimalPool(ctx context.Context, in *QueryDecimalPoolRequest, opts ...grpc.CallOption) (*QueryDecimalPoolResponse, error)
+	// FeePoolCommunityPool queries the community pool coins held by the fee pool
+	// in the distribution module account, to which the allocation credits the
+	// community pool cut of the fees.
+	FeePoolCommunityPool(ctx context.Context, in *QueryFeePoolCommunityPoolRequest, opts ...grpc.CallOption) (*QueryFeePoolCommunityPoolResponse, error)
+	// LastBlockFeesDistributed queries the fees collected and distributed by the
+	// allocation of the last block. Since the allocation runs in BeginBlock, the
+	// fees were collected in the block before the returned height.
+	LastBlockFeesDistributed(ctx context.Context, in *QueryLastBlockFeesDistributedRequest, opts ...grpc.CallOption) (*QueryLastBlockFeesDistributedResponse, error)
+	// SimulateAllocation dry-runs the allocation of the given fees to the given
+	// votes against the current state, without persisting anything.
+	SimulateAllocation(ctx context.Context, in *QuerySimulateAllocationRequest, opts ...grpc.CallOption) (*QuerySimulateAllocationResponse, error)
 	// Deprecated: Do not use.
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	// Since: cosmos-sdk 0.50
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// TotalCommunityFunds queries the community pool coins held by the
+	// x/protocolpool module, the decimal pool coins held by the distribution
+	// module and their sum.
+	TotalCommunityFunds(ctx context.Context, in *QueryTotalCommunityFundsRequest, opts ...grpc.CallOption) (*QueryTotalCommunityFundsResponse, error)
 }
 
 type queryClient struct {
@@ -79,6 +150,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) AllocationParams(ctx context.Context, in *QueryAllocationParamsRequest, opts ...grpc.CallOption) (*QueryAllocationParamsResponse, error) {
+	out := new(QueryAllocationParamsResponse)
+	err := c.cc.Invoke(ctx, Query_AllocationParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidatorDistributionInfo(ctx context.Context, in *QueryValidatorDistributionInfoRequest, opts ...grpc.CallOption) (*QueryValidatorDistributionInfoResponse, error) {
 	out := new(QueryValidatorDistributionInfoResponse)
 	err := c.cc.Invoke(ctx, Query_ValidatorDistributionInfo_FullMethodName, in, out, opts...)
@@ -97,6 +177,24 @@ func (c *queryClient) ValidatorOutstandingRewards(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *queryClient) TotalOutstandingRewards(ctx context.Context, in *QueryTotalOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryTotalOutstandingRewardsResponse, error) {
+	out := new(QueryTotalOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_TotalOutstandingRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsWithOutstandingRewards(ctx context.Context, in *QueryValidatorsWithOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorsWithOutstandingRewardsResponse, error) {
+	out := new(QueryValidatorsWithOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorsWithOutstandingRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error) {
 	out := new(QueryValidatorCommissionResponse)
 	err := c.cc.Invoke(ctx, Query_ValidatorCommission_FullMethodName, in, out, opts...)
@@ -106,6 +204,60 @@ func (c *queryClient) ValidatorCommission(ctx context.Context, in *QueryValidato
 	return out, nil
 }
 
+func (c *queryClient) ValidatorCurrentRewards(ctx context.Context, in *QueryValidatorCurrentRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorCurrentRewardsResponse, error) {
+	out := new(QueryValidatorCurrentRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorCurrentRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorHistoricalRewards(ctx context.Context, in *QueryValidatorHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorHistoricalRewardsResponse, error) {
+	out := new(QueryValidatorHistoricalRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorHistoricalRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorEstimatedRewardRate(ctx context.Context, in *QueryValidatorEstimatedRewardRateRequest, opts ...grpc.CallOption) (*QueryValidatorEstimatedRewardRateResponse, error) {
+	out := new(QueryValidatorEstimatedRewardRateResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorEstimatedRewardRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRewardsFrozen(ctx context.Context, in *QueryValidatorRewardsFrozenRequest, opts ...grpc.CallOption) (*QueryValidatorRewardsFrozenResponse, error) {
+	out := new(QueryValidatorRewardsFrozenResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorRewardsFrozen_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRewardMultiplier(ctx context.Context, in *QueryValidatorRewardMultiplierRequest, opts ...grpc.CallOption) (*QueryValidatorRewardMultiplierResponse, error) {
+	out := new(QueryValidatorRewardMultiplierResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorRewardMultiplier_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorCommissionWithdrawals(ctx context.Context, in *QueryValidatorCommissionWithdrawalsRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionWithdrawalsResponse, error) {
+	out := new(QueryValidatorCommissionWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorCommissionWithdrawals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error) {
 	out := new(QueryValidatorSlashesResponse)
 	err := c.cc.Invoke(ctx, Query_ValidatorSlashes_FullMethodName, in, out, opts...)
@@ -115,6 +267,15 @@ func (c *queryClient) ValidatorSlashes(ctx context.Context, in *QueryValidatorSl
 	return out, nil
 }
 
+func (c *queryClient) ValidatorSlashesByHeight(ctx context.Context, in *QueryValidatorSlashesByHeightRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesByHeightResponse, error) {
+	out := new(QueryValidatorSlashesByHeightResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorSlashesByHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error) {
 	out := new(QueryDelegationRewardsResponse)
 	err := c.cc.Invoke(ctx, Query_DelegationRewards_FullMethodName, in, out, opts...)
@@ -124,6 +285,15 @@ func (c *queryClient) DelegationRewards(ctx context.Context, in *QueryDelegation
 	return out, nil
 }
 
+func (c *queryClient) DelegationRewardsProjection(ctx context.Context, in *QueryDelegationRewardsProjectionRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsProjectionResponse, error) {
+	out := new(QueryDelegationRewardsProjectionResponse)
+	err := c.cc.Invoke(ctx, Query_DelegationRewardsProjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error) {
 	out := new(QueryDelegationTotalRewardsResponse)
 	err := c.cc.Invoke(ctx, Query_DelegationTotalRewards_FullMethodName, in, out, opts...)
@@ -151,6 +321,42 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DecimalPool(ctx context.Context, in *QueryDecimalPoolRequest, opts ...grpc.CallOption) (*QueryDecimalPoolResponse, error) {
+	out := new(QueryDecimalPoolResponse)
+	err := c.cc.Invoke(ctx, Query_DecimalPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePoolCommunityPool(ctx context.Context, in *QueryFeePoolCommunityPoolRequest, opts ...grpc.CallOption) (*QueryFeePoolCommunityPoolResponse, error) {
+	out := new(QueryFeePoolCommunityPoolResponse)
+	err := c.cc.Invoke(ctx, Query_FeePoolCommunityPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastBlockFeesDistributed(ctx context.Context, in *QueryLastBlockFeesDistributedRequest, opts ...grpc.CallOption) (*QueryLastBlockFeesDistributedResponse, error) {
+	out := new(QueryLastBlockFeesDistributedResponse)
+	err := c.cc.Invoke(ctx, Query_LastBlockFeesDistributed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateAllocation(ctx context.Context, in *QuerySimulateAllocationRequest, opts ...grpc.CallOption) (*QuerySimulateAllocationResponse, error) {
+	out := new(QuerySimulateAllocationResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateAllocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
@@ -161,22 +367,68 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) TotalCommunityFunds(ctx context.Context, in *QueryTotalCommunityFundsRequest, opts ...grpc.CallOption) (*QueryTotalCommunityFundsResponse, error) {
+	out := new(QueryTotalCommunityFundsResponse)
+	err := c.cc.Invoke(ctx, Query_TotalCommunityFunds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Params queries params of the distribution module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AllocationParams queries the rates applied by the fee allocation, and the
+	// vote multiplier they derive.
+	AllocationParams(context.Context, *QueryAllocationParamsRequest) (*QueryAllocationParamsResponse, error)
 	// ValidatorDistributionInfo queries validator commission and self-delegation rewards for validator
 	ValidatorDistributionInfo(context.Context, *QueryValidatorDistributionInfoRequest) (*QueryValidatorDistributionInfoResponse, error)
 	// ValidatorOutstandingRewards queries rewards of a validator address.
 	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
+	// TotalOutstandingRewards queries the sum of the outstanding rewards of all
+	// validators, i.e. the rewards allocated but not yet withdrawn.
+	TotalOutstandingRewards(context.Context, *QueryTotalOutstandingRewardsRequest) (*QueryTotalOutstandingRewardsResponse, error)
+	// ValidatorsWithOutstandingRewards queries the validators with non-zero
+	// outstanding rewards and their outstanding rewards, by operator address.
+	ValidatorsWithOutstandingRewards(context.Context, *QueryValidatorsWithOutstandingRewardsRequest) (*QueryValidatorsWithOutstandingRewardsResponse, error)
 	// ValidatorCommission queries accumulated commission for a validator.
 	ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error)
+	// ValidatorCurrentRewards queries the rewards accumulated by a validator in
+	// its current period, i.e. since its last period increment.
+	ValidatorCurrentRewards(context.Context, *QueryValidatorCurrentRewardsRequest) (*QueryValidatorCurrentRewardsResponse, error)
+	// ValidatorHistoricalRewards queries the cumulative reward ratio and the
+	// reference count stored for a past period of a validator.
+	ValidatorHistoricalRewards(context.Context, *QueryValidatorHistoricalRewardsRequest) (*QueryValidatorHistoricalRewardsResponse, error)
+	// ValidatorEstimatedRewardRate queries an estimate of the annual reward rate
+	// of a validator, derived from the rewards allocated to it over recent
+	// blocks. The estimate is not authoritative, past rewards do not predict the
+	// future ones.
+	ValidatorEstimatedRewardRate(context.Context, *QueryValidatorEstimatedRewardRateRequest) (*QueryValidatorEstimatedRewardRateResponse, error)
+	// ValidatorRewardsFrozen queries whether the reward accrual of a validator
+	// is frozen, its allocations being redirected to the community pool.
+	ValidatorRewardsFrozen(context.Context, *QueryValidatorRewardsFrozenRequest) (*QueryValidatorRewardsFrozenResponse, error)
+	// ValidatorRewardMultiplier queries the multiplier applied to the rewards
+	// allocated to a validator, one if none is set.
+	ValidatorRewardMultiplier(context.Context, *QueryValidatorRewardMultiplierRequest) (*QueryValidatorRewardMultiplierResponse, error)
+	// ValidatorCommissionWithdrawals queries the recorded commission withdrawals
+	// of a validator.
+	ValidatorCommissionWithdrawals(context.Context, *QueryValidatorCommissionWithdrawalsRequest) (*QueryValidatorCommissionWithdrawalsResponse, error)
 	// ValidatorSlashes queries slash events of a validator.
 	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
+	// ValidatorSlashesByHeight queries the slash events of a validator that
+	// occurred in a range of block heights, together with their heights.
+	ValidatorSlashesByHeight(context.Context, *QueryValidatorSlashesByHeightRequest) (*QueryValidatorSlashesByHeightResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
 	DelegationRewards(context.Context, *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error)
+	// DelegationRewardsProjection queries an estimate of the rewards a delegation
+	// will have accrued after a number of blocks, assuming that nothing changes.
+	// The estimate extrapolates the recent per-block rewards of the validator
+	// and is not authoritative, past rewards do not predict the future ones.
+	DelegationRewardsProjection(context.Context, *QueryDelegationRewardsProjectionRequest) (*QueryDelegationRewardsProjectionResponse, error)
 	// DelegationTotalRewards queries the total rewards accrued by each
 	// validator.
 	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
@@ -184,12 +436,30 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DecimalPool queries the decimal pool coins, i.e. the sub-unit remainder
+	// accumulated by the fee allocation.
+	DecimalPool(context.Context, *QueryDecimalPoolRequest) (*QueryDecimalPoolResponse, error)
+	// FeePoolCommunityPool queries the community pool coins held by the fee pool
+	// in the distribution module account, to which the allocation credits the
+	// community pool cut of the fees.
+	FeePoolCommunityPool(context.Context, *QueryFeePoolCommunityPoolRequest) (*QueryFeePoolCommunityPoolResponse, error)
+	// LastBlockFeesDistributed queries the fees collected and distributed by the
+	// allocation of the last block. Since the allocation runs in BeginBlock, the
+	// fees were collected in the block before the returned height.
+	LastBlockFeesDistributed(context.Context, *QueryLastBlockFeesDistributedRequest) (*QueryLastBlockFeesDistributedResponse, error)
+	// SimulateAllocation dry-runs the allocation of the given fees to the given
+	// votes against the current state, without persisting anything.
+	SimulateAllocation(context.Context, *QuerySimulateAllocationRequest) (*QuerySimulateAllocationResponse, error)
 	// Deprecated: Do not use.
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	// Since: cosmos-sdk 0.50
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// TotalCommunityFunds queries the community pool coins held by the
+	// x/protocolpool module, the decimal pool coins held by the distribution
+	// module and their sum.
+	TotalCommunityFunds(context.Context, *QueryTotalCommunityFundsRequest) (*QueryTotalCommunityFundsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -200,21 +470,54 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) AllocationParams(context.Context, *QueryAllocationParamsRequest) (*QueryAllocationParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocationParams not implemented")
+}
 func (UnimplementedQueryServer) ValidatorDistributionInfo(context.Context, *QueryValidatorDistributionInfoRequest) (*QueryValidatorDistributionInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDistributionInfo not implemented")
 }
 func (UnimplementedQueryServer) ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOutstandingRewards not implemented")
 }
+func (UnimplementedQueryServer) TotalOutstandingRewards(context.Context, *QueryTotalOutstandingRewardsRequest) (*QueryTotalOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalOutstandingRewards not implemented")
+}
+func (UnimplementedQueryServer) ValidatorsWithOutstandingRewards(context.Context, *QueryValidatorsWithOutstandingRewardsRequest) (*QueryValidatorsWithOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsWithOutstandingRewards not implemented")
+}
 func (UnimplementedQueryServer) ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommission not implemented")
 }
+func (UnimplementedQueryServer) ValidatorCurrentRewards(context.Context, *QueryValidatorCurrentRewardsRequest) (*QueryValidatorCurrentRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCurrentRewards not implemented")
+}
+func (UnimplementedQueryServer) ValidatorHistoricalRewards(context.Context, *QueryValidatorHistoricalRewardsRequest) (*QueryValidatorHistoricalRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorHistoricalRewards not implemented")
+}
+func (UnimplementedQueryServer) ValidatorEstimatedRewardRate(context.Context, *QueryValidatorEstimatedRewardRateRequest) (*QueryValidatorEstimatedRewardRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorEstimatedRewardRate not implemented")
+}
+func (UnimplementedQueryServer) ValidatorRewardsFrozen(context.Context, *QueryValidatorRewardsFrozenRequest) (*QueryValidatorRewardsFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewardsFrozen not implemented")
+}
+func (UnimplementedQueryServer) ValidatorRewardMultiplier(context.Context, *QueryValidatorRewardMultiplierRequest) (*QueryValidatorRewardMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewardMultiplier not implemented")
+}
+func (UnimplementedQueryServer) ValidatorCommissionWithdrawals(context.Context, *QueryValidatorCommissionWithdrawalsRequest) (*QueryValidatorCommissionWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommissionWithdrawals not implemented")
+}
 func (UnimplementedQueryServer) ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashes not implemented")
 }
+func (UnimplementedQueryServer) ValidatorSlashesByHeight(context.Context, *QueryValidatorSlashesByHeightRequest) (*QueryValidatorSlashesByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashesByHeight not implemented")
+}
 func (UnimplementedQueryServer) DelegationRewards(context.Context, *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewards not implemented")
 }
+func (UnimplementedQueryServer) DelegationRewardsProjection(context.Context, *QueryDelegationRewardsProjectionRequest) (*QueryDelegationRewardsProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewardsProjection not implemented")
+}
 func (UnimplementedQueryServer) DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
 }
@@ -224,9 +527,24 @@ func (UnimplementedQueryServer) DelegatorValidators(context.Context, *QueryDeleg
 func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (UnimplementedQueryServer) DecimalPool(context.Context, *QueryDecimalPoolRequest) (*QueryDecimalPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecimalPool not implemented")
+}
+func (UnimplementedQueryServer) FeePoolCommunityPool(context.Context, *QueryFeePoolCommunityPoolRequest) (*QueryFeePoolCommunityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePoolCommunityPool not implemented")
+}
+func (UnimplementedQueryServer) LastBlockFeesDistributed(context.Context, *QueryLastBlockFeesDistributedRequest) (*QueryLastBlockFeesDistributedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastBlockFeesDistributed not implemented")
+}
+func (UnimplementedQueryServer) SimulateAllocation(context.Context, *QuerySimulateAllocationRequest) (*QuerySimulateAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAllocation not implemented")
+}
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (UnimplementedQueryServer) TotalCommunityFunds(context.Context, *QueryTotalCommunityFundsRequest) (*QueryTotalCommunityFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalCommunityFunds not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -258,6 +576,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllocationParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllocationParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllocationParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllocationParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllocationParams(ctx, req.(*QueryAllocationParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidatorDistributionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorDistributionInfoRequest)
 	if err := dec(in); err != nil {
@@ -294,6 +630,42 @@ func _Query_ValidatorOutstandingRewards_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TotalOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalOutstandingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalOutstandingRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalOutstandingRewards(ctx, req.(*QueryTotalOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsWithOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsWithOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsWithOutstandingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorsWithOutstandingRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsWithOutstandingRewards(ctx, req.(*QueryValidatorsWithOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorCommissionRequest)
 	if err := dec(in); err != nil {
@@ -312,6 +684,114 @@ func _Query_ValidatorCommission_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorCurrentRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCurrentRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorCurrentRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorCurrentRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorCurrentRewards(ctx, req.(*QueryValidatorCurrentRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorHistoricalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorHistoricalRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorHistoricalRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorHistoricalRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorHistoricalRewards(ctx, req.(*QueryValidatorHistoricalRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorEstimatedRewardRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorEstimatedRewardRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorEstimatedRewardRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorEstimatedRewardRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorEstimatedRewardRate(ctx, req.(*QueryValidatorEstimatedRewardRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRewardsFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRewardsFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRewardsFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorRewardsFrozen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRewardsFrozen(ctx, req.(*QueryValidatorRewardsFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRewardMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRewardMultiplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRewardMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorRewardMultiplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRewardMultiplier(ctx, req.(*QueryValidatorRewardMultiplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorCommissionWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCommissionWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorCommissionWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorCommissionWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorCommissionWithdrawals(ctx, req.(*QueryValidatorCommissionWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidatorSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorSlashesRequest)
 	if err := dec(in); err != nil {
@@ -330,6 +810,24 @@ func _Query_ValidatorSlashes_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorSlashesByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSlashesByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSlashesByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorSlashesByHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSlashesByHeight(ctx, req.(*QueryValidatorSlashesByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegationRewardsRequest)
 	if err := dec(in); err != nil {
@@ -348,6 +846,24 @@ func _Query_DelegationRewards_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegationRewardsProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationRewardsProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationRewardsProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegationRewardsProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationRewardsProjection(ctx, req.(*QueryDelegationRewardsProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegationTotalRewardsRequest)
 	if err := dec(in); err != nil {
@@ -402,6 +918,78 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DecimalPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDecimalPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecimalPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DecimalPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecimalPool(ctx, req.(*QueryDecimalPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePoolCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePoolCommunityPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePoolCommunityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePoolCommunityPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePoolCommunityPool(ctx, req.(*QueryFeePoolCommunityPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastBlockFeesDistributed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastBlockFeesDistributedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastBlockFeesDistributed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LastBlockFeesDistributed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastBlockFeesDistributed(ctx, req.(*QueryLastBlockFeesDistributedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateAllocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateAllocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateAllocation(ctx, req.(*QuerySimulateAllocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -420,6 +1008,24 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TotalCommunityFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalCommunityFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalCommunityFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalCommunityFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalCommunityFunds(ctx, req.(*QueryTotalCommunityFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -431,6 +1037,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "AllocationParams",
+			Handler:    _Query_AllocationParams_Handler,
+		},
 		{
 			MethodName: "ValidatorDistributionInfo",
 			Handler:    _Query_ValidatorDistributionInfo_Handler,
@@ -439,18 +1049,58 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatorOutstandingRewards",
 			Handler:    _Query_ValidatorOutstandingRewards_Handler,
 		},
+		{
+			MethodName: "TotalOutstandingRewards",
+			Handler:    _Query_TotalOutstandingRewards_Handler,
+		},
+		{
+			MethodName: "ValidatorsWithOutstandingRewards",
+			Handler:    _Query_ValidatorsWithOutstandingRewards_Handler,
+		},
 		{
 			MethodName: "ValidatorCommission",
 			Handler:    _Query_ValidatorCommission_Handler,
 		},
+		{
+			MethodName: "ValidatorCurrentRewards",
+			Handler:    _Query_ValidatorCurrentRewards_Handler,
+		},
+		{
+			MethodName: "ValidatorHistoricalRewards",
+			Handler:    _Query_ValidatorHistoricalRewards_Handler,
+		},
+		{
+			MethodName: "ValidatorEstimatedRewardRate",
+			Handler:    _Query_ValidatorEstimatedRewardRate_Handler,
+		},
+		{
+			MethodName: "ValidatorRewardsFrozen",
+			Handler:    _Query_ValidatorRewardsFrozen_Handler,
+		},
+		{
+			MethodName: "ValidatorRewardMultiplier",
+			Handler:    _Query_ValidatorRewardMultiplier_Handler,
+		},
+		{
+			MethodName: "ValidatorCommissionWithdrawals",
+			Handler:    _Query_ValidatorCommissionWithdrawals_Handler,
+		},
 		{
 			MethodName: "ValidatorSlashes",
 			Handler:    _Query_ValidatorSlashes_Handler,
 		},
+		{
+			MethodName: "ValidatorSlashesByHeight",
+			Handler:    _Query_ValidatorSlashesByHeight_Handler,
+		},
 		{
 			MethodName: "DelegationRewards",
 			Handler:    _Query_DelegationRewards_Handler,
 		},
+		{
+			MethodName: "DelegationRewardsProjection",
+			Handler:    _Query_DelegationRewardsProjection_Handler,
+		},
 		{
 			MethodName: "DelegationTotalRewards",
 			Handler:    _Query_DelegationTotalRewards_Handler,
@@ -463,10 +1113,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DecimalPool",
+			Handler:    _Query_DecimalPool_Handler,
+		},
+		{
+			MethodName: "FeePoolCommunityPool",
+			Handler:    _Query_FeePoolCommunityPool_Handler,
+		},
+		{
+			MethodName: "LastBlockFeesDistributed",
+			Handler:    _Query_LastBlockFeesDistributed_Handler,
+		},
+		{
+			MethodName: "SimulateAllocation",
+			Handler:    _Query_SimulateAllocation_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "TotalCommunityFunds",
+			Handler:    _Query_TotalCommunityFunds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
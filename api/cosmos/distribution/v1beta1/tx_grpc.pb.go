@@ -19,13 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SetWithdrawAddress_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress"
-	Msg_WithdrawDelegatorReward_FullMethodName     = "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward"
-	Msg_WithdrawValidatorCommission_FullMethodName = "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission"
-	Msg_FundCommunityPool_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/FundCommunityPool"
-	Msg_UpdateParams_FullMethodName                = "/cosmos.distribution.v1beta1.Msg/UpdateParams"
-	Msg_CommunityPoolSpend_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend"
-	Msg_DepositValidatorRewardsPool_FullMethodName = "/cosmos.distribution.v1beta1.Msg/DepositValidatorRewardsPool"
+	Msg_SetWithdrawAddress_FullMethodName                    = "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress"
+	Msg_WithdrawDelegatorReward_FullMethodName               = "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward"
+	Msg_WithdrawValidatorCommission_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission"
+	Msg_FundCommunityPool_FullMethodName                     = "/cosmos.distribution.v1beta1.Msg/FundCommunityPool"
+	Msg_UpdateParams_FullMethodName                          = "/cosmos.distribution.v1beta1.Msg/UpdateParams"
+	Msg_CommunityPoolSpend_FullMethodName                    = "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend"
+	Msg_DepositValidatorRewardsPool_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/DepositValidatorRewardsPool"
+	Msg_WithdrawAllDelegatorRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/WithdrawAllDelegatorRewards"
+	Msg_SetValidatorCommissionWithdrawAddress_FullMethodName = "/cosmos.distribution.v1beta1.Msg/SetValidatorCommissionWithdrawAddress"
+	Msg_SetWithdrawSplit_FullMethodName                      = "/cosmos.distribution.v1beta1.Msg/SetWithdrawSplit"
+	Msg_SetValidatorCommissionCap_FullMethodName             = "/cosmos.distribution.v1beta1.Msg/SetValidatorCommissionCap"
+	Msg_WithdrawAndCompound_FullMethodName                   = "/cosmos.distribution.v1beta1.Msg/WithdrawAndCompound"
+	Msg_AllocateValidatorRewards_FullMethodName              = "/cosmos.distribution.v1beta1.Msg/AllocateValidatorRewards"
+	Msg_SetValidatorCommissionExemptDenoms_FullMethodName    = "/cosmos.distribution.v1beta1.Msg/SetValidatorCommissionExemptDenoms"
+	Msg_SetValidatorRewardsFrozen_FullMethodName             = "/cosmos.distribution.v1beta1.Msg/SetValidatorRewardsFrozen"
+	Msg_SetValidatorCommissionRebated_FullMethodName         = "/cosmos.distribution.v1beta1.Msg/SetValidatorCommissionRebated"
+	Msg_SetFeeCollectorName_FullMethodName                   = "/cosmos.distribution.v1beta1.Msg/SetFeeCollectorName"
+	Msg_SetValidatorRewardMultiplier_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/SetValidatorRewardMultiplier"
+	Msg_CommunityPoolSpendBatch_FullMethodName               = "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpendBatch"
 )
 
 // MsgClient is the client API for Msg service.
@@ -66,6 +78,55 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.50
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw rewards of a
+	// delegator from all the validators it is delegating to.
+	WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error)
+	// SetValidatorCommissionWithdrawAddress defines a method to change the
+	// withdraw address of a validator's commission.
+	SetValidatorCommissionWithdrawAddress(ctx context.Context, in *MsgSetValidatorCommissionWithdrawAddress, opts ...grpc.CallOption) (*MsgSetValidatorCommissionWithdrawAddressResponse, error)
+	// SetWithdrawSplit defines a method to split the rewards of a delegator (or
+	// validator self-delegation) between several addresses upon withdrawal.
+	SetWithdrawSplit(ctx context.Context, in *MsgSetWithdrawSplit, opts ...grpc.CallOption) (*MsgSetWithdrawSplitResponse, error)
+	// SetValidatorCommissionCap defines a governance operation for capping the
+	// commission rate the rewards of a validator are split with. The authority
+	// is defined in the keeper.
+	SetValidatorCommissionCap(ctx context.Context, in *MsgSetValidatorCommissionCap, opts ...grpc.CallOption) (*MsgSetValidatorCommissionCapResponse, error)
+	// WithdrawAndCompound defines a method to withdraw rewards of delegator
+	// from a single validator and delegate the bond denom part of them to the
+	// same validator.
+	WithdrawAndCompound(ctx context.Context, in *MsgWithdrawAndCompound, opts ...grpc.CallOption) (*MsgWithdrawAndCompoundResponse, error)
+	// AllocateValidatorRewards defines a governance operation for crediting
+	// rewards, funded by the authority, to a validator and its delegators. The
+	// authority is defined in the keeper.
+	AllocateValidatorRewards(ctx context.Context, in *MsgAllocateValidatorRewards, opts ...grpc.CallOption) (*MsgAllocateValidatorRewardsResponse, error)
+	// SetValidatorCommissionExemptDenoms defines a method for a validator to
+	// exempt denoms from its commission, sharing the rewards in these denoms in
+	// full with its delegators.
+	SetValidatorCommissionExemptDenoms(ctx context.Context, in *MsgSetValidatorCommissionExemptDenoms, opts ...grpc.CallOption) (*MsgSetValidatorCommissionExemptDenomsResponse, error)
+	// SetValidatorRewardsFrozen defines a governance operation for freezing the
+	// reward accrual of a validator, its allocations being redirected to the
+	// community pool, or for unfreezing it. The authority is defined in the
+	// keeper.
+	SetValidatorRewardsFrozen(ctx context.Context, in *MsgSetValidatorRewardsFrozen, opts ...grpc.CallOption) (*MsgSetValidatorRewardsFrozenResponse, error)
+	// SetValidatorCommissionRebated defines a governance operation for adding a
+	// validator to the validators whose commission is partly rebated to their
+	// delegators from the community pool, or for removing it. The authority is
+	// defined in the keeper.
+	SetValidatorCommissionRebated(ctx context.Context, in *MsgSetValidatorCommissionRebated, opts ...grpc.CallOption) (*MsgSetValidatorCommissionRebatedResponse, error)
+	// SetFeeCollectorName defines a governance operation for switching the
+	// module account the fees are collected from, e.g. during a migration. The
+	// authority is defined in the keeper.
+	SetFeeCollectorName(ctx context.Context, in *MsgSetFeeCollectorName, opts ...grpc.CallOption) (*MsgSetFeeCollectorNameResponse, error)
+	// SetValidatorRewardMultiplier defines a governance operation for applying
+	// a multiplier to the rewards allocated to a validator, the difference being
+	// taken from or added to the community pool. The authority is defined in
+	// the keeper.
+	SetValidatorRewardMultiplier(ctx context.Context, in *MsgSetValidatorRewardMultiplier, opts ...grpc.CallOption) (*MsgSetValidatorRewardMultiplierResponse, error)
+	// CommunityPoolSpendBatch defines a governance operation for sending tokens
+	// from the x/protocolpool community pool to several recipients at once. The
+	// whole batch fails if the community pool cannot cover its total. The
+	// authority is defined in the keeper.
+	CommunityPoolSpendBatch(ctx context.Context, in *MsgCommunityPoolSpendBatch, opts ...grpc.CallOption) (*MsgCommunityPoolSpendBatchResponse, error)
 }
 
 type msgClient struct {
@@ -140,6 +201,114 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	out := new(MsgWithdrawAllDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawAllDelegatorRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorCommissionWithdrawAddress(ctx context.Context, in *MsgSetValidatorCommissionWithdrawAddress, opts ...grpc.CallOption) (*MsgSetValidatorCommissionWithdrawAddressResponse, error) {
+	out := new(MsgSetValidatorCommissionWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, Msg_SetValidatorCommissionWithdrawAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetWithdrawSplit(ctx context.Context, in *MsgSetWithdrawSplit, opts ...grpc.CallOption) (*MsgSetWithdrawSplitResponse, error) {
+	out := new(MsgSetWithdrawSplitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetWithdrawSplit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorCommissionCap(ctx context.Context, in *MsgSetValidatorCommissionCap, opts ...grpc.CallOption) (*MsgSetValidatorCommissionCapResponse, error) {
+	out := new(MsgSetValidatorCommissionCapResponse)
+	err := c.cc.Invoke(ctx, Msg_SetValidatorCommissionCap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawAndCompound(ctx context.Context, in *MsgWithdrawAndCompound, opts ...grpc.CallOption) (*MsgWithdrawAndCompoundResponse, error) {
+	out := new(MsgWithdrawAndCompoundResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawAndCompound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AllocateValidatorRewards(ctx context.Context, in *MsgAllocateValidatorRewards, opts ...grpc.CallOption) (*MsgAllocateValidatorRewardsResponse, error) {
+	out := new(MsgAllocateValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_AllocateValidatorRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorCommissionExemptDenoms(ctx context.Context, in *MsgSetValidatorCommissionExemptDenoms, opts ...grpc.CallOption) (*MsgSetValidatorCommissionExemptDenomsResponse, error) {
+	out := new(MsgSetValidatorCommissionExemptDenomsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetValidatorCommissionExemptDenoms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorRewardsFrozen(ctx context.Context, in *MsgSetValidatorRewardsFrozen, opts ...grpc.CallOption) (*MsgSetValidatorRewardsFrozenResponse, error) {
+	out := new(MsgSetValidatorRewardsFrozenResponse)
+	err := c.cc.Invoke(ctx, Msg_SetValidatorRewardsFrozen_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorCommissionRebated(ctx context.Context, in *MsgSetValidatorCommissionRebated, opts ...grpc.CallOption) (*MsgSetValidatorCommissionRebatedResponse, error) {
+	out := new(MsgSetValidatorCommissionRebatedResponse)
+	err := c.cc.Invoke(ctx, Msg_SetValidatorCommissionRebated_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeCollectorName(ctx context.Context, in *MsgSetFeeCollectorName, opts ...grpc.CallOption) (*MsgSetFeeCollectorNameResponse, error) {
+	out := new(MsgSetFeeCollectorNameResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeCollectorName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorRewardMultiplier(ctx context.Context, in *MsgSetValidatorRewardMultiplier, opts ...grpc.CallOption) (*MsgSetValidatorRewardMultiplierResponse, error) {
+	out := new(MsgSetValidatorRewardMultiplierResponse)
+	err := c.cc.Invoke(ctx, Msg_SetValidatorRewardMultiplier_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommunityPoolSpendBatch(ctx context.Context, in *MsgCommunityPoolSpendBatch, opts ...grpc.CallOption) (*MsgCommunityPoolSpendBatchResponse, error) {
+	out := new(MsgCommunityPoolSpendBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_CommunityPoolSpendBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -178,6 +347,55 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.50
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw rewards of a
+	// delegator from all the validators it is delegating to.
+	WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error)
+	// SetValidatorCommissionWithdrawAddress defines a method to change the
+	// withdraw address of a validator's commission.
+	SetValidatorCommissionWithdrawAddress(context.Context, *MsgSetValidatorCommissionWithdrawAddress) (*MsgSetValidatorCommissionWithdrawAddressResponse, error)
+	// SetWithdrawSplit defines a method to split the rewards of a delegator (or
+	// validator self-delegation) between several addresses upon withdrawal.
+	SetWithdrawSplit(context.Context, *MsgSetWithdrawSplit) (*MsgSetWithdrawSplitResponse, error)
+	// SetValidatorCommissionCap defines a governance operation for capping the
+	// commission rate the rewards of a validator are split with. The authority
+	// is defined in the keeper.
+	SetValidatorCommissionCap(context.Context, *MsgSetValidatorCommissionCap) (*MsgSetValidatorCommissionCapResponse, error)
+	// WithdrawAndCompound defines a method to withdraw rewards of delegator
+	// from a single validator and delegate the bond denom part of them to the
+	// same validator.
+	WithdrawAndCompound(context.Context, *MsgWithdrawAndCompound) (*MsgWithdrawAndCompoundResponse, error)
+	// AllocateValidatorRewards defines a governance operation for crediting
+	// rewards, funded by the authority, to a validator and its delegators. The
+	// authority is defined in the keeper.
+	AllocateValidatorRewards(context.Context, *MsgAllocateValidatorRewards) (*MsgAllocateValidatorRewardsResponse, error)
+	// SetValidatorCommissionExemptDenoms defines a method for a validator to
+	// exempt denoms from its commission, sharing the rewards in these denoms in
+	// full with its delegators.
+	SetValidatorCommissionExemptDenoms(context.Context, *MsgSetValidatorCommissionExemptDenoms) (*MsgSetValidatorCommissionExemptDenomsResponse, error)
+	// SetValidatorRewardsFrozen defines a governance operation for freezing the
+	// reward accrual of a validator, its allocations being redirected to the
+	// community pool, or for unfreezing it. The authority is defined in the
+	// keeper.
+	SetValidatorRewardsFrozen(context.Context, *MsgSetValidatorRewardsFrozen) (*MsgSetValidatorRewardsFrozenResponse, error)
+	// SetValidatorCommissionRebated defines a governance operation for adding a
+	// validator to the validators whose commission is partly rebated to their
+	// delegators from the community pool, or for removing it. The authority is
+	// defined in the keeper.
+	SetValidatorCommissionRebated(context.Context, *MsgSetValidatorCommissionRebated) (*MsgSetValidatorCommissionRebatedResponse, error)
+	// SetFeeCollectorName defines a governance operation for switching the
+	// module account the fees are collected from, e.g. during a migration. The
+	// authority is defined in the keeper.
+	SetFeeCollectorName(context.Context, *MsgSetFeeCollectorName) (*MsgSetFeeCollectorNameResponse, error)
+	// SetValidatorRewardMultiplier defines a governance operation for applying
+	// a multiplier to the rewards allocated to a validator, the difference being
+	// taken from or added to the community pool. The authority is defined in
+	// the keeper.
+	SetValidatorRewardMultiplier(context.Context, *MsgSetValidatorRewardMultiplier) (*MsgSetValidatorRewardMultiplierResponse, error)
+	// CommunityPoolSpendBatch defines a governance operation for sending tokens
+	// from the x/protocolpool community pool to several recipients at once. The
+	// whole batch fails if the community pool cannot cover its total. The
+	// authority is defined in the keeper.
+	CommunityPoolSpendBatch(context.Context, *MsgCommunityPoolSpendBatch) (*MsgCommunityPoolSpendBatchResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -206,6 +424,42 @@ func (UnimplementedMsgServer) CommunityPoolSpend(context.Context, *MsgCommunityP
 func (UnimplementedMsgServer) DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (UnimplementedMsgServer) WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllDelegatorRewards not implemented")
+}
+func (UnimplementedMsgServer) SetValidatorCommissionWithdrawAddress(context.Context, *MsgSetValidatorCommissionWithdrawAddress) (*MsgSetValidatorCommissionWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorCommissionWithdrawAddress not implemented")
+}
+func (UnimplementedMsgServer) SetWithdrawSplit(context.Context, *MsgSetWithdrawSplit) (*MsgSetWithdrawSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawSplit not implemented")
+}
+func (UnimplementedMsgServer) SetValidatorCommissionCap(context.Context, *MsgSetValidatorCommissionCap) (*MsgSetValidatorCommissionCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorCommissionCap not implemented")
+}
+func (UnimplementedMsgServer) WithdrawAndCompound(context.Context, *MsgWithdrawAndCompound) (*MsgWithdrawAndCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAndCompound not implemented")
+}
+func (UnimplementedMsgServer) AllocateValidatorRewards(context.Context, *MsgAllocateValidatorRewards) (*MsgAllocateValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateValidatorRewards not implemented")
+}
+func (UnimplementedMsgServer) SetValidatorCommissionExemptDenoms(context.Context, *MsgSetValidatorCommissionExemptDenoms) (*MsgSetValidatorCommissionExemptDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorCommissionExemptDenoms not implemented")
+}
+func (UnimplementedMsgServer) SetValidatorRewardsFrozen(context.Context, *MsgSetValidatorRewardsFrozen) (*MsgSetValidatorRewardsFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorRewardsFrozen not implemented")
+}
+func (UnimplementedMsgServer) SetValidatorCommissionRebated(context.Context, *MsgSetValidatorCommissionRebated) (*MsgSetValidatorCommissionRebatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorCommissionRebated not implemented")
+}
+func (UnimplementedMsgServer) SetFeeCollectorName(context.Context, *MsgSetFeeCollectorName) (*MsgSetFeeCollectorNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeCollectorName not implemented")
+}
+func (UnimplementedMsgServer) SetValidatorRewardMultiplier(context.Context, *MsgSetValidatorRewardMultiplier) (*MsgSetValidatorRewardMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorRewardMultiplier not implemented")
+}
+func (UnimplementedMsgServer) CommunityPoolSpendBatch(context.Context, *MsgCommunityPoolSpendBatch) (*MsgCommunityPoolSpendBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolSpendBatch not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -345,6 +599,222 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawAllDelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAllDelegatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawAllDelegatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, req.(*MsgWithdrawAllDelegatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorCommissionWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorCommissionWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorCommissionWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetValidatorCommissionWithdrawAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorCommissionWithdrawAddress(ctx, req.(*MsgSetValidatorCommissionWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetWithdrawSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetWithdrawSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawSplit(ctx, req.(*MsgSetWithdrawSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorCommissionCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorCommissionCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorCommissionCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetValidatorCommissionCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorCommissionCap(ctx, req.(*MsgSetValidatorCommissionCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawAndCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAndCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAndCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawAndCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAndCompound(ctx, req.(*MsgWithdrawAndCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AllocateValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAllocateValidatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AllocateValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AllocateValidatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AllocateValidatorRewards(ctx, req.(*MsgAllocateValidatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorCommissionExemptDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorCommissionExemptDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorCommissionExemptDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetValidatorCommissionExemptDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorCommissionExemptDenoms(ctx, req.(*MsgSetValidatorCommissionExemptDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorRewardsFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorRewardsFrozen)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorRewardsFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetValidatorRewardsFrozen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorRewardsFrozen(ctx, req.(*MsgSetValidatorRewardsFrozen))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorCommissionRebated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorCommissionRebated)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorCommissionRebated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetValidatorCommissionRebated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorCommissionRebated(ctx, req.(*MsgSetValidatorCommissionRebated))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeCollectorName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeCollectorName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeCollectorName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeCollectorName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeCollectorName(ctx, req.(*MsgSetFeeCollectorName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorRewardMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorRewardMultiplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorRewardMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetValidatorRewardMultiplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorRewardMultiplier(ctx, req.(*MsgSetValidatorRewardMultiplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommunityPoolSpendBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommunityPoolSpendBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommunityPoolSpendBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CommunityPoolSpendBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommunityPoolSpendBatch(ctx, req.(*MsgCommunityPoolSpendBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -380,6 +850,54 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "WithdrawAllDelegatorRewards",
+			Handler:    _Msg_WithdrawAllDelegatorRewards_Handler,
+		},
+		{
+			MethodName: "SetValidatorCommissionWithdrawAddress",
+			Handler:    _Msg_SetValidatorCommissionWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "SetWithdrawSplit",
+			Handler:    _Msg_SetWithdrawSplit_Handler,
+		},
+		{
+			MethodName: "SetValidatorCommissionCap",
+			Handler:    _Msg_SetValidatorCommissionCap_Handler,
+		},
+		{
+			MethodName: "WithdrawAndCompound",
+			Handler:    _Msg_WithdrawAndCompound_Handler,
+		},
+		{
+			MethodName: "AllocateValidatorRewards",
+			Handler:    _Msg_AllocateValidatorRewards_Handler,
+		},
+		{
+			MethodName: "SetValidatorCommissionExemptDenoms",
+			Handler:    _Msg_SetValidatorCommissionExemptDenoms_Handler,
+		},
+		{
+			MethodName: "SetValidatorRewardsFrozen",
+			Handler:    _Msg_SetValidatorRewardsFrozen_Handler,
+		},
+		{
+			MethodName: "SetValidatorCommissionRebated",
+			Handler:    _Msg_SetValidatorCommissionRebated_Handler,
+		},
+		{
+			MethodName: "SetFeeCollectorName",
+			Handler:    _Msg_SetFeeCollectorName_Handler,
+		},
+		{
+			MethodName: "SetValidatorRewardMultiplier",
+			Handler:    _Msg_SetValidatorRewardMultiplier_Handler,
+		},
+		{
+			MethodName: "CommunityPoolSpendBatch",
+			Handler:    _Msg_CommunityPoolSpendBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -220,6 +220,118 @@ func (s *GRPCQueryTestSuite) TestQueryValidatorCommissionGRPC() {
 	}
 }
 
+func (s *GRPCQueryTestSuite) TestQueryValidatorCurrentRewardsGRPC() {
+	val := s.network.GetValidators()[0]
+	baseURL := val.GetAPIAddress()
+
+	rewards, err := sdk.ParseDecCoins("9.8stake")
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		url      string
+		headers  map[string]string
+		expErr   bool
+		respType proto.Message
+		expected proto.Message
+	}{
+		{
+			"gRPC request params with wrong validator address",
+			fmt.Sprintf("%s/cosmos/distribution/v1beta1/validators/%s/current_rewards", baseURL, "wrongAddress"),
+			map[string]string{},
+			true,
+			&types.QueryValidatorCurrentRewardsResponse{},
+			&types.QueryValidatorCurrentRewardsResponse{},
+		},
+		{
+			"gRPC request params valid address",
+			fmt.Sprintf("%s/cosmos/distribution/v1beta1/validators/%s/current_rewards", baseURL, val.GetValAddress().String()),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "2",
+			},
+			false,
+			&types.QueryValidatorCurrentRewardsResponse{},
+			&types.QueryValidatorCurrentRewardsResponse{
+				Rewards: rewards,
+				Period:  2,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		resp, err := sdktestutil.GetRequestWithHeaders(tc.url, tc.headers)
+		s.Run(tc.name, func() {
+			if tc.expErr {
+				s.Require().Error(val.GetClientCtx().Codec.UnmarshalJSON(resp, tc.respType))
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(val.GetClientCtx().Codec.UnmarshalJSON(resp, tc.respType))
+				s.Require().Equal(tc.expected.String(), tc.respType.String())
+			}
+		})
+	}
+}
+
+func (s *GRPCQueryTestSuite) TestQueryValidatorHistoricalRewardsGRPC() {
+	val := s.network.GetValidators()[0]
+	baseURL := val.GetAPIAddress()
+
+	testCases := []struct {
+		name     string
+		url      string
+		headers  map[string]string
+		expErr   bool
+		respType proto.Message
+		expected proto.Message
+	}{
+		{
+			"gRPC request params with wrong validator address",
+			fmt.Sprintf("%s/cosmos/distribution/v1beta1/validators/%s/historical_rewards/1", baseURL, "wrongAddress"),
+			map[string]string{},
+			true,
+			&types.QueryValidatorHistoricalRewardsResponse{},
+			&types.QueryValidatorHistoricalRewardsResponse{},
+		},
+		{
+			"gRPC request params with a pruned period",
+			fmt.Sprintf("%s/cosmos/distribution/v1beta1/validators/%s/historical_rewards/0", baseURL, val.GetValAddress().String()),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "2",
+			},
+			true,
+			&types.QueryValidatorHistoricalRewardsResponse{},
+			&types.QueryValidatorHistoricalRewardsResponse{},
+		},
+		{
+			"gRPC request params valid period",
+			fmt.Sprintf("%s/cosmos/distribution/v1beta1/validators/%s/historical_rewards/1", baseURL, val.GetValAddress().String()),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "2",
+			},
+			false,
+			&types.QueryValidatorHistoricalRewardsResponse{},
+			&types.QueryValidatorHistoricalRewardsResponse{
+				Rewards: types.NewValidatorHistoricalRewards(sdk.DecCoins{}, 2),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		resp, err := sdktestutil.GetRequestWithHeaders(tc.url, tc.headers)
+		s.Run(tc.name, func() {
+			if tc.expErr {
+				s.Require().Error(val.GetClientCtx().Codec.UnmarshalJSON(resp, tc.respType))
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(val.GetClientCtx().Codec.UnmarshalJSON(resp, tc.respType))
+				s.Require().Equal(tc.expected.String(), tc.respType.String())
+			}
+		})
+	}
+}
+
 func (s *GRPCQueryTestSuite) TestQuerySlashesGRPC() {
 	val := s.network.GetValidators()[0]
 	baseURL := val.GetAPIAddress()
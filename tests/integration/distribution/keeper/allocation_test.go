@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/math"
+	distrtypes "cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAllocateTokensBurnRate(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	params := distrtypes.DefaultParams()
+	params.BurnRate = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, f.distrKeeper.Params.Set(f.sdkCtx, params))
+	require.NoError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, distrtypes.InitialFeePool()))
+
+	validator, err := stakingtypes.NewValidator(f.valAddr.String(), valConsPk0, stakingtypes.Description{})
+	require.NoError(t, err)
+	validator.Tokens = math.NewInt(1000)
+	validator.DelegatorShares = math.LegacyNewDec(1000)
+	require.NoError(t, f.stakingKeeper.SetValidator(f.sdkCtx, validator))
+	require.NoError(t, f.stakingKeeper.SetValidatorByConsAddr(f.sdkCtx, validator))
+
+	// the fixture collects the fees from the distribution module account
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, fees))
+	require.Equal(t, math.NewInt(100), f.bankKeeper.GetSupply(f.sdkCtx, sdk.DefaultBondDenom).Amount)
+
+	votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+	result, err := f.distrKeeper.AllocateTokensWithResult(f.sdkCtx, 100, votes)
+	require.NoError(t, err)
+
+	// 10% of the fees are burned and removed from the total supply
+	require.Equal(t, math.NewInt(90), f.bankKeeper.GetSupply(f.sdkCtx, sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(10))), result.Burned)
+
+	// the rest is allocated as usual
+	outstanding, err := f.distrKeeper.ValidatorOutstandingRewards.Get(f.sdkCtx, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("88.2"))}, outstanding.Rewards)
+
+	feePool, err := f.distrKeeper.FeePool.Get(f.sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("1.8"))}, feePool.CommunityPool)
+	require.Equal(t, fees.Sub(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10))), f.bankKeeper.GetAllBalances(f.sdkCtx, f.accountKeeper.GetModuleAddress(distrtypes.ModuleName)))
+}
@@ -47,10 +47,16 @@ func TestGRPCParams(t *testing.T) {
 			name: "valid request",
 			malleate: func() {
 				params = types.Params{
-					CommunityTax:        math.LegacyNewDecWithPrec(3, 1),
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: true,
+					CommunityTax:              math.LegacyNewDecWithPrec(3, 1),
+					BaseProposerReward:        math.LegacyZeroDec(),
+					BonusProposerReward:       math.LegacyZeroDec(),
+					WithdrawAddrEnabled:       true,
+					DecimalPoolFlushThreshold: math.NewInt(100),
+					MinCommissionRate:         math.LegacyZeroDec(),
+					BurnRate:                  math.LegacyZeroDec(),
+					InfraFundRate:             math.LegacyZeroDec(),
+					CommissionRebateRate:      math.LegacyZeroDec(),
+					MinSelfBondForRewards:     math.ZeroInt(),
 				}
 
 				assert.NilError(t, f.distrKeeper.Params.Set(f.sdkCtx, params))
@@ -372,6 +378,142 @@ func TestGRPCValidatorSlashes(t *testing.T) {
 	}
 }
 
+func TestGRPCValidatorSlashesByHeight(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	qr := f.app.QueryHelper()
+	queryClient := types.NewQueryClient(qr)
+
+	addr2 := sdk.AccAddress(PKS[1].Address())
+	valAddr2 := sdk.ValAddress(addr2)
+
+	// the last two slashes occur at the same height, ending successive periods
+	slashes := []types.ValidatorSlashEventRecord{
+		{Height: 2, Period: 3, ValidatorSlashEvent: types.NewValidatorSlashEvent(3, math.LegacyNewDecWithPrec(5, 1))},
+		{Height: 3, Period: 5, ValidatorSlashEvent: types.NewValidatorSlashEvent(5, math.LegacyNewDecWithPrec(5, 1))},
+		{Height: 4, Period: 7, ValidatorSlashEvent: types.NewValidatorSlashEvent(7, math.LegacyNewDecWithPrec(5, 1))},
+		{Height: 4, Period: 9, ValidatorSlashEvent: types.NewValidatorSlashEvent(9, math.LegacyNewDecWithPrec(5, 1))},
+	}
+
+	for i, slash := range slashes {
+		err := f.distrKeeper.ValidatorSlashEvents.Set(
+			f.sdkCtx,
+			collections.Join3(f.valAddr, slash.Height, slash.Period),
+			slash.ValidatorSlashEvent,
+		)
+		assert.NilError(t, err)
+		slashes[i].ValidatorAddress = f.valAddr.String()
+	}
+
+	var (
+		req    *types.QueryValidatorSlashesByHeightRequest
+		expRes *types.QueryValidatorSlashesByHeightResponse
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPass   bool
+		expErrMsg string
+	}{
+		{
+			name: "empty request",
+			malleate: func() {
+				req = &types.QueryValidatorSlashesByHeightRequest{}
+				expRes = &types.QueryValidatorSlashesByHeightResponse{}
+			},
+			expPass:   false,
+			expErrMsg: "empty validator address",
+		},
+		{
+			name: "ending height lesser than starting height",
+			malleate: func() {
+				req = &types.QueryValidatorSlashesByHeightRequest{
+					ValidatorAddress: f.valAddr.String(),
+					StartingHeight:   10,
+					EndingHeight:     1,
+				}
+				expRes = &types.QueryValidatorSlashesByHeightResponse{}
+			},
+			expPass:   false,
+			expErrMsg: "starting height greater than ending height",
+		},
+		{
+			name: "no slash event validator request",
+			malleate: func() {
+				req = &types.QueryValidatorSlashesByHeightRequest{
+					ValidatorAddress: valAddr2.String(),
+					StartingHeight:   1,
+					EndingHeight:     10,
+				}
+				expRes = &types.QueryValidatorSlashesByHeightResponse{}
+			},
+			expPass: true,
+		},
+		{
+			name: "request slashes in a height range",
+			malleate: func() {
+				req = &types.QueryValidatorSlashesByHeightRequest{
+					ValidatorAddress: f.valAddr.String(),
+					StartingHeight:   3,
+					EndingHeight:     4,
+				}
+				expRes = &types.QueryValidatorSlashesByHeightResponse{
+					Slashes: slashes[1:],
+				}
+			},
+			expPass: true,
+		},
+		{
+			name: "request slashes at a single height",
+			malleate: func() {
+				req = &types.QueryValidatorSlashesByHeightRequest{
+					ValidatorAddress: f.valAddr.String(),
+					StartingHeight:   2,
+					EndingHeight:     2,
+				}
+				expRes = &types.QueryValidatorSlashesByHeightResponse{
+					Slashes: slashes[:1],
+				}
+			},
+			expPass: true,
+		},
+		{
+			name: "request slashes in a height range with limit 2",
+			malleate: func() {
+				req = &types.QueryValidatorSlashesByHeightRequest{
+					ValidatorAddress: f.valAddr.String(),
+					StartingHeight:   3,
+					EndingHeight:     10,
+					Pagination:       &query.PageRequest{Limit: 2},
+				}
+				expRes = &types.QueryValidatorSlashesByHeightResponse{
+					Slashes: slashes[1:3],
+				}
+			},
+			expPass: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			tc.malleate()
+
+			slashesRes, err := queryClient.ValidatorSlashesByHeight(f.sdkCtx, req)
+
+			if tc.expPass {
+				assert.NilError(t, err)
+				assert.DeepEqual(t, expRes.GetSlashes(), slashesRes.GetSlashes())
+			} else {
+				assert.ErrorContains(t, err, tc.expErrMsg)
+				assert.Assert(t, slashesRes == nil)
+			}
+		})
+	}
+}
+
 func TestGRPCDelegatorWithdrawAddress(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
@@ -77,7 +77,7 @@ func initFixture(t *testing.T) *fixture {
 
 	maccPerms := map[string][]string{
 		pooltypes.ModuleName:           {},
-		distrtypes.ModuleName:          {authtypes.Minter},
+		distrtypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 	}
@@ -114,6 +114,7 @@ func initFixture(t *testing.T) *fixture {
 	distrKeeper := distrkeeper.NewKeeper(
 		cdc, runtime.NewKVStoreService(keys[distrtypes.StoreKey]), accountKeeper, bankKeeper, stakingKeeper, poolKeeper, distrtypes.ModuleName, authority.String(),
 	)
+	require.NoError(t, distrKeeper.Params.Set(newCtx, distrtypes.DefaultParams()))
 
 	authModule := auth.NewAppModule(cdc, accountKeeper, authsims.RandomGenesisAccounts)
 	bankModule := bank.NewAppModule(cdc, bankKeeper, accountKeeper)
@@ -346,6 +347,128 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	}
 }
 
+func TestMsgWithdrawAndCompound(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	require.NoError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, distrtypes.InitialFeePool()))
+	require.NoError(t, f.distrKeeper.Params.Set(f.sdkCtx, distrtypes.DefaultParams()))
+	msgServer := distrkeeper.NewMsgServerImpl(f.distrKeeper)
+	hooks := f.distrKeeper.Hooks()
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+
+	// setup staking validator without commission
+	validator, err := stakingtypes.NewValidator(f.valAddr.String(), PKS[0], stakingtypes.Description{})
+	require.NoError(t, err)
+	validator, err = validator.SetInitialCommission(stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyOneDec()))
+	require.NoError(t, err)
+	delTokens := sdk.TokensFromConsensusPower(2, sdk.DefaultPowerReduction)
+	validator, issuedShares := validator.AddTokensFromDel(delTokens)
+	require.NoError(t, f.stakingKeeper.SetValidator(f.sdkCtx, validator))
+	require.NoError(t, hooks.AfterValidatorCreated(f.sdkCtx, f.valAddr))
+
+	// setup delegation
+	require.NoError(t, hooks.BeforeDelegationCreated(f.sdkCtx, delAddr, f.valAddr))
+	require.NoError(t, f.stakingKeeper.SetDelegation(f.sdkCtx, stakingtypes.NewDelegation(delAddr.String(), f.valAddr.String(), issuedShares)))
+	require.NoError(t, hooks.AfterDelegationModified(f.sdkCtx, delAddr, f.valAddr))
+
+	// allocate rewards in the bond denom and in another denom
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("mytoken", 4))
+	require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, rewards))
+	require.NoError(t, f.distrKeeper.AllocateTokensToValidator(f.sdkCtx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	// the rewards are withdrawn in the next block
+	ctx := f.sdkCtx.WithBlockHeight(f.sdkCtx.BlockHeight() + 1)
+
+	// invalid addresses are rejected
+	_, err = msgServer.WithdrawAndCompound(ctx, distrtypes.NewMsgWithdrawAndCompound(emptyDelAddr.String(), f.valAddr.String()))
+	assert.ErrorContains(t, err, "invalid delegator address")
+	_, err = msgServer.WithdrawAndCompound(ctx, distrtypes.NewMsgWithdrawAndCompound(delAddr.String(), emptyValAddr.String()))
+	assert.ErrorContains(t, err, "invalid validator address")
+
+	res, err := msgServer.WithdrawAndCompound(ctx, distrtypes.NewMsgWithdrawAndCompound(delAddr.String(), f.valAddr.String()))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), res.Compounded)
+	require.Equal(t, math.LegacyNewDec(10), res.Shares)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("mytoken", 4)), res.Withdrawn)
+
+	// the bond denom rewards are delegated to the validator
+	delegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delAddr, f.valAddr))
+	require.NoError(t, err)
+	require.Equal(t, issuedShares.Add(res.Shares), delegation.Shares)
+	validator, err = f.stakingKeeper.GetValidator(ctx, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, delTokens.AddRaw(10), validator.Tokens)
+
+	// and the other rewards are sent to the withdraw address
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("mytoken", 4)), f.bankKeeper.GetAllBalances(ctx, delAddr))
+
+	// without new rewards, nothing is compounded
+	res, err = msgServer.WithdrawAndCompound(ctx, distrtypes.NewMsgWithdrawAndCompound(delAddr.String(), f.valAddr.String()))
+	require.NoError(t, err)
+	require.True(t, res.Compounded.IsZero())
+	require.True(t, res.Shares.IsZero())
+	require.True(t, res.Withdrawn.IsZero())
+}
+
+func TestMsgAllocateValidatorRewards(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	require.NoError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, distrtypes.InitialFeePool()))
+	require.NoError(t, f.distrKeeper.Params.Set(f.sdkCtx, distrtypes.DefaultParams()))
+	msgServer := distrkeeper.NewMsgServerImpl(f.distrKeeper)
+	hooks := f.distrKeeper.Hooks()
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	authority := authtypes.NewModuleAddress("gov")
+
+	// setup staking validator with a 10% commission
+	validator, err := stakingtypes.NewValidator(f.valAddr.String(), PKS[0], stakingtypes.Description{})
+	require.NoError(t, err)
+	rate := math.LegacyNewDecWithPrec(1, 1)
+	validator, err = validator.SetInitialCommission(stakingtypes.NewCommission(rate, math.LegacyOneDec(), math.LegacyOneDec()))
+	require.NoError(t, err)
+	validator, issuedShares := validator.AddTokensFromDel(sdk.TokensFromConsensusPower(2, sdk.DefaultPowerReduction))
+	require.NoError(t, f.stakingKeeper.SetValidator(f.sdkCtx, validator))
+	require.NoError(t, hooks.AfterValidatorCreated(f.sdkCtx, f.valAddr))
+
+	// setup delegation
+	require.NoError(t, hooks.BeforeDelegationCreated(f.sdkCtx, delAddr, f.valAddr))
+	require.NoError(t, f.stakingKeeper.SetDelegation(f.sdkCtx, stakingtypes.NewDelegation(delAddr.String(), f.valAddr.String(), issuedShares)))
+	require.NoError(t, hooks.AfterDelegationModified(f.sdkCtx, delAddr, f.valAddr))
+
+	bonus := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	// the rewards are funded by the authority account
+	_, err = msgServer.AllocateValidatorRewards(f.sdkCtx, distrtypes.NewMsgAllocateValidatorRewards(authority.String(), f.valAddr.String(), bonus))
+	assert.ErrorContains(t, err, "insufficient funds")
+
+	require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, bonus))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, authority, bonus))
+
+	// only to existing validators
+	_, err = msgServer.AllocateValidatorRewards(f.sdkCtx, distrtypes.NewMsgAllocateValidatorRewards(authority.String(), sdk.ValAddress(delAddr).String(), bonus))
+	assert.ErrorContains(t, err, "validator does not exist")
+
+	_, err = msgServer.AllocateValidatorRewards(f.sdkCtx, distrtypes.NewMsgAllocateValidatorRewards(authority.String(), f.valAddr.String(), bonus))
+	require.NoError(t, err)
+	require.True(t, f.bankKeeper.GetAllBalances(f.sdkCtx, authority).IsZero())
+
+	// the commission is paid to the validator
+	commission, err := f.distrKeeper.ValidatorsAccumulatedCommission.Get(f.sdkCtx, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 10)}, commission.Commission)
+
+	// and the delegator withdraws the rest in the next block
+	ctx := f.sdkCtx.WithBlockHeight(f.sdkCtx.BlockHeight() + 1)
+	res, err := msgServer.WithdrawDelegatorReward(ctx, distrtypes.NewMsgWithdrawDelegatorReward(delAddr.String(), f.valAddr.String()))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 90)), res.Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 90)), f.bankKeeper.GetAllBalances(ctx, delAddr))
+}
+
 func TestMsgSetWithdrawAddress(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
@@ -477,6 +600,86 @@ func TestMsgSetWithdrawAddress(t *testing.T) {
 	}
 }
 
+func TestWithdrawAddressAfterDelegationRemoval(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		sticky bool
+	}{
+		{name: "sticky", sticky: true},
+		{name: "not sticky", sticky: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+
+			params := distrtypes.DefaultParams()
+			params.StickyWithdrawAddress = tc.sticky
+			require.NoError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, distrtypes.InitialFeePool()))
+			require.NoError(t, f.distrKeeper.Params.Set(f.sdkCtx, params))
+			msgServer := distrkeeper.NewMsgServerImpl(f.distrKeeper)
+			hooks := f.distrKeeper.Hooks()
+
+			delAddr := sdk.AccAddress(PKS[1].Address())
+			withdrawAddr := sdk.AccAddress(PKS[2].Address())
+
+			// setup staking validator without commission
+			validator, err := stakingtypes.NewValidator(f.valAddr.String(), PKS[0], stakingtypes.Description{})
+			require.NoError(t, err)
+			validator, err = validator.SetInitialCommission(stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyOneDec()))
+			require.NoError(t, err)
+			validator, issuedShares := validator.AddTokensFromDel(sdk.TokensFromConsensusPower(2, sdk.DefaultPowerReduction))
+			require.NoError(t, f.stakingKeeper.SetValidator(f.sdkCtx, validator))
+			require.NoError(t, hooks.AfterValidatorCreated(f.sdkCtx, f.valAddr))
+
+			delegate := func(ctx sdk.Context) {
+				require.NoError(t, hooks.BeforeDelegationCreated(ctx, delAddr, f.valAddr))
+				require.NoError(t, f.stakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr.String(), f.valAddr.String(), issuedShares)))
+				require.NoError(t, hooks.AfterDelegationModified(ctx, delAddr, f.valAddr))
+			}
+			delegate(f.sdkCtx)
+
+			_, err = msgServer.SetWithdrawAddress(f.sdkCtx, distrtypes.NewMsgSetWithdrawAddress(delAddr, withdrawAddr))
+			require.NoError(t, err)
+
+			// the delegator removes its only delegation
+			ctx := f.sdkCtx.WithBlockHeight(f.sdkCtx.BlockHeight() + 1)
+			require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delAddr, f.valAddr))
+			require.NoError(t, hooks.BeforeDelegationRemoved(ctx, delAddr, f.valAddr))
+			delegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delAddr, f.valAddr))
+			require.NoError(t, err)
+			require.NoError(t, f.stakingKeeper.RemoveDelegation(ctx, delegation))
+
+			// the withdraw address is kept with no delegation left only if it
+			// is sticky, the delegator address is used otherwise
+			expWithdrawAddr := delAddr
+			if tc.sticky {
+				expWithdrawAddr = withdrawAddr
+			}
+			addr, err := f.distrKeeper.GetDelegatorWithdrawAddr(ctx, delAddr)
+			require.NoError(t, err)
+			require.Equal(t, expWithdrawAddr, addr)
+
+			// and the rewards of a new delegation are sent to it
+			delegate(ctx)
+			rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+			require.NoError(t, f.bankKeeper.MintCoins(ctx, distrtypes.ModuleName, rewards))
+			require.NoError(t, f.distrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			_, err = msgServer.WithdrawDelegatorReward(ctx, distrtypes.NewMsgWithdrawDelegatorReward(delAddr.String(), f.valAddr.String()))
+			require.NoError(t, err)
+			require.Equal(t, rewards, f.bankKeeper.GetAllBalances(ctx, expWithdrawAddr))
+			if tc.sticky {
+				require.True(t, f.bankKeeper.GetAllBalances(ctx, delAddr).IsZero())
+			}
+		})
+	}
+}
+
 func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
@@ -742,32 +945,46 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "community tax must be positive: -0.200000000000000000",
 		},
 		{
-			name: "base proposer reward set",
+			name: "negative base proposer reward",
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
 					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyNewDecWithPrec(1, 2),
+					BaseProposerReward:  math.LegacyNewDecWithPrec(-1, 2),
 					BonusProposerReward: math.LegacyZeroDec(),
 					WithdrawAddrEnabled: withdrawAddrEnabled,
 				},
 			},
 			expErr:    true,
-			expErrMsg: "cannot update base or bonus proposer reward because these are deprecated fields",
+			expErrMsg: "base proposer reward must be positive: -0.010000000000000000",
 		},
 		{
-			name: "bonus proposer reward set",
+			name: "negative bonus proposer reward",
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
 					CommunityTax:        communityTax,
 					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyNewDecWithPrec(1, 2),
+					BonusProposerReward: math.LegacyNewDecWithPrec(-1, 2),
 					WithdrawAddrEnabled: withdrawAddrEnabled,
 				},
 			},
 			expErr:    true,
-			expErrMsg: "cannot update base or bonus proposer reward because these are deprecated fields",
+			expErrMsg: "bonus proposer reward must be positive: -0.010000000000000000",
+		},
+		{
+			name: "proposer rewards and community tax greater than 1",
+			msg: &distrtypes.MsgUpdateParams{
+				Authority: f.distrKeeper.GetAuthority(),
+				Params: distrtypes.Params{
+					CommunityTax:        communityTax,
+					BaseProposerReward:  math.LegacyNewDecWithPrec(5, 1),
+					BonusProposerReward: math.LegacyNewDecWithPrec(5, 1),
+					WithdrawAddrEnabled: withdrawAddrEnabled,
+				},
+			},
+			expErr:    true,
+			expErrMsg: "sum of community tax, base proposer reward and bonus proposer reward cannot be greater than one",
 		},
 		{
 			name: "all good",
@@ -778,6 +995,11 @@ func TestMsgUpdateParams(t *testing.T) {
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyZeroDec(),
 					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityPoolRoutes: []distrtypes.CommunityPoolRoute{
+						{Module: pooltypes.ModuleName, Weight: math.LegacyOneDec()},
+					},
+					AllocationEpochBlocks:   1,
+					KeepCommunityFundsLocal: true,
 				},
 			},
 			expErr: false,
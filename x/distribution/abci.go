@@ -15,6 +15,11 @@ import (
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	// the maximum withdrawal per block applies anew
+	if err := k.ResetBlockWithdrawals(ctx); err != nil {
+		return err
+	}
+
 	// determine the total power signing the block
 	var previousTotalPower int64
 	for _, vote := range ctx.CometInfo().LastCommit.Votes {
@@ -33,3 +38,21 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
 	return k.PreviousProposer.Set(ctx, consAddr)
 }
+
+// EndBlocker flushes the decimal pool to the community pool every
+// DecimalPoolFlushInterval blocks, so that the dust accumulated by the
+// allocations does not depend on an external call to be spent.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	interval, err := k.GetDecimalPoolFlushInterval(ctx)
+	if err != nil {
+		return err
+	}
+
+	if interval == 0 || ctx.BlockHeight() <= 0 || uint64(ctx.BlockHeight())%interval != 0 {
+		return nil
+	}
+
+	return k.SendDecimalPoolToCommunityPool(ctx)
+}
@@ -0,0 +1,88 @@
+package distribution_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/distribution"
+	"cosmossdk.io/x/distribution/keeper"
+	distrtestutil "cosmossdk.io/x/distribution/testutil"
+	"cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestEndBlockerDecimalPoolFlush(t *testing.T) {
+	testCases := []struct {
+		name       string
+		interval   uint64
+		expFlushes []int64
+	}{
+		{name: "no flush", interval: 0},
+		{name: "every block", interval: 1, expFlushes: []int64{1, 2, 3, 4, 5, 6, 7}},
+		{name: "every three blocks", interval: 3, expFlushes: []int64{3, 6}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(types.StoreKey)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec,
+				runtime.NewKVStoreService(key),
+				accountKeeper,
+				bankKeeper,
+				distrtestutil.NewMockStakingKeeper(ctrl),
+				distrtestutil.NewMockPoolKeeper(ctrl),
+				authtypes.FeeCollectorName,
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := types.DefaultParams()
+			params.DecimalPoolFlushInterval = tc.interval
+			require.NoError(t, distrKeeper.Params.Set(testCtx.Ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(testCtx.Ctx, types.InitialFeePool()))
+
+			// every block adds 1.5stake to the decimal pool before the end
+			// blocker runs
+			dust := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr("1.5")}}
+			var flushes []int64
+			for height := int64(1); height <= 7; height++ {
+				ctx := testCtx.Ctx.WithBlockHeight(height)
+
+				feePool, err := distrKeeper.FeePool.Get(ctx)
+				require.NoError(t, err)
+				feePool.DecimalPool = feePool.DecimalPool.Add(dust...)
+				require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+				before := feePool.CommunityPool
+
+				require.NoError(t, distribution.EndBlocker(ctx, distrKeeper))
+
+				feePool, err = distrKeeper.FeePool.Get(ctx)
+				require.NoError(t, err)
+				if !feePool.CommunityPool.Equal(before) {
+					flushes = append(flushes, height)
+					// only sub-unit amounts are left in the decimal pool
+					require.True(t, feePool.DecimalPool.AmountOf(sdk.DefaultBondDenom).LT(math.LegacyOneDec()))
+				}
+			}
+
+			require.Equal(t, tc.expFlushes, flushes)
+		})
+	}
+}
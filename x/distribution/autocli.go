@@ -39,6 +39,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "TotalOutstandingRewards",
+					Use:       "total-outstanding-rewards",
+					Short:     "Query the sum of the outstanding (un-withdrawn) rewards of all validators",
+					Example:   fmt.Sprintf(`$ %s query distribution total-outstanding-rewards`, version.AppName),
+				},
+				{
+					RpcMethod: "ValidatorsWithOutstandingRewards",
+					Use:       "validators-with-outstanding-rewards",
+					Short:     "Query the validators with non-zero outstanding (un-withdrawn) rewards and their outstanding rewards",
+					Example:   fmt.Sprintf(`$ %s query distribution validators-with-outstanding-rewards`, version.AppName),
+				},
 				{
 					RpcMethod: "ValidatorCommission",
 					Use:       "commission [validator]",
@@ -48,6 +60,63 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "ValidatorCurrentRewards",
+					Use:       "validator-current-rewards [validator]",
+					Short:     "Query the rewards accumulated by a validator in its current period",
+					Example:   fmt.Sprintf(`$ %s query distribution validator-current-rewards [validator-address]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "ValidatorHistoricalRewards",
+					Use:       "validator-historical-rewards [validator] [period]",
+					Short:     "Query the cumulative reward ratio and reference count stored for a past period of a validator",
+					Example:   fmt.Sprintf(`$ %s query distribution validator-historical-rewards [validator-address] 3`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "period"},
+					},
+				},
+				{
+					RpcMethod: "ValidatorEstimatedRewardRate",
+					Use:       "validator-estimated-reward-rate [validator] [lookback-blocks]",
+					Short:     "Query an estimate of the annual reward rate of a validator, derived from its rewards over recent blocks",
+					Long:      "Query an estimate of the annual rewards per bonded token of a validator, derived from the rewards allocated to it over the given number of recent blocks. The estimate is not authoritative, past rewards do not predict future ones.",
+					Example:   fmt.Sprintf(`$ %s query distribution validator-estimated-reward-rate [validator-address] 1000`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "lookback_blocks"},
+					},
+				},
+				{
+					RpcMethod: "ValidatorRewardsFrozen",
+					Use:       "validator-rewards-frozen [validator]",
+					Short:     "Query whether the reward accrual of a validator is frozen",
+					Example:   fmt.Sprintf(`$ %s query distribution validator-rewards-frozen [validator-address]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "ValidatorRewardMultiplier",
+					Use:       "validator-reward-multiplier [validator]",
+					Short:     "Query the multiplier applied to the rewards of a validator",
+					Example:   fmt.Sprintf(`$ %s query distribution validator-reward-multiplier [validator-address]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "ValidatorCommissionWithdrawals",
+					Use:       "commission-withdrawals [validator]",
+					Short:     "Query the recorded commission withdrawals of a validator",
+					Example:   fmt.Sprintf(`$ %s query distribution commission-withdrawals [validator-address]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
 				{
 					RpcMethod: "ValidatorSlashes",
 					Use:       "slashes [validator] [start-height] [end-height]",
@@ -59,6 +128,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "ending_height"},
 					},
 				},
+				{
+					RpcMethod: "ValidatorSlashesByHeight",
+					Use:       "slashes-by-height [validator] [start-height] [end-height]",
+					Short:     "Query distribution validator slashes in a range of block heights",
+					Example:   fmt.Sprintf(`$ %s query distribution slashes-by-height [validator-address] 1 1000`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "starting_height"},
+						{ProtoField: "ending_height"},
+					},
+				},
 				{
 					RpcMethod: "DelegationRewards",
 					Use:       "rewards [delegator-addr] [validator-addr]",
@@ -70,13 +150,60 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegationRewardsProjection",
+					Use:       "rewards-projection [delegator-addr] [validator-addr] [blocks]",
+					Short:     "Query an estimate of the rewards of a delegation a number of blocks ahead",
+					Long:      "Query an estimate of the rewards of a delegation the given number of blocks ahead, projected linearly from the rewards allocated to the validator over the recent sampled blocks. The estimate assumes that the delegation and the validator stay unchanged, it is not a commitment.",
+					Example:   fmt.Sprintf("$ %s query distribution rewards-projection [delegator-address] [validator-address] 1000", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+						{ProtoField: "validator_address"},
+						{ProtoField: "blocks"},
+					},
+				},
+				{
+					RpcMethod: "AllocationParams",
+					Use:       "allocation-params",
+					Short:     "Query the rates applied by the fee allocation, and the vote multiplier they derive",
+					Example:   fmt.Sprintf(`$ %s query distribution allocation-params`, version.AppName),
+				},
+				{
+					RpcMethod: "DecimalPool",
+					Use:       "decimal-pool",
+					Short:     "Query the amount of coins in the decimal pool, i.e. the sub-unit remainder of the fee allocation",
+					Example:   fmt.Sprintf(`$ %s query distribution decimal-pool`, version.AppName),
+				},
+				{
+					RpcMethod: "FeePoolCommunityPool",
+					Use:       "fee-pool-community-pool",
+					Short:     "Query the community pool coins held by the fee pool of the distribution module, credited with the community pool cut of the fees",
+					Example:   fmt.Sprintf(`$ %s query distribution fee-pool-community-pool`, version.AppName),
+				},
+				{
+					RpcMethod: "LastBlockFeesDistributed",
+					Use:       "last-block-fees",
+					Short:     "Query the fees collected and distributed by the allocation of the last block, which were collected in the block before it",
+					Example:   fmt.Sprintf(`$ %s query distribution last-block-fees`, version.AppName),
+				},
 				{
 					RpcMethod: "CommunityPool",
 					Use:       "community-pool",
 					Short:     "Query the amount of coins in the community pool",
 					Example:   fmt.Sprintf(`$ %s query distribution community-pool`, version.AppName),
 				},
+				{
+					RpcMethod: "TotalCommunityFunds",
+					Use:       "total-community-funds",
+					Short:     "Query the amount of coins in the community pool and in the decimal pool, and their sum",
+					Example:   fmt.Sprintf(`$ %s query distribution total-community-funds`, version.AppName),
+				},
+				{
+					RpcMethod: "SimulateAllocation",
+					Skip:      true, // skipped because sent by the custom simulate-allocation command
+				},
 			},
+			EnhanceCustomCommand: true,
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: distributionv1beta1.Msg_ServiceDesc.ServiceName,
@@ -98,6 +225,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawAndCompound",
+					Use:       "withdraw-and-compound [validator-addr]",
+					Short:     "Withdraw rewards from a given delegation address and delegate the bond denom rewards to the same validator",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-and-compound cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
 				{
 					RpcMethod: "WithdrawValidatorCommission",
 					Use:       "withdraw-validator-commission [validator-addr]",
@@ -106,6 +242,32 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "SetValidatorCommissionWithdrawAddress",
+					Use:       "set-commission-withdraw-addr [validator-addr] [withdraw-addr]",
+					Short:     "Change the withdraw address for the commission of a validator (must be a validator operator)",
+					Example:   fmt.Sprintf("%s tx distribution set-commission-withdraw-addr cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "withdraw_address"},
+					},
+				},
+				{
+					RpcMethod: "SetValidatorCommissionExemptDenoms",
+					Use:       "set-commission-exempt-denoms [validator-addr] [denoms...]",
+					Short:     "Set the denoms the commission of a validator is not charged on, no denoms clears the list (must be a validator operator)",
+					Example:   fmt.Sprintf("%s tx distribution set-commission-exempt-denoms cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp uatom --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "denoms", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "SetWithdrawSplit",
+					Use:       "set-withdraw-split",
+					Short:     "Split the rewards associated with an address between several withdraw addresses, an empty split removes the current one",
+					Example:   fmt.Sprintf(`%s tx distribution set-withdraw-split --entries '{"address":"cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p","weight":"0.7"}' --entries '{"address":"cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r","weight":"0.3"}' --from mykey`, version.AppName),
+				},
 				{
 					RpcMethod: "DepositValidatorRewardsPool",
 					Use:       "fund-validator-rewards-pool [validator-addr] [amount]",
@@ -134,10 +296,69 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "SetValidatorCommissionCap",
+					Use:            "set-validator-commission-cap-proposal [validator] [max-rate]",
+					Short:          "Submit a proposal to cap the commission rate the rewards of a validator are split with. Note: an empty max rate removes the cap.",
+					Example:        fmt.Sprintf(`%s tx distribution set-validator-commission-cap-proposal cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp 0.1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "max_rate"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "AllocateValidatorRewards",
+					Use:            "allocate-validator-rewards-proposal [validator] [amount]",
+					Short:          "Submit a proposal to allocate rewards, funded by the governance account, to a validator and its delegators",
+					Example:        fmt.Sprintf(`%s tx distribution allocate-validator-rewards-proposal cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp 1000stake`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SetValidatorRewardsFrozen",
+					Use:            "set-validator-rewards-frozen-proposal [validator] [frozen]",
+					Short:          "Submit a proposal to freeze or unfreeze the reward accrual of a validator, the rewards of a frozen validator going to the community pool",
+					Example:        fmt.Sprintf(`%s tx distribution set-validator-rewards-frozen-proposal cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp true`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "frozen"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SetValidatorCommissionRebated",
+					Use:            "set-validator-commission-rebated-proposal [validator] [rebated]",
+					Short:          "Submit a proposal to rebate part of the commission of a validator to its delegators from the community pool, or to stop rebating it",
+					Example:        fmt.Sprintf(`%s tx distribution set-validator-commission-rebated-proposal cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp true`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "rebated"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SetValidatorRewardMultiplier",
+					Use:            "set-validator-reward-multiplier-proposal [validator] [multiplier]",
+					Short:          "Submit a proposal to apply a multiplier to the rewards of a validator, the difference being taken from or added to the community pool",
+					Example:        fmt.Sprintf(`%s tx distribution set-validator-reward-multiplier-proposal cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp 1.2`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "multiplier"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SetFeeCollectorName",
+					Use:            "set-fee-collector-name-proposal [fee-collector-name]",
+					Short:          "Submit a proposal to change the module account the fees are distributed from",
+					Example:        fmt.Sprintf(`%s tx distribution set-fee-collector-name-proposal protocol_fees`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "fee_collector_name"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:   "CommunityPoolSpendBatch",
+					Use:         "community-pool-spend-batch-proposal",
+					Short:       "Submit a proposal to send coins from the community pool to several recipients, the whole batch failing if the community pool cannot cover it",
+					Example:     fmt.Sprintf(`%s tx distribution community-pool-spend-batch-proposal --spends '{"recipient":"cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p","amount":[{"denom":"stake","amount":"1000"}]}' --spends '{"recipient":"cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r","amount":[{"denom":"stake","amount":"500"}]}'`, version.AppName),
+					GovProposal: true,
+				},
 				{
 					RpcMethod: "CommunityPoolSpend",
 					Skip:      true, // skipped because deprecated in favor of protocolpool
 				},
+				{
+					RpcMethod: "WithdrawAllDelegatorRewards",
+					Skip:      true, // skipped because sent by the custom withdraw-all-rewards command
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// Query flags for the x/distribution module
+var (
+	FlagFees  = "fees"
+	FlagVotes = "votes"
+)
+
+// NewQueryCmd returns a root CLI command handler for the x/distribution query commands
+// that are not generated by autocli.
+func NewQueryCmd() *cobra.Command {
+	distQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the distribution module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	distQueryCmd.AddCommand(
+		NewSimulateAllocationCmd(),
+	)
+
+	return distQueryCmd
+}
+
+// NewSimulateAllocationCmd returns a CLI command handler for dry-running the allocation of
+// hypothetical fees to a set of votes.
+func NewSimulateAllocationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-allocation --fees [coins] [--votes [file]]",
+		Short: "Simulate the allocation of fees to the validators for a block",
+		Long: `Simulate the allocation of the given fees to the validators against the current state,
+and print the outcome for every validator and the cut of the community pool. No state is persisted.
+The votes file holds the votes of the simulated block, by default every bonded validator votes with
+its current power and signs the block:
+
+{
+  "votes": [
+    {"validator_address": "cosmosvaloper1...", "power": "10", "signed": true}
+  ]
+}`,
+		Example: fmt.Sprintf("$ %s query distribution simulate-allocation --fees 1000stake --votes votes.json", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySimulateAllocationRequest{}
+			votesFile, _ := cmd.Flags().GetString(FlagVotes)
+			if votesFile != "" {
+				bz, err := os.ReadFile(votesFile)
+				if err != nil {
+					return err
+				}
+				if err := clientCtx.Codec.UnmarshalJSON(bz, req); err != nil {
+					return fmt.Errorf("failed to parse votes file: %w", err)
+				}
+			}
+
+			feesStr, _ := cmd.Flags().GetString(FlagFees)
+			fees, err := sdk.ParseCoinsNormalized(feesStr)
+			if err != nil {
+				return err
+			}
+			req.Fees = fees
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateAllocation(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagFees, "", "Fees collected in the simulated block")
+	cmd.Flags().String(FlagVotes, "", "JSON file holding the votes of the simulated block")
+	_ = cmd.MarkFlagRequired(FlagFees)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,103 @@
+package cli_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/x/distribution/client/cli"
+	"cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *CLITestSuite) TestQuerySimulateAllocationCmd() {
+	valAddr := sdk.ValAddress("validator_address___").String()
+
+	bz, err := s.encCfg.Codec.Marshal(&types.QuerySimulateAllocationResponse{})
+	s.Require().NoError(err)
+	clientCtx := s.baseCtx.WithClient(clitestutil.NewMockCometRPC(abci.ResponseQuery{Value: bz}))
+
+	dir := s.T().TempDir()
+	votesFile := filepath.Join(dir, "votes.json")
+	s.Require().NoError(os.WriteFile(votesFile, []byte(fmt.Sprintf(`{"votes": [{"validator_address": %q, "power": "10", "signed": true}]}`, valAddr)), 0o600))
+	invalidVotesFile := filepath.Join(dir, "invalid_votes.json")
+	s.Require().NoError(os.WriteFile(invalidVotesFile, []byte(`{"votes": "none"}`), 0o600))
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+	}{
+		{
+			"missing fees",
+			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			`required flag(s) "fees" not set`,
+		},
+		{
+			"invalid fees",
+			[]string{
+				fmt.Sprintf("--%s=stake", cli.FlagFees),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"invalid decimal coin expression",
+		},
+		{
+			"missing votes file",
+			[]string{
+				fmt.Sprintf("--%s=100stake", cli.FlagFees),
+				fmt.Sprintf("--%s=%s", cli.FlagVotes, filepath.Join(dir, "missing.json")),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"no such file or directory",
+		},
+		{
+			"invalid votes file",
+			[]string{
+				fmt.Sprintf("--%s=100stake", cli.FlagFees),
+				fmt.Sprintf("--%s=%s", cli.FlagVotes, invalidVotesFile),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"failed to parse votes file",
+		},
+		{
+			"current validator set",
+			[]string{
+				fmt.Sprintf("--%s=100stake", cli.FlagFees),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"",
+		},
+		{
+			"votes file",
+			[]string{
+				fmt.Sprintf("--%s=100stake", cli.FlagFees),
+				fmt.Sprintf("--%s=%s", cli.FlagVotes, votesFile),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewSimulateAllocationCmd()
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err)
+				res := &types.QuerySimulateAllocationResponse{}
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), res), out.String())
+			}
+		})
+	}
+}
@@ -41,15 +41,23 @@ func NewTxCmd() *cobra.Command {
 	return distTxCmd
 }
 
-// NewWithdrawAllRewardsCmd returns a CLI command handler for creating a MsgWithdrawDelegatorReward transaction.
-// This command is more powerful than AutoCLI generated command as it allows sending batch of messages.
+// NewWithdrawAllRewardsCmd returns a CLI command handler for creating a MsgWithdrawAllDelegatorRewards transaction.
+// If the number of messages per tx is limited, it instead sends batches of MsgWithdrawDelegatorReward.
 func NewWithdrawAllRewardsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "withdraw-all-rewards",
-		Short:   "Withdraw all delegations rewards for a delegator",
-		Example: fmt.Sprintf("%s tx distribution withdraw-all-rewards --from mykey", version.AppName),
-		Args:    cobra.NoArgs,
-		RunE: func(cmd *cobra.Command, _ []string) error {
+		Use:   "withdraw-all-rewards [delegator]",
+		Short: "Withdraw all delegations rewards for a delegator",
+		Long: `Withdraw all delegations rewards for a delegator, sent to its withdraw address.
+The delegator can be given as a key name or an address, it defaults to the --from flag.`,
+		Example: fmt.Sprintf("%s tx distribution withdraw-all-rewards mykey", version.AppName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+					return err
+				}
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -59,6 +67,12 @@ func NewWithdrawAllRewardsCmd() *cobra.Command {
 				return err
 			}
 
+			chunkSize, _ := cmd.Flags().GetInt(FlagMaxMessagesPerTx)
+			if chunkSize == 0 {
+				msg := types.NewMsgWithdrawAllDelegatorRewards(delAddr)
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
 			// The transaction cannot be generated offline since it requires a query
 			// to get all the validators.
 			if clientCtx.Offline {
@@ -84,11 +98,6 @@ func NewWithdrawAllRewardsCmd() *cobra.Command {
 				msgs = append(msgs, msg)
 			}
 
-			chunkSize, _ := cmd.Flags().GetInt(FlagMaxMessagesPerTx)
-			if chunkSize == 0 {
-				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
-			}
-
 			// split messages into slices of length chunkSize
 			totalMessages := len(msgs)
 			for i := 0; i < len(msgs); i += chunkSize {
@@ -108,7 +117,7 @@ func NewWithdrawAllRewardsCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().Int(FlagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx (0 for unlimited)")
+	cmd.Flags().Int(FlagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx, sending one MsgWithdrawDelegatorReward per validator (0 for a single MsgWithdrawAllDelegatorRewards)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
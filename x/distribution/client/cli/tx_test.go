@@ -92,6 +92,7 @@ func (s *CLITestSuite) TestTxWithdrawAllRewardsCmd() {
 			"invalid transaction (offline)",
 			[]string{
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=1", cli.FlagMaxMessagesPerTx),
 				fmt.Sprintf("--%s=true", flags.FlagOffline),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
@@ -108,6 +109,16 @@ func (s *CLITestSuite) TestTxWithdrawAllRewardsCmd() {
 			},
 			"",
 		},
+		{
+			"valid transaction with delegator argument",
+			[]string{
+				val[0].Address.String(),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			"",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,146 +1,2065 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	metrics "github.com/hashicorp/go-metrics"
+	"golang.org/x/sync/errgroup"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/comet"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/distribution/types"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // AllocateTokens performs reward and fee distribution to all validators based
 // on the F1 fee distribution specification.
 func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bondedVotes []comet.VoteInfo) error {
+	_, err := k.AllocateTokensWithResult(ctx, totalPreviousPower, bondedVotes)
+	return err
+}
+
+// AllocateTokensWithResult performs the same allocation as AllocateTokens and
+// returns the totals allocated to validators and to the community pool.
+//
+// The allocation runs in a branched context, written only if it succeeds as a
+// whole: on any failure, neither the fee pool update nor the coins sent to the
+// community pool routes are committed, and the collected fees are left in the
+// fee collectors to be allocated with the fees of a later block.
+func (k Keeper) AllocateTokensWithResult(ctx context.Context, totalPreviousPower int64, bondedVotes []comet.VoteInfo) (types.AllocationResult, error) {
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	result, err := k.allocateTokens(cacheCtx, totalPreviousPower, bondedVotes)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+	write()
+
+	emitAllocationTelemetry(result)
+	k.logAllocationSummary(ctx, result)
+	return result, nil
+}
+
+// allocateTokens collects the fees and allocates them to the bonded votes and
+// the community pool.
+func (k Keeper) allocateTokens(ctx context.Context, totalPreviousPower int64, bondedVotes []comet.VoteInfo) (types.AllocationResult, error) {
+	if err := validateVotes(bondedVotes); err != nil {
+		return types.AllocationResult{}, err
+	}
+
 	// fetch and clear the collected fees for distribution, since this is
 	// called in BeginBlock, collected fees will be from the previous block
 	// (and distributed to the previous proposer)
-	feeCollector := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName)
-	feesCollectedInt := k.bankKeeper.GetAllBalances(ctx, feeCollector.GetAddress())
-	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt...)
+	feeCollectorNames, err := k.GetFeeCollectorNames(ctx)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	feesCollectedInt := sdk.NewCoins()
+	for _, feeCollectorName := range feeCollectorNames {
+		feeCollector := k.authKeeper.GetModuleAccount(ctx, feeCollectorName)
+
+		balances, err := types.SanitizeFees(k.bankKeeper.GetAllBalances(ctx, feeCollector.GetAddress()))
+		if err != nil {
+			return types.AllocationResult{}, fmt.Errorf("fees collected by %s: %w", feeCollectorName, err)
+		}
 
-	// transfer collected fees to the distribution module account
-	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, feesCollectedInt)
+		// fees in denoms that are not distributable are left in the fee
+		// collector
+		balances, err = k.getDistributableFees(ctx, balances)
+		if err != nil {
+			return types.AllocationResult{}, err
+		}
+
+		// transfer collected fees to the distribution module account
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, feeCollectorName, types.ModuleName, balances)
+		if err != nil {
+			return types.AllocationResult{}, err
+		}
+
+		feesCollectedInt = feesCollectedInt.Add(balances...)
+	}
+
+	// burn a share of the collected fees before any of them is allocated
+	burned, err := k.burnFees(ctx, feesCollectedInt)
 	if err != nil {
-		return err
+		return types.AllocationResult{}, err
+	}
+
+	// send the infra fund cut of the fees left after the burn before any of
+	// them is allocated
+	infraFund, err := k.sendInfraFundFees(ctx, feesCollectedInt.Sub(burned...))
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	// measure the state changed by the allocation, for the reward
+	// conservation invariant
+	outstandingBefore := k.GetTotalRewards(ctx)
+	escrowedBefore := k.GetTotalEscrowedRewards(ctx)
+	feePoolBefore, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	result, err := k.allocateEpochFees(ctx, feesCollectedInt, burned, infraFund, totalPreviousPower, bondedVotes)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	// send the community pool cut routed to the module accounts
+	result.Routed, err = k.sendRoutedCommunityFunds(ctx, result.Routed)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+	routed := sdk.DecCoins{}
+	for _, r := range result.Routed {
+		routed = routed.Add(sdk.NewDecCoinsFromCoins(r.Amount...)...)
+	}
+
+	outstandingAfter := k.GetTotalRewards(ctx)
+	escrowedAfter := k.GetTotalEscrowedRewards(ctx)
+	feePoolAfter, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	err = k.LastAllocationRecord.Set(ctx, types.AllocationRecord{
+		Fees:                     result.TotalFees,
+		OutstandingRewardsBefore: outstandingBefore,
+		OutstandingRewardsAfter:  outstandingAfter,
+		CommunityPoolBefore:      feePoolBefore.CommunityPool,
+		CommunityPoolAfter:       feePoolAfter.CommunityPool,
+		DecimalPoolBefore:        feePoolBefore.DecimalPool,
+		DecimalPoolAfter:         feePoolAfter.DecimalPool,
+		EscrowedRewardsBefore:    escrowedBefore,
+		EscrowedRewardsAfter:     escrowedAfter,
+		Routed:                   routed,
+		CarriedFeesBefore:        feePoolBefore.CarriedFees,
+		CarriedFeesAfter:         feePoolAfter.CarriedFees,
+	})
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	err = k.LastBlockFees.Set(ctx, types.BlockFees{
+		Height:          sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		FeesCollected:   feesCollectedInt,
+		FeesDistributed: result.TotalFees,
+	})
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	return result, nil
+}
+
+// SimulateAllocation performs the allocation of the given fees to the bonded
+// votes in a cached context, which is discarded, and returns the totals of the
+// allocation and the rewards allocated to every validator that voted or
+// proposed the previous block. No state is persisted, no fee is burned and
+// neither the reward listeners nor the distribution hooks are called. The fees
+// in denoms that are not distributable are ignored.
+func (k Keeper) SimulateAllocation(ctx context.Context, fees sdk.Coins, totalPreviousPower int64, bondedVotes []comet.VoteInfo) (types.AllocationResult, []types.SimulatedValidatorAllocation, error) {
+	if err := validateVotes(bondedVotes); err != nil {
+		return types.AllocationResult{}, nil, err
+	}
+
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	// the validators are reported in the order of the votes, followed by the
+	// previous proposer if it did not vote
+	consAddrs := make([]sdk.ConsAddress, 0, len(bondedVotes)+1)
+	for _, vote := range bondedVotes {
+		consAddrs = append(consAddrs, vote.Validator.Address)
+	}
+	proposer, err := k.PreviousProposer.Get(cacheCtx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.AllocationResult{}, nil, err
+	}
+	if len(proposer) > 0 {
+		consAddrs = append(consAddrs, proposer)
+	}
+
+	var valAddrs []sdk.ValAddress
+	seen := make(map[string]bool, len(consAddrs))
+	for _, consAddr := range consAddrs {
+		validator, err := k.stakingKeeper.ValidatorByConsAddr(cacheCtx, consAddr)
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) || (err == nil && validator == nil) {
+			continue
+		}
+		if err != nil {
+			return types.AllocationResult{}, nil, err
+		}
+
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return types.AllocationResult{}, nil, err
+		}
+		if seen[string(valAddr)] {
+			continue
+		}
+		seen[string(valAddr)] = true
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	rewardsBefore := make([]sdk.DecCoins, len(valAddrs))
+	commissionBefore := make([]sdk.DecCoins, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		rewardsBefore[i], commissionBefore[i], err = k.getValidatorRewardsAndCommission(cacheCtx, valAddr)
+		if err != nil {
+			return types.AllocationResult{}, nil, err
+		}
+	}
+
+	fees, err = types.SanitizeFees(fees)
+	if err != nil {
+		return types.AllocationResult{}, nil, err
+	}
+
+	fees, err = k.getDistributableFees(cacheCtx, fees)
+	if err != nil {
+		return types.AllocationResult{}, nil, err
+	}
+
+	burned, err := k.getBurnedFees(cacheCtx, fees)
+	if err != nil {
+		return types.AllocationResult{}, nil, err
+	}
+
+	infraFund, err := k.getInfraFundFees(cacheCtx, fees.Sub(burned...))
+	if err != nil {
+		return types.AllocationResult{}, nil, err
+	}
+
+	// neither the listeners nor the hooks are notified of a simulated
+	// allocation
+	simulator := k
+	simulator.rewardListeners = nil
+	simulator.hooks = nil
+	result, err := simulator.allocateFees(cacheCtx, fees, burned, infraFund, totalPreviousPower, bondedVotes)
+	if err != nil {
+		return types.AllocationResult{}, nil, err
+	}
+
+	validators := make([]types.SimulatedValidatorAllocation, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		rewards, commission, err := k.getValidatorRewardsAndCommission(cacheCtx, valAddr)
+		if err != nil {
+			return types.AllocationResult{}, nil, err
+		}
+
+		validators[i] = types.SimulatedValidatorAllocation{
+			ValidatorAddress: valAddr.String(),
+			Rewards:          rewards.Sub(rewardsBefore[i]),
+			Commission:       commission.Sub(commissionBefore[i]),
+		}
+	}
+
+	return result, validators, nil
+}
+
+// ComputeAllocation dry-runs the allocation of the given fees, as
+// SimulateAllocation does, and returns the rewards, commission included, that
+// every validator would be allocated, keyed by operator address, and the
+// amount the community pool would receive. No state is written, no event is
+// emitted and neither the reward listeners nor the distribution hooks are
+// called, which lets a change of the community tax be modeled before it is
+// enacted.
+func (k Keeper) ComputeAllocation(ctx context.Context, fees sdk.Coins, totalPreviousPower int64, bondedVotes []comet.VoteInfo) (map[string]sdk.DecCoins, sdk.DecCoins, error) {
+	result, validators, err := k.SimulateAllocation(ctx, fees, totalPreviousPower, bondedVotes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allocations := make(map[string]sdk.DecCoins, len(validators))
+	for _, validator := range validators {
+		allocations[validator.ValidatorAddress] = validator.Rewards
+	}
+
+	return allocations, result.ToCommunityPool, nil
+}
+
+// getValidatorRewardsAndCommission returns the outstanding rewards and the
+// accumulated commission of a validator, empty if it has none.
+func (k Keeper) getValidatorRewardsAndCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.DecCoins, sdk.DecCoins, error) {
+	outstanding, err := k.ValidatorOutstandingRewards.Get(ctx, valAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, nil, err
+	}
+
+	commission, err := k.ValidatorsAccumulatedCommission.Get(ctx, valAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, nil, err
+	}
+
+	return outstanding.Rewards, commission.Commission, nil
+}
+
+// allocateFees allocates the collected fees, net of the burned fees, to the
+// proposer, the validators and the community pool. The fees must already be
+// held by the distribution module account.
+func (k Keeper) allocateFees(ctx context.Context, feesCollectedInt, burned, infraFund sdk.Coins, totalPreviousPower int64, bondedVotes []comet.VoteInfo) (types.AllocationResult, error) {
+	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt.Sub(burned...).Sub(infraFund...)...)
+
+	result := types.AllocationResult{
+		TotalFees:       feesCollected,
+		Burned:          sdk.NewDecCoinsFromCoins(burned...),
+		ToInfraFund:     sdk.NewDecCoinsFromCoins(infraFund...),
+		Subsidy:         sdk.DecCoins{},
+		Escrowed:        sdk.DecCoins{},
+		RoundingDeficit: sdk.DecCoins{},
+		ToValidators:    sdk.DecCoins{},
+		ToProposer:      sdk.DecCoins{},
+		ToCommunityPool: sdk.DecCoins{},
+		Remainder:       sdk.DecCoins{},
 	}
 
 	// temporary workaround to keep CanWithdrawInvariant happy
 	// general discussions here: https://github.com/cosmos/cosmos-sdk/issues/2906#issuecomment-441867634
 	feePool, err := k.FeePool.Get(ctx)
 	if err != nil {
-		return err
+		return types.AllocationResult{}, err
+	}
+
+	// the params are read once for the whole allocation, rather than by every
+	// lookup of every validator
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	// while the distribution is paused, the collected fees are held in the
+	// decimal pool and no validator nor the community pool is paid
+	if params.DistributionPaused {
+		feePool.DecimalPool = feePool.DecimalPool.Add(feesCollected...)
+		result.Remainder = feesCollected
+		return k.finalizeAllocation(ctx, feePool, result)
 	}
 
 	if totalPreviousPower == 0 {
-		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected...)
-		return k.FeePool.Set(ctx, feePool)
+		feePool, err = k.allocateZeroPowerFees(ctx, feePool, feesCollected, &result)
+		if err != nil {
+			return types.AllocationResult{}, err
+		}
+		return k.finalizeAllocation(ctx, feePool, result)
+	}
+
+	if err := k.checkVotePower(ctx, totalPreviousPower, bondedVotes); err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	// allocate the fees carried over from the blocks with no bonded power
+	// with the fees of this block
+	if !feePool.CarriedFees.IsZero() {
+		result.Released = feePool.CarriedFees
+		feesCollected = feesCollected.Add(feePool.CarriedFees...)
+		feePool.CarriedFees = sdk.DecCoins{}
+	}
+
+	// top up low fees from the community pool, the subsidy is allocated with
+	// the fees but is not counted as collected
+	subsidy, err := k.getSubsidy(ctx, feesCollected, feePool.CommunityPool)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+	if !subsidy.IsZero() {
+		feePool.CommunityPool = feePool.CommunityPool.Sub(subsidy)
+		feesCollected = feesCollected.Add(subsidy...)
+		result.Subsidy = subsidy
+		k.emitSubsidizeRewardsEvent(ctx, subsidy)
 	}
 
 	// calculate fraction allocated to validators
 	remaining := feesCollected
-	communityTax, err := k.GetCommunityTax(ctx)
+	communityTax := params.CommunityTax
+
+	// a tax outside of [0, 1) would result in a negative or zero vote multiplier
+	if communityTax.IsNegative() || communityTax.GTE(math.LegacyOneDec()) {
+		return types.AllocationResult{}, errorsmod.Wrapf(types.ErrInvalidCommunityTax, "community tax must be in [0, 1): %s", communityTax)
+	}
+
+	// pay the proposer of the previous block, if a proposer reward is set
+	proposerMultiplier, err := k.getProposerMultiplier(ctx, totalPreviousPower, bondedVotes)
 	if err != nil {
-		return err
+		return types.AllocationResult{}, err
+	}
+
+	if proposerMultiplier.IsPositive() {
+		proposerReward := feesCollected.MulDecTruncate(proposerMultiplier)
+		paid, rebate, err := k.allocateProposerReward(ctx, params, proposerReward, feePool.CommunityPool)
+		if err != nil {
+			return types.AllocationResult{}, err
+		}
+		feePool.CommunityPool = feePool.CommunityPool.Sub(rebate)
+
+		// an unknown proposer's reward is added to the community pool
+		if paid {
+			remaining = remaining.Sub(proposerReward)
+			result.ToProposer = proposerReward
+			result.ToValidators = result.ToValidators.Add(proposerReward...)
+		}
 	}
 
-	voteMultiplier := math.LegacyOneDec().Sub(communityTax)
+	result.CommunityTax = feesCollected.MulDecTruncate(communityTax)
+	voteMultiplier := math.LegacyOneDec().Sub(proposerMultiplier).Sub(communityTax)
 	feeMultiplier := feesCollected.MulDecTruncate(voteMultiplier)
 
-	// allocate tokens proportionally to voting power
-	//
-	// TODO: Consider parallelizing later
-	//
-	// Ref: https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
-	for _, vote := range bondedVotes {
+	unrewarded := getUnrewardedVotes(bondedVotes, params.MaxRewardedValidators)
+
+	uptimeFactors, err := k.getUptimeFactors(ctx)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	weights, totalWeight, err := k.getPowerWeights(ctx, totalPreviousPower, bondedVotes)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	// compute the reward of every validator proportionally to voting power,
+	// the over-allocation of the rounded rewards is drawn from the decimal pool.
+	// The shares only depend on the weights, they are computed by a bounded
+	// pool of goroutines and do not touch the store.
+	shares, deficit := getValidatorShares(feeMultiplier, weights, totalWeight, params.RoundingMode, feePool.DecimalPool, k.getShareWorkers())
+	if !deficit.IsZero() {
+		feePool.DecimalPool = feePool.DecimalPool.Sub(deficit)
+		remaining = remaining.Add(deficit...)
+		result.RoundingDeficit = deficit
+	}
+
+	rewards := make([]validatorReward, 0, len(bondedVotes))
+	rewardIndexes := make(map[string]int, len(bondedVotes))
+	unallocated := sdk.DecCoins{}
+	for i, vote := range bondedVotes {
+		// the allocation of a large validator set is aborted once the
+		// context is canceled, e.g. by the deadline of a simulation query
+		if err := ctx.Err(); err != nil {
+			return types.AllocationResult{}, err
+		}
+
+		// a validator without power is not entitled to any reward, skip it
+		// to avoid zero-amount writes and events
+		if vote.Validator.Power == 0 {
+			continue
+		}
+
+		reward := shares[i]
+
+		// a validator beyond the maximum number of rewarded validators is
+		// skipped before it is looked up, its share is added to the community
+		// pool
+		if unrewarded[i] {
+			unallocated = unallocated.Add(reward...)
+			continue
+		}
 
 		validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
-		if err != nil {
-			return err
+		if err != nil && !errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return types.AllocationResult{}, fmt.Errorf("failed to get validator with consensus address %s: %w", sdk.ConsAddress(vote.Validator.Address), err)
 		}
 
 		// TODO: Consider micro-slashing for missing votes.
 		//
 		// Ref: https://github.com/cosmos/cosmos-sdk/issues/2525#issuecomment-430838701
-		powerFraction := math.LegacyNewDec(vote.Validator.Power).QuoTruncate(math.LegacyNewDec(totalPreviousPower))
-		reward := feeMultiplier.MulDecTruncate(powerFraction)
 
-		err = k.AllocateTokensToValidator(ctx, validator, reward)
+		// the validator may have been removed since it voted, its share is
+		// added to the community pool instead of halting the allocation
+		if err != nil || validator == nil {
+			k.Logger(ctx).Error(
+				"validator of a bonded vote not found, allocating its reward to the community pool",
+				"validator", sdk.ConsAddress(vote.Validator.Address).String(),
+				"reward", reward.String(),
+			)
+			unallocated = unallocated.Add(reward...)
+			continue
+		}
+
+		// a jailed validator may still be found in the bonded votes, its
+		// share is withheld and added to the community pool if configured
+		if params.WithholdJailedRewards && validator.IsJailed() {
+			unallocated = unallocated.Add(reward...)
+			continue
+		}
+
+		// otherwise its share is escrowed until it is bonded again, unless
+		// it is tombstoned, in which case its share and its escrow are
+		// forfeited to the community pool
+		if params.EscrowJailedRewards && validator.IsJailed() {
+			valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+			if err != nil {
+				return types.AllocationResult{}, wrapValidatorError(err, vote.Validator.Address, validator)
+			}
+
+			if k.tombstoneFunc != nil && k.tombstoneFunc(ctx, vote.Validator.Address) {
+				escrowed, err := k.removeEscrowedRewards(ctx, valAddr)
+				if err != nil {
+					return types.AllocationResult{}, wrapValidatorError(err, vote.Validator.Address, validator)
+				}
+				if !escrowed.IsZero() {
+					feePool.CommunityPool = feePool.CommunityPool.Add(escrowed...)
+					k.emitEscrowEvent(ctx, types.EventTypeForfeitEscrowedRewards, valAddr, escrowed)
+				}
+				unallocated = unallocated.Add(reward...)
+				continue
+			}
+
+			if err := k.escrowRewards(ctx, valAddr, reward); err != nil {
+				return types.AllocationResult{}, wrapValidatorError(err, vote.Validator.Address, validator)
+			}
+			result.Escrowed = result.Escrowed.Add(reward...)
+			continue
+		}
+
+		// the share of a validator whose self-delegation is below the
+		// minimum is added to the community pool
+		if minSelfBond := params.MinSelfBondForRewards; !minSelfBond.IsNil() && minSelfBond.IsPositive() {
+			selfBond, err := k.getSelfBond(ctx, validator)
+			if err != nil {
+				return types.AllocationResult{}, wrapValidatorError(err, vote.Validator.Address, validator)
+			}
+			if selfBond.LT(minSelfBond) {
+				unallocated = unallocated.Add(reward...)
+				continue
+			}
+		}
+
+		// a validator appearing several times in the votes is allocated the
+		// sum of its rewards at once, in the position of its first vote
+		if j, ok := rewardIndexes[string(vote.Validator.Address)]; ok {
+			rewards[j].power += vote.Validator.Power
+			rewards[j].reward = rewards[j].reward.Add(reward...)
+			continue
+		}
+
+		rewardIndexes[string(vote.Validator.Address)] = len(rewards)
+		rewards = append(rewards, validatorReward{
+			consAddr:  vote.Validator.Address,
+			validator: validator,
+			power:     vote.Validator.Power,
+			reward:    reward,
+		})
+	}
+
+	// the truncation remainder of the validators' share is kept apart in the
+	// decimal pool, unless the remainder strategy allocates it to validators
+	remainder := feeMultiplier.Add(deficit...).Sub(unallocated).Sub(result.Escrowed)
+	for _, r := range rewards {
+		remainder = remainder.Sub(r.reward)
+	}
+	rewards, remainder, err = k.allocateRemainder(ctx, rewards, remainder)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	// allocate tokens to validators
+	//
+	// NOTE: the state writes and the subtraction from remaining below must be
+	// applied serially and in the order of bondedVotes, as provided by
+	// consensus, so that every node computes byte-identical state regardless
+	// of its number of cores. Do not parallelize this loop: the store is not
+	// safe for concurrent writes. Only the computation of the shares above is
+	// parallel. The time spent on large validator sets is measured by
+	// BenchmarkAllocateTokensLargeValidatorSet.
+	//
+	// Ref: https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
+	for _, r := range rewards {
+		if err := ctx.Err(); err != nil {
+			return types.AllocationResult{}, err
+		}
+
+		// the reward of a validator whose reward accrual is frozen is left in
+		// the remaining fees, added to the community pool
+		valBz, frozen, err := k.isRewardsFrozen(ctx, r.validator)
 		if err != nil {
-			return err
+			return types.AllocationResult{}, wrapValidatorError(err, r.consAddr, r.validator)
+		}
+		if frozen {
+			k.emitEscrowEvent(ctx, types.EventTypeRedirectFrozenRewards, valBz, r.reward)
+			continue
 		}
 
+		// the reduction of the reward of a validator that missed blocks is
+		// left in the remaining fees, added to the community pool
+		reward := applyUptimeFactor(uptimeFactors, r.consAddr, r.reward)
+
+		// the penalty of a validator's reward multiplier is left in the
+		// remaining fees, added to the community pool, and its bonus is taken
+		// from the community pool
+		reward, bonus, err := k.applyRewardMultiplier(ctx, valBz, reward, feePool.CommunityPool)
+		if err != nil {
+			return types.AllocationResult{}, wrapValidatorError(err, r.consAddr, r.validator)
+		}
+		feePool.CommunityPool = feePool.CommunityPool.Sub(bonus)
+
+		rebate, err := k.allocateTokensToValidator(ctx, params, r.validator, reward.Add(bonus...), feePool.CommunityPool, !k.aggregateRewardEvents)
+		if err != nil {
+			return types.AllocationResult{}, wrapValidatorError(err, r.consAddr, r.validator)
+		}
+
+		feePool.CommunityPool = feePool.CommunityPool.Sub(rebate)
 		remaining = remaining.Sub(reward)
+		result.ToValidators = result.ToValidators.Add(reward...)
+		result.ValidatorsRewarded++
 	}
 
 	// allocate community funding
-	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
-	return k.FeePool.Set(ctx, feePool)
+	remaining = remaining.Sub(result.Escrowed)
+	result.Remainder = remainder
+	result.ToCommunityPool, result.Routed, err = k.routeCommunityFunds(ctx, remaining.Sub(result.Remainder))
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+	feePool.CommunityPool = feePool.CommunityPool.Add(result.ToCommunityPool...)
+	feePool.DecimalPool = feePool.DecimalPool.Add(result.Remainder...)
+	k.emitDecimalPoolRemainderEvent(ctx, result.Remainder)
+	return k.finalizeAllocation(ctx, feePool, result)
 }
 
-// AllocateTokensToValidator allocate tokens to a particular validator,
-// splitting according to commission.
-func (k Keeper) AllocateTokensToValidator(ctx context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error {
-	// split tokens between validator and delegators according to commission
-	commission := tokens.MulDec(val.GetCommission())
-	shared := tokens.Sub(commission)
+// allocateZeroPowerFees allocates the fees collected in a block with no bonded
+// power according to the zero power strategy, and records the allocation in
+// the result. It returns the updated fee pool.
+func (k Keeper) allocateZeroPowerFees(ctx context.Context, feePool types.FeePool, feesCollected sdk.DecCoins, result *types.AllocationResult) (types.FeePool, error) {
+	strategy, err := k.GetZeroPowerStrategy(ctx)
+	if err != nil {
+		return types.FeePool{}, err
+	}
 
-	valBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+	switch strategy {
+	case types.ZeroPowerStrategyDecimalPool:
+		feePool.DecimalPool = feePool.DecimalPool.Add(feesCollected...)
+		result.Remainder = feesCollected
+		k.emitDecimalPoolRemainderEvent(ctx, feesCollected)
+	case types.ZeroPowerStrategyCarry:
+		feePool.CarriedFees = feePool.CarriedFees.Add(feesCollected...)
+		result.Carried = feesCollected
+	default:
+		result.ToCommunityPool, result.Routed, err = k.routeCommunityFunds(ctx, feesCollected)
+		if err != nil {
+			return types.FeePool{}, err
+		}
+		feePool.CommunityPool = feePool.CommunityPool.Add(result.ToCommunityPool...)
+	}
+
+	return feePool, nil
+}
+
+// getSubsidy returns, per denom of the subsidy floor, the difference between
+// the floor and the fees, capped by the amount held by the community pool so
+// that the subsidy never drains the pool below zero.
+func (k Keeper) getSubsidy(ctx context.Context, fees, communityPool sdk.DecCoins) (sdk.DecCoins, error) {
+	floor, err := k.GetSubsidyFloor(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// update current commission
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeCommission,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
-			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator()),
-		),
-	)
-	currentCommission, err := k.ValidatorsAccumulatedCommission.Get(ctx, valBz)
-	if err != nil && !errors.Is(err, collections.ErrNotFound) {
-		return err
+	subsidy := sdk.DecCoins{}
+	for _, coin := range floor {
+		shortfall := math.LegacyNewDecFromInt(coin.Amount).Sub(fees.AmountOf(coin.Denom))
+		shortfall = math.LegacyMinDec(shortfall, communityPool.AmountOf(coin.Denom))
+		if shortfall.IsPositive() {
+			subsidy = subsidy.Add(sdk.NewDecCoinFromDec(coin.Denom, shortfall))
+		}
 	}
 
-	currentCommission.Commission = currentCommission.Commission.Add(commission...)
-	err = k.ValidatorsAccumulatedCommission.Set(ctx, valBz, currentCommission)
+	return subsidy, nil
+}
+
+// routeCommunityFunds splits the community pool cut of the collected fees
+// between the community pool routes. It returns the amount added to the
+// community pool, i.e. the share routed to x/protocolpool, and the whole coins
+// to send to the module accounts of the other routes. The first route is
+// allocated the dust of the others; if it is not x/protocolpool, its sub-unit
+// dust, which cannot be sent, is added to the community pool. The share of
+// x/protocolpool in the denoms with a community pool destination is then sent
+// to the destination modules, and, unless the community funds are kept local,
+// the whole coins of the rest of it to x/protocolpool.
+func (k Keeper) routeCommunityFunds(ctx context.Context, cut sdk.DecCoins) (sdk.DecCoins, []types.RoutedCommunityFunds, error) {
+	toCommunityPool, routed, err := k.splitCommunityFunds(ctx, cut)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	// update current rewards
-	currentRewards, err := k.ValidatorCurrentRewards.Get(ctx, valBz)
-	// if the rewards do not exist it's fine, we will just add to zero.
-	if err != nil && !errors.Is(err, collections.ErrNotFound) {
-		return err
+	toCommunityPool, routed, err = k.routeCommunityPoolDenoms(ctx, toCommunityPool, routed)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	currentRewards.Rewards = currentRewards.Rewards.Add(shared...)
-	err = k.ValidatorCurrentRewards.Set(ctx, valBz, currentRewards)
+	keepLocal, err := k.GetKeepCommunityFundsLocal(ctx)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+	if keepLocal {
+		return toCommunityPool, routed, nil
 	}
 
-	// update outstanding rewards
-	sdkCtx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeRewards,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
-			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator()),
-		),
-	)
+	// only the sub-unit dust, which cannot be sent, stays in the fee pool
+	coins, dust := toCommunityPool.TruncateDecimal()
+	if !coins.IsZero() {
+		routed = addRoutedCommunityFunds(routed, pooltypes.ModuleName, coins...)
+	}
+	return dust, routed, nil
+}
 
-	outstanding, err := k.ValidatorOutstandingRewards.Get(ctx, valBz)
-	if err != nil && !errors.Is(err, collections.ErrNotFound) {
-		return err
+// checkCommunityFundsDestination returns an error if the community pool cut
+// is not kept local while there is no x/protocolpool module account to send
+// it to.
+func (k Keeper) checkCommunityFundsDestination(params types.Params) error {
+	if params.KeepCommunityFundsLocal || k.authKeeper.GetModuleAddress(pooltypes.ModuleName) != nil {
+		return nil
 	}
 
-	outstanding.Rewards = outstanding.Rewards.Add(tokens...)
-	return k.ValidatorOutstandingRewards.Set(ctx, valBz, outstanding)
+	return errorsmod.Wrapf(types.ErrCommunityPoolUnavailable, "community funds cannot be sent without a %s module account", pooltypes.ModuleName)
+}
+
+// splitCommunityFunds splits the community pool cut between the community
+// pool routes, returning the share of x/protocolpool and the whole coins of
+// the other routes.
+func (k Keeper) splitCommunityFunds(ctx context.Context, cut sdk.DecCoins) (sdk.DecCoins, []types.RoutedCommunityFunds, error) {
+	routes, err := k.GetCommunityPoolRoutes(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(routes) == 0 || cut.IsZero() {
+		return cut, nil, nil
+	}
+
+	toCommunityPool := sdk.DecCoins{}
+	var routed []types.RoutedCommunityFunds
+	allocate := func(module string, amount sdk.DecCoins) {
+		if module == pooltypes.ModuleName {
+			toCommunityPool = toCommunityPool.Add(amount...)
+			return
+		}
+
+		coins, dust := amount.TruncateDecimal()
+		toCommunityPool = toCommunityPool.Add(dust...)
+		if !coins.IsZero() {
+			routed = append(routed, types.RoutedCommunityFunds{Module: module, Amount: coins})
+		}
+	}
+
+	rest := cut
+	for _, route := range routes[1:] {
+		share := cut.MulDecTruncate(route.Weight)
+		if route.Module != pooltypes.ModuleName {
+			coins, _ := share.TruncateDecimal()
+			share = sdk.NewDecCoinsFromCoins(coins...)
+		}
+		rest = rest.Sub(share)
+		allocate(route.Module, share)
+	}
+	allocate(routes[0].Module, rest)
+
+	return toCommunityPool, routed, nil
+}
+
+// routeCommunityPoolDenoms takes the whole coins of the denoms with a
+// community pool destination out of the amount added to the community pool,
+// and adds them to the coins routed to the destination modules. The sub-unit
+// dust of these denoms, which cannot be sent, stays in the community pool.
+func (k Keeper) routeCommunityPoolDenoms(ctx context.Context, toCommunityPool sdk.DecCoins, routed []types.RoutedCommunityFunds) (sdk.DecCoins, []types.RoutedCommunityFunds, error) {
+	destinations, err := k.GetCommunityPoolDenomDestinations(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sent := sdk.NewCoins()
+	for _, coin := range toCommunityPool {
+		module, ok := destinations[coin.Denom]
+		if !ok || module == pooltypes.ModuleName {
+			continue
+		}
+
+		amount := sdk.NewCoin(coin.Denom, coin.Amount.TruncateInt())
+		if amount.IsZero() {
+			continue
+		}
+		routed = addRoutedCommunityFunds(routed, module, amount)
+		sent = sent.Add(amount)
+	}
+
+	return toCommunityPool.Sub(sdk.NewDecCoinsFromCoins(sent...)), routed, nil
+}
+
+// addRoutedCommunityFunds adds the coins to the funds routed to the module,
+// keeping a single entry per module.
+func addRoutedCommunityFunds(routed []types.RoutedCommunityFunds, module string, coins ...sdk.Coin) []types.RoutedCommunityFunds {
+	for i, r := range routed {
+		if r.Module == module {
+			routed[i].Amount = r.Amount.Add(coins...)
+			return routed
+		}
+	}
+
+	return append(routed, types.RoutedCommunityFunds{Module: module, Amount: sdk.NewCoins(coins...)})
+}
+
+// sendRoutedCommunityFunds sends the community pool cut routed to the module
+// accounts and returns the funds sent. The coins of the denoms whose sends are
+// disabled in x/bank, on which the send would fail and halt the block, are
+// added to the decimal pool instead, with a warning logged.
+func (k Keeper) sendRoutedCommunityFunds(ctx context.Context, routed []types.RoutedCommunityFunds) ([]types.RoutedCommunityFunds, error) {
+	var sent []types.RoutedCommunityFunds
+	held := sdk.NewCoins()
+	for _, r := range routed {
+		amount := sdk.NewCoins()
+		for _, coin := range r.Amount {
+			if k.bankKeeper.IsSendEnabledDenom(ctx, coin.Denom) {
+				amount = amount.Add(coin)
+				continue
+			}
+
+			k.Logger(ctx).Error(
+				"community pool funds of a send-disabled denom kept in the decimal pool",
+				"module", r.Module,
+				"amount", coin.String(),
+			)
+			held = held.Add(coin)
+		}
+		if amount.IsZero() {
+			continue
+		}
+
+		r = types.RoutedCommunityFunds{Module: r.Module, Amount: amount}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, r.Module, r.Amount); err != nil {
+			return nil, err
+		}
+		k.emitRouteCommunityFundsEvent(ctx, r)
+		sent = append(sent, r)
+	}
+
+	if held.IsZero() {
+		return sent, nil
+	}
+
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	feePool.DecimalPool = feePool.DecimalPool.Add(sdk.NewDecCoinsFromCoins(held...)...)
+	if err := k.FeePool.Set(ctx, feePool); err != nil {
+		return nil, err
+	}
+
+	return sent, nil
+}
+
+// emitRouteCommunityFundsEvent emits an event recording the community pool cut
+// sent to the module account of a community pool route.
+func (k Keeper) emitRouteCommunityFundsEvent(ctx context.Context, routed types.RoutedCommunityFunds) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRouteCommunityFunds,
+			sdk.NewAttribute(sdk.AttributeKeyModule, routed.Module),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, routed.Amount.String()),
+		),
+	)
+}
+
+// emitSubsidizeRewardsEvent emits an event recording the amount taken from the
+// community pool to subsidize the rewards.
+func (k Keeper) emitSubsidizeRewardsEvent(ctx context.Context, subsidy sdk.DecCoins) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubsidizeRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, subsidy.String()),
+		),
+	)
+}
+
+// getValidatorShares returns the share of the validators' rewards of every
+// weight, rounded according to the rounding mode, and the deficit drawn from
+// the decimal pool. The rounded shares of a denom may add up to more than the
+// rewards: the over-allocation is returned as the deficit, unless the decimal
+// pool does not hold it, in which case the shares are truncated. The shares are
+// computed by at most workers goroutines at once.
+func getValidatorShares(rewards sdk.DecCoins, weights []math.LegacyDec, totalWeight math.LegacyDec, mode types.RoundingMode, decimalPool sdk.DecCoins, workers int) ([]sdk.DecCoins, sdk.DecCoins) {
+	if mode == types.RoundingModeRound {
+		shares, total := computeShares(newShareScaler(rewards, totalWeight, true), weights, workers)
+
+		deficit := sdk.DecCoins{}
+		diff, _ := total.SafeSub(rewards)
+		for _, coin := range diff {
+			if coin.IsPositive() {
+				deficit = append(deficit, coin)
+			}
+		}
+
+		if _, hasNeg := decimalPool.SafeSub(deficit); !hasNeg {
+			return shares, deficit
+		}
+	}
+
+	shares, _ := computeShares(newShareScaler(rewards, totalWeight, false), weights, workers)
+	return shares, sdk.DecCoins{}
+}
+
+// computeShares returns the share of every weight computed by the scaler and
+// their total. The weights are split into one contiguous chunk per worker,
+// computed by at most workers goroutines at once, each with its own copy of
+// the scaler; a negative number of workers computes every share in its own
+// goroutine, with no bound. Every share is written at the index of its weight
+// and the total is added up in the order of the weights once they are all
+// computed, so that the result does not depend on the scheduling.
+func computeShares(scaler *shareScaler, weights []math.LegacyDec, workers int) ([]sdk.DecCoins, sdk.DecCoins) {
+	shares := make([]sdk.DecCoins, len(weights))
+
+	chunk := 1
+	if workers > 0 {
+		chunk = (len(weights) + workers - 1) / workers
+	}
+
+	var g errgroup.Group
+	g.SetLimit(workers)
+	for start := 0; start < len(weights); start += chunk {
+		start, end, s := start, start+chunk, scaler.clone()
+		if end > len(weights) {
+			end = len(weights)
+		}
+		g.Go(func() error {
+			for i := start; i < end; i++ {
+				shares[i] = s.share(weights[i])
+			}
+			return nil
+		})
+	}
+	// the shares are computed without error
+	_ = g.Wait()
+
+	total := sdk.DecCoins{}
+	for _, share := range shares {
+		total = total.Add(share...)
+	}
+
+	return shares, total
+}
+
+// shareScaler computes the shares of an amount of coins proportional to
+// weights, reusing its intermediate buffers across the validators of an
+// allocation. A share is identical to
+// coins.MulDecTruncate(weight.QuoTruncate(totalWeight)), or to
+// coins.MulDec(weight.Quo(totalWeight)) if the scaler rounds: the same integer
+// arithmetic is performed on the underlying big integers, so that only the
+// amounts of the returned coins are allocated.
+type shareScaler struct {
+	coins       sdk.DecCoins
+	amounts     []*big.Int
+	totalWeight *big.Int
+	// round rounds the fraction and the products half to even instead of
+	// truncating them
+	round bool
+	// fraction, product, rem and twice are scratch buffers overwritten by
+	// every share
+	fraction, product, rem, twice *big.Int
+}
+
+var (
+	// decPrecision and decPrecisionSquared are the multipliers of LegacyDec
+	// values and of their products
+	decPrecision        = new(big.Int).Exp(big.NewInt(10), big.NewInt(math.LegacyPrecision), nil)
+	decPrecisionSquared = new(big.Int).Mul(decPrecision, decPrecision)
+)
+
+func newShareScaler(coins sdk.DecCoins, totalWeight math.LegacyDec, round bool) *shareScaler {
+	amounts := make([]*big.Int, len(coins))
+	for i, coin := range coins {
+		amounts[i] = coin.Amount.BigInt()
+	}
+
+	return &shareScaler{
+		coins:       coins,
+		amounts:     amounts,
+		totalWeight: totalWeight.BigInt(),
+		round:       round,
+		fraction:    new(big.Int),
+		product:     new(big.Int),
+		rem:         new(big.Int),
+		twice:       new(big.Int),
+	}
+}
+
+// clone returns a copy of the scaler with its own scratch buffers, sharing
+// the amounts it only reads, to be used by another goroutine.
+func (s *shareScaler) clone() *shareScaler {
+	c := *s
+	c.fraction, c.product, c.rem, c.twice = new(big.Int), new(big.Int), new(big.Int), new(big.Int)
+	return &c
+}
+
+// share returns the share of the coins of the given weight, the fraction of
+// the total weight and every amount are truncated or rounded.
+func (s *shareScaler) share(weight math.LegacyDec) sdk.DecCoins {
+	// fraction = weight / totalWeight, as computed by LegacyDec.QuoTruncate
+	// or LegacyDec.Quo
+	s.product.Mul(weight.BigInt(), decPrecisionSquared)
+	s.fraction.QuoRem(s.product, s.totalWeight, s.rem)
+	s.chopPrecision(s.fraction)
+	if s.fraction.Sign() == 0 {
+		return sdk.DecCoins{}
+	}
+
+	// the coins are sorted and their denoms unique, so are the products
+	// appended in the same order
+	var res sdk.DecCoins
+	for i, amount := range s.amounts {
+		// product = amount * fraction, as computed by LegacyDec.MulTruncate
+		// or LegacyDec.Mul
+		s.product.Mul(amount, s.fraction)
+		s.chopPrecision(s.product)
+		if s.product.Sign() == 0 {
+			continue
+		}
+
+		if res == nil {
+			res = make(sdk.DecCoins, 0, len(s.coins))
+		}
+		res = append(res, sdk.DecCoin{
+			Denom:  s.coins[i].Denom,
+			Amount: math.LegacyNewDecFromBigIntWithPrec(s.product, math.LegacyPrecision),
+		})
+	}
+
+	return res
+}
+
+// chopPrecision divides the non-negative x by the decimal precision in place,
+// rounding half to even if the scaler rounds and truncating otherwise.
+func (s *shareScaler) chopPrecision(x *big.Int) {
+	x.QuoRem(x, decPrecision, s.rem)
+	if !s.round || s.rem.Sign() == 0 {
+		return
+	}
+
+	c := s.twice.Lsh(s.rem, 1).Cmp(decPrecision)
+	if c > 0 || (c == 0 && x.Bit(0) == 1) {
+		x.Add(x, big.NewInt(1))
+	}
+}
+
+// burnFees burns the share of the collected fees set by the burn rate from the
+// distribution module account and returns the burned amount. The burned amount
+// of every denom is truncated, so that the burn never exceeds the rate.
+func (k Keeper) burnFees(ctx context.Context, fees sdk.Coins) (sdk.Coins, error) {
+	burned, err := k.getBurnedFees(ctx, fees)
+	if err != nil {
+		return nil, err
+	}
+	if burned.IsZero() {
+		return burned, nil
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, k.authKeeper.GetModuleAddress(types.ModuleName), burned); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnFees,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+		),
+	)
+
+	return burned, nil
+}
+
+// getDistributableFees returns the fees in the denoms of the
+// DistributableDenoms param, or all of them if the param is empty.
+func (k Keeper) getDistributableFees(ctx context.Context, fees sdk.Coins) (sdk.Coins, error) {
+	denoms, err := k.GetDistributableDenoms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(denoms) == 0 {
+		return fees, nil
+	}
+
+	distributable := sdk.NewCoins()
+	for _, denom := range denoms {
+		if amount := fees.AmountOf(denom); amount.IsPositive() {
+			distributable = distributable.Add(sdk.NewCoin(denom, amount))
+		}
+	}
+
+	return distributable, nil
+}
+
+// checkBurnerPermission returns an error if the params set a burn rate and the
+// distribution module account is not allowed to burn the fees.
+func (k Keeper) checkBurnerPermission(ctx context.Context, params types.Params) error {
+	if params.BurnRate.IsNil() || !params.BurnRate.IsPositive() {
+		return nil
+	}
+
+	moduleAcc := k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc == nil || !moduleAcc.HasPermission(authtypes.Burner) {
+		return fmt.Errorf("%s module account has no %s permission, required by the burn rate", types.ModuleName, authtypes.Burner)
+	}
+
+	return nil
+}
+
+// getBurnedFees returns the share of the fees set by the burn rate, truncated
+// for every denom, without burning it.
+func (k Keeper) getBurnedFees(ctx context.Context, fees sdk.Coins) (sdk.Coins, error) {
+	burnRate, err := k.GetBurnRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	burned := sdk.NewCoins()
+	if !burnRate.IsPositive() {
+		return burned, nil
+	}
+
+	for _, fee := range fees {
+		amount := math.LegacyNewDecFromInt(fee.Amount).MulTruncate(burnRate).TruncateInt()
+		burned = burned.Add(sdk.NewCoin(fee.Denom, amount))
+	}
+
+	return burned, nil
+}
+
+// sendInfraFundFees sends the share of the fees set by the infra fund rate
+// from the distribution module account to the infra fund module account and
+// returns the amount sent. The amount of every denom is truncated, so that
+// the cut never exceeds the rate.
+func (k Keeper) sendInfraFundFees(ctx context.Context, fees sdk.Coins) (sdk.Coins, error) {
+	infraFund, err := k.getInfraFundFees(ctx, fees)
+	if err != nil {
+		return nil, err
+	}
+	if infraFund.IsZero() {
+		return infraFund, nil
+	}
+
+	module, err := k.GetInfraFundModule(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, module, infraFund); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInfraFund,
+			sdk.NewAttribute(sdk.AttributeKeyModule, module),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, infraFund.String()),
+		),
+	)
+
+	return infraFund, nil
+}
+
+// getInfraFundFees returns the share of the fees set by the infra fund rate,
+// truncated for every denom, without sending it.
+func (k Keeper) getInfraFundFees(ctx context.Context, fees sdk.Coins) (sdk.Coins, error) {
+	rate, err := k.GetInfraFundRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infraFund := sdk.NewCoins()
+	if !rate.IsPositive() {
+		return infraFund, nil
+	}
+
+	for _, fee := range fees {
+		amount := math.LegacyNewDecFromInt(fee.Amount).MulTruncate(rate).TruncateInt()
+		infraFund = infraFund.Add(sdk.NewCoin(fee.Denom, amount))
+	}
+
+	return infraFund, nil
+}
+
+// emitDecimalPoolRemainderEvent records the truncation remainder an allocation
+// adds to the decimal pool, so that the dust accumulated by a chain can be
+// audited. Nothing is emitted if there is no remainder.
+func (k Keeper) emitDecimalPoolRemainderEvent(ctx context.Context, remainder sdk.DecCoins) {
+	if remainder.IsZero() {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDecimalPoolRemainder,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, remainder.String()),
+		),
+	)
+}
+
+// getUnrewardedVotes returns the indexes of the bonded votes beyond the
+// maxRewarded votes with the most power, none if maxRewarded is zero. The votes
+// without power are not ranked and the ties are broken by the order of the
+// votes, so that every node selects the same validators.
+func getUnrewardedVotes(bondedVotes []comet.VoteInfo, maxRewarded uint64) map[int]bool {
+	if maxRewarded == 0 {
+		return nil
+	}
+
+	ranked := make([]int, 0, len(bondedVotes))
+	for i, vote := range bondedVotes {
+		if vote.Validator.Power > 0 {
+			ranked = append(ranked, i)
+		}
+	}
+	if uint64(len(ranked)) <= maxRewarded {
+		return nil
+	}
+
+	sort.SliceStable(ranked, func(a, b int) bool {
+		return bondedVotes[ranked[a]].Validator.Power > bondedVotes[ranked[b]].Validator.Power
+	})
+
+	unrewarded := make(map[int]bool, uint64(len(ranked))-maxRewarded)
+	for _, i := range ranked[maxRewarded:] {
+		unrewarded[i] = true
+	}
+
+	return unrewarded
+}
+
+// getPowerWeights returns the weight of every bonded vote's power and the
+// total weight the weights are divided by. Without a power source nor a power
+// weight func, the weights are the powers and the total is the total previous
+// power, otherwise the total is the sum of the weights of the votes with a
+// positive power.
+func (k Keeper) getPowerWeights(ctx context.Context, totalPreviousPower int64, bondedVotes []comet.VoteInfo) ([]math.LegacyDec, math.LegacyDec, error) {
+	weights := make([]math.LegacyDec, len(bondedVotes))
+	if k.powerSource == nil && k.powerWeightFunc == nil {
+		for i, vote := range bondedVotes {
+			weights[i] = math.LegacyNewDec(vote.Validator.Power)
+		}
+		return weights, math.LegacyNewDec(totalPreviousPower), nil
+	}
+
+	totalWeight := math.LegacyZeroDec()
+	weighted := false
+	for i, vote := range bondedVotes {
+		if vote.Validator.Power == 0 {
+			weights[i] = math.LegacyZeroDec()
+			continue
+		}
+
+		weight, err := k.getPowerWeight(ctx, vote)
+		if err != nil {
+			return nil, math.LegacyDec{}, err
+		}
+		if weight.IsNil() || weight.IsNegative() {
+			return nil, math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidPowerWeight, "weight of the power of %s must not be nil or negative: %s", sdk.ConsAddress(vote.Validator.Address), weight)
+		}
+
+		weights[i] = weight
+		totalWeight = totalWeight.Add(weight)
+		weighted = true
+	}
+
+	// the total weight is only divided by if a vote has a positive power
+	if weighted && !totalWeight.IsPositive() {
+		return nil, math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidPowerWeight, "total weight of the bonded votes must be positive")
+	}
+
+	return weights, totalWeight, nil
+}
+
+// getPowerWeight returns the weight of a bonded vote's power: the decimal
+// power of its validator supplied by the power source if it is set, otherwise
+// the weight of the vote's power.
+func (k Keeper) getPowerWeight(ctx context.Context, vote comet.VoteInfo) (math.LegacyDec, error) {
+	if k.powerSource != nil {
+		return k.powerSource.GetValidatorPower(ctx, vote.Validator.Address)
+	}
+
+	return k.powerWeightFunc(vote.Validator.Power), nil
+}
+
+// validateVotes verifies the consensus address of every bonded vote before
+// anything is allocated, and returns a single error listing all the votes
+// with a malformed address.
+func validateVotes(bondedVotes []comet.VoteInfo) error {
+	var invalid []string
+	for i, vote := range bondedVotes {
+		if err := sdk.VerifyAddressFormat(vote.Validator.Address); err != nil {
+			invalid = append(invalid, fmt.Sprintf("vote %d with address %X: %s", i, vote.Validator.Address, err))
+		}
+	}
+
+	if len(invalid) > 0 {
+		return errorsmod.Wrap(types.ErrInvalidVote, strings.Join(invalid, "; "))
+	}
+	return nil
+}
+
+// checkVotePower verifies, if the keeper is configured to, that the sum of the
+// bonded votes' powers matches the total previous power. Otherwise the power
+// fractions do not sum to one and the difference silently ends up in the
+// community and decimal pools.
+func (k Keeper) checkVotePower(ctx context.Context, totalPreviousPower int64, bondedVotes []comet.VoteInfo) error {
+	if k.votePowerTolerance.IsNil() {
+		return nil
+	}
+
+	var votePower int64
+	for _, vote := range bondedVotes {
+		votePower += vote.Validator.Power
+	}
+
+	deviation := math.LegacyNewDec(votePower - totalPreviousPower).Abs().QuoInt64(totalPreviousPower)
+	if deviation.LTE(k.votePowerTolerance) {
+		return nil
+	}
+
+	if k.votePowerStrict {
+		return errorsmod.Wrapf(types.ErrVotePowerMismatch, "sum of vote powers %d, total previous power %d", votePower, totalPreviousPower)
+	}
+
+	k.Logger(ctx).Error(
+		"sum of vote powers does not match total previous power",
+		"vote_power", votePower,
+		"total_previous_power", totalPreviousPower,
+		"deviation", deviation.String(),
+	)
+	return nil
+}
+
+// getProposerMultiplier returns the fraction of the collected fees allocated
+// to the proposer of the previous block: the base proposer reward plus the
+// bonus proposer reward scaled by the fraction of voting power that committed
+// the previous block.
+func (k Keeper) getProposerMultiplier(ctx context.Context, totalPreviousPower int64, bondedVotes []comet.VoteInfo) (math.LegacyDec, error) {
+	baseProposerReward, err := k.GetBaseProposerReward(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	bonusProposerReward, err := k.GetBonusProposerReward(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if bonusProposerReward.IsZero() {
+		return baseProposerReward, nil
+	}
+
+	var previousPrecommitPower int64
+	for _, vote := range bondedVotes {
+		if vote.BlockIDFlag == comet.BlockIDFlagCommit {
+			previousPrecommitPower += vote.Validator.Power
+		}
+	}
+
+	previousFractionVotes := math.LegacyNewDec(previousPrecommitPower).QuoTruncate(math.LegacyNewDec(totalPreviousPower))
+	return baseProposerReward.Add(bonusProposerReward.MulTruncate(previousFractionVotes)), nil
+}
+
+// allocateProposerReward allocates the proposer reward to the proposer of the
+// previous block. It returns false, without allocating anything, if the
+// proposer is unknown or no longer exists, and the commission rebate drawn
+// from the given community pool.
+func (k Keeper) allocateProposerReward(ctx context.Context, params types.Params, proposerReward, communityPool sdk.DecCoins) (bool, sdk.DecCoins, error) {
+	proposer, err := k.PreviousProposer.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, nil, err
+	}
+
+	if len(proposer) == 0 {
+		return false, nil, nil
+	}
+
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, proposer)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) || (err == nil && validator == nil) {
+		// previous proposer can be unknown if say, the unbonding period is 1
+		// block, so e.g. a validator undelegates at block X, it's removed
+		// entirely by block X+1's endblock, then X+2 we need to refer to the
+		// previous proposer for X+1, but we've forgotten about them.
+		k.Logger(ctx).Error(fmt.Sprintf(
+			"WARNING: Attempt to allocate proposer rewards to unknown proposer %s. "+
+				"This should happen only if the proposer unbonded completely within a single block, "+
+				"which generally should not happen except in exceptional circumstances (or fuzz testing). "+
+				"We recommend you investigate immediately.",
+			sdk.ConsAddress(proposer).String()))
+		return false, nil, nil
+	}
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to get proposer with consensus address %s: %w", sdk.ConsAddress(proposer), err)
+	}
+
+	// the reward of a proposer whose reward accrual is frozen is added to the
+	// community pool
+	valBz, frozen, err := k.isRewardsFrozen(ctx, validator)
+	if err != nil {
+		return false, nil, wrapValidatorError(err, proposer, validator)
+	}
+	if frozen {
+		k.emitEscrowEvent(ctx, types.EventTypeRedirectFrozenRewards, valBz, proposerReward)
+		return false, nil, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposerReward,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, proposerReward.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		),
+	)
+
+	rebate, err := k.allocateTokensToValidator(ctx, params, validator, proposerReward, communityPool, !k.aggregateRewardEvents)
+	if err != nil {
+		return false, nil, wrapValidatorError(err, proposer, validator)
+	}
+
+	return true, rebate, nil
+}
+
+// wrapValidatorError wraps an error of the allocation to a validator with its
+// operator and consensus addresses, to identify the validator that caused a
+// failed allocation.
+func wrapValidatorError(err error, consAddr []byte, validator stakingtypes.ValidatorI) error {
+	return fmt.Errorf("validator %s with consensus address %s: %w", validator.GetOperator(), sdk.ConsAddress(consAddr), err)
+}
+
+// validatorReward is the reward computed for a single validator during an
+// allocation, before it is written to state.
+type validatorReward struct {
+	consAddr  []byte
+	validator stakingtypes.ValidatorI
+	power     int64
+	reward    sdk.DecCoins
+}
+
+// allocateRemainder applies the keeper's remainder strategy to the truncation
+// remainder of the validators' share. It returns the updated rewards and the
+// remainder left for the decimal pool.
+func (k Keeper) allocateRemainder(ctx context.Context, rewards []validatorReward, remainder sdk.DecCoins) ([]validatorReward, sdk.DecCoins, error) {
+	if remainder.IsZero() {
+		return rewards, remainder, nil
+	}
+
+	switch k.remainderStrategy {
+	case types.RemainderStrategyDecimalPool:
+		return rewards, remainder, nil
+
+	case types.RemainderStrategyProportional:
+		if len(rewards) == 0 {
+			return rewards, remainder, nil
+		}
+
+		return splitRemainderProportionally(rewards, remainder), sdk.DecCoins{}, nil
+
+	case types.RemainderStrategyProposer:
+		proposer, err := k.PreviousProposer.Get(ctx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, nil, err
+		}
+
+		// without a known proposer the remainder stays in the decimal pool
+		if len(proposer) == 0 {
+			return rewards, remainder, nil
+		}
+
+		for i, r := range rewards {
+			if bytes.Equal(r.consAddr, proposer) {
+				rewards[i].reward = r.reward.Add(remainder...)
+				return rewards, sdk.DecCoins{}, nil
+			}
+		}
+
+		// the proposer did not vote, allocate the remainder to it separately
+		// if it still exists
+		validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, proposer)
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) || (err == nil && validator == nil) {
+			return rewards, remainder, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get proposer with consensus address %s: %w", sdk.ConsAddress(proposer), err)
+		}
+
+		rewards = append(rewards, validatorReward{consAddr: proposer, validator: validator, reward: remainder})
+		return rewards, sdk.DecCoins{}, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown remainder strategy: %s", k.remainderStrategy)
+	}
+}
+
+// splitRemainderProportionally adds the remainder to the rewards
+// proportionally to the validators' power. The remainder is split in units of
+// the smallest decimal amount, and the units left over after the proportional
+// split are handed out one at a time in the order of the rewards, so that the
+// whole remainder is allocated.
+func splitRemainderProportionally(rewards []validatorReward, remainder sdk.DecCoins) []validatorReward {
+	totalPower := big.NewInt(0)
+	for _, r := range rewards {
+		totalPower.Add(totalPower, big.NewInt(r.power))
+	}
+
+	for _, coin := range remainder {
+		units := coin.Amount.BigInt()
+		shares := make([]*big.Int, len(rewards))
+		leftover := new(big.Int).Set(units)
+		for i, r := range rewards {
+			shares[i] = new(big.Int).Mul(units, big.NewInt(r.power))
+			shares[i].Quo(shares[i], totalPower)
+			leftover.Sub(leftover, shares[i])
+		}
+
+		for i := 0; leftover.Sign() > 0; i = (i + 1) % len(rewards) {
+			shares[i].Add(shares[i], big.NewInt(1))
+			leftover.Sub(leftover, big.NewInt(1))
+		}
+
+		for i, share := range shares {
+			if share.Sign() == 0 {
+				continue
+			}
+			amount := math.LegacyNewDecFromBigIntWithPrec(share, math.LegacyPrecision)
+			rewards[i].reward = rewards[i].reward.Add(sdk.NewDecCoinFromDec(coin.Denom, amount))
+		}
+	}
+
+	return rewards
+}
+
+// finalizeAllocation flushes the decimal pool if it grew above the configured
+// threshold, stores the fee pool and emits the allocation summary event.
+func (k Keeper) finalizeAllocation(ctx context.Context, feePool types.FeePool, result types.AllocationResult) (types.AllocationResult, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	moved := sdk.NewCoins()
+	if threshold := params.DecimalPoolFlushThreshold; !threshold.IsNil() && threshold.IsPositive() {
+		var routed []types.RoutedCommunityFunds
+		feePool, moved, routed, err = k.moveDecimalPoolToCommunityPool(ctx, feePool, threshold)
+		if err != nil {
+			return types.AllocationResult{}, err
+		}
+		k.emitDecimalPoolFlushEvent(ctx, moved, routed)
+
+		// the flushed coins of the denoms with a community pool destination
+		// are sent with the routed community pool cut
+		for _, r := range routed {
+			result.Routed = addRoutedCommunityFunds(result.Routed, r.Module, r.Amount...)
+		}
+	}
+
+	if err := k.FeePool.Set(ctx, feePool); err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	if err := k.afterCommunityPoolFunded(ctx, result.ToCommunityPool, types.CommunityPoolSourceAllocation); err != nil {
+		return types.AllocationResult{}, err
+	}
+	if err := k.afterCommunityPoolFunded(ctx, sdk.NewDecCoinsFromCoins(moved...), types.CommunityPoolSourceDecimalPool); err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	if err := k.recordDecimalPoolSample(ctx, result); err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	k.emitAllocationSummaryEvent(ctx, result)
+	return result, nil
+}
+
+// emitAllocationSummaryEvent emits a single rewards event holding the total
+// allocated to validators and to the community pool, if the keeper is
+// configured to aggregate reward events.
+func (k Keeper) emitAllocationSummaryEvent(ctx context.Context, result types.AllocationResult) {
+	if !k.aggregateRewardEvents {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, result.ToValidators.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPool, result.ToCommunityPool.String()),
+		),
+	)
+}
+
+// emitAllocationTelemetry reports the totals of an allocation, labeled by
+// denom, to the telemetry sink.
+func emitAllocationTelemetry(result types.AllocationResult) {
+	setDecCoinsGauge := func(coins sdk.DecCoins, keys ...string) {
+		for _, c := range coins {
+			amount := c.Amount.TruncateInt()
+			if amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					keys,
+					float32(amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", c.Denom)},
+				)
+			}
+		}
+	}
+
+	setDecCoinsGauge(result.TotalFees, types.ModuleName, "allocation", "fees_collected")
+	setDecCoinsGauge(result.Burned, types.ModuleName, "allocation", "burned")
+	setDecCoinsGauge(result.ToInfraFund, types.ModuleName, "allocation", "infra_fund")
+	setDecCoinsGauge(result.Subsidy, types.ModuleName, "allocation", "subsidy")
+	setDecCoinsGauge(result.ToValidators, types.ModuleName, "allocation", "validators")
+	setDecCoinsGauge(result.Escrowed, types.ModuleName, "allocation", "escrowed")
+	setDecCoinsGauge(result.ToCommunityPool, types.ModuleName, "allocation", "community_pool")
+	setDecCoinsGauge(result.Remainder, types.ModuleName, "allocation", "decimal_pool")
+	setDecCoinsGauge(result.Carried, types.ModuleName, "allocation", "carried")
+	telemetry.SetGauge(float32(result.ValidatorsRewarded), types.ModuleName, "allocation", "validators_rewarded")
+}
+
+// logAllocationSummary writes a single debug log line holding the totals of an
+// allocation, for log-based monitoring.
+func (k Keeper) logAllocationSummary(ctx context.Context, result types.AllocationResult) {
+	k.Logger(ctx).Debug(
+		"allocated tokens",
+		"total_fees", result.TotalFees.String(),
+		"community_tax", result.CommunityTax.String(),
+		"to_validators", result.ToValidators.String(),
+		"to_community_pool", result.ToCommunityPool.String(),
+		"to_decimal_pool", result.Remainder.String(),
+		"validator_count", result.ValidatorsRewarded,
+	)
+}
+
+// AllocateTokensToValidator allocate tokens to a particular validator,
+// splitting according to commission. The tokens allocated to a validator whose
+// reward accrual is frozen are added to the community pool instead.
+func (k Keeper) AllocateTokensToValidator(ctx context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error {
+	valBz, frozen, err := k.isRewardsFrozen(ctx, val)
+	if err != nil {
+		return err
+	}
+	if frozen {
+		return k.redirectFrozenRewards(ctx, valBz, tokens)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.allocateTokensToValidatorWithRebate(ctx, params, val, tokens, true)
+}
+
+// allocateTokensToValidatorWithRebate allocates tokens to a validator, drawing
+// its commission rebate from the stored community pool.
+func (k Keeper) allocateTokensToValidatorWithRebate(ctx context.Context, params types.Params, val stakingtypes.ValidatorI, tokens sdk.DecCoins, emitEvents bool) error {
+	// without a fee pool, there is no community pool to draw a rebate from
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	rebate, err := k.allocateTokensToValidator(ctx, params, val, tokens, feePool.CommunityPool, emitEvents)
+	if err != nil || rebate.IsZero() {
+		return err
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Sub(rebate)
+	return k.FeePool.Set(ctx, feePool)
+}
+
+// isRewardsFrozen returns the operator address of a validator and whether its
+// reward accrual is frozen.
+func (k Keeper) isRewardsFrozen(ctx context.Context, val stakingtypes.ValidatorI) (sdk.ValAddress, bool, error) {
+	valBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+	if err != nil {
+		return nil, false, err
+	}
+
+	frozen, err := k.IsValidatorRewardsFrozen(ctx, valBz)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return valBz, frozen, nil
+}
+
+// redirectFrozenRewards adds the tokens allocated to a validator whose reward
+// accrual is frozen to the community pool.
+func (k Keeper) redirectFrozenRewards(ctx context.Context, valAddr sdk.ValAddress, tokens sdk.DecCoins) error {
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Add(tokens...)
+	if err := k.FeePool.Set(ctx, feePool); err != nil {
+		return err
+	}
+
+	k.emitEscrowEvent(ctx, types.EventTypeRedirectFrozenRewards, valAddr, tokens)
+	return nil
+}
+
+// AllocateTokensToValidatorFromAccount sends tokens from the funder account to
+// the distribution module and allocates them to a validator as its regular
+// rewards, splitting them according to its commission, e.g. to credit the
+// delegators of a validator with an airdrop or retroactive rewards.
+func (k Keeper) AllocateTokensToValidatorFromAccount(ctx context.Context, valAddr sdk.ValAddress, tokens sdk.Coins, funder sdk.AccAddress) error {
+	validator, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	if validator == nil {
+		return errorsmod.Wrap(types.ErrNoValidatorExists, valAddr.String())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, types.ModuleName, tokens); err != nil {
+		return err
+	}
+
+	return k.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(tokens...))
+}
+
+// allocateTokensToValidator implements AllocateTokensToValidator, optionally
+// suppressing the commission and rewards events. The commission rebate of a
+// rebated validator is added to the rewards of its delegators and returned,
+// for the caller to take it out of the given community pool. The commission is
+// computed with the given params.
+func (k Keeper) allocateTokensToValidator(ctx context.Context, params types.Params, val stakingtypes.ValidatorI, tokens, communityPool sdk.DecCoins, emitEvents bool) (sdk.DecCoins, error) {
+	rate, err := k.effectiveCommissionRate(ctx, params, val)
+	if err != nil {
+		return nil, err
+	}
+
+	valBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+	if err != nil {
+		return nil, err
+	}
+
+	// split tokens between validator and delegators according to commission
+	commission, shared, err := k.splitTokensByCommission(ctx, valBz, tokens, rate)
+	if err != nil {
+		return nil, err
+	}
+
+	rebate, err := k.getCommissionRebate(ctx, params, valBz, commission, communityPool)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.updateValidatorRewards(sdk.UnwrapSDKContext(ctx), val, valBz, tokens.Add(rebate...), commission, shared.Add(rebate...), emitEvents); err != nil {
+		return nil, err
+	}
+	if !rebate.IsZero() {
+		k.emitEscrowEvent(ctx, types.EventTypeRebateCommission, valBz, rebate)
+	}
+	k.notifyRewardListeners(ctx, valBz, commission, shared.Add(rebate...))
+
+	if err := k.recordRewardSample(ctx, valBz, tokens); err != nil {
+		return nil, err
+	}
+
+	return rebate, k.afterValidatorRewardsAllocated(ctx, valBz, tokens)
+}
+
+// applyRewardMultiplier applies the reward multiplier of a validator to its
+// reward. It returns the part of the reward allocated from the fees, reduced
+// by a multiplier below one, and the bonus of a multiplier above one, capped
+// per denom to the community pool so that the bonus never drains the pool
+// below zero.
+func (k Keeper) applyRewardMultiplier(ctx context.Context, valAddr sdk.ValAddress, reward, communityPool sdk.DecCoins) (sdk.DecCoins, sdk.DecCoins, error) {
+	multiplier, err := k.GetValidatorRewardMultiplier(ctx, valAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch {
+	case multiplier.LT(math.LegacyOneDec()):
+		return reward.MulDecTruncate(multiplier), sdk.DecCoins{}, nil
+	case multiplier.GT(math.LegacyOneDec()) && !communityPool.IsZero():
+		bonus := reward.MulDecTruncate(multiplier.Sub(math.LegacyOneDec())).Intersect(communityPool)
+		return reward, bonus, nil
+	default:
+		return reward, sdk.DecCoins{}, nil
+	}
+}
+
+// getUptimeFactors returns the uptime factors of the validators keyed by
+// consensus address, nil if no uptime provider is set. A factor outside of
+// [0, 1] fails the allocation.
+func (k Keeper) getUptimeFactors(ctx context.Context) (map[string]math.LegacyDec, error) {
+	if k.uptimeProvider == nil {
+		return nil, nil
+	}
+
+	factors, err := k.uptimeProvider.GetUptimeFactors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for consAddr, factor := range factors {
+		if factor.IsNil() || factor.IsNegative() || factor.GT(math.LegacyOneDec()) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidUptimeFactor, "uptime factor of %s must be in [0, 1]: %s", consAddr, factor)
+		}
+	}
+
+	return factors, nil
+}
+
+// applyUptimeFactor scales the reward of a validator by its uptime factor, a
+// validator without a factor has a full uptime.
+func applyUptimeFactor(factors map[string]math.LegacyDec, consAddr sdk.ConsAddress, reward sdk.DecCoins) sdk.DecCoins {
+	factor, ok := factors[consAddr.String()]
+	if !ok || factor.Equal(math.LegacyOneDec()) {
+		return reward
+	}
+
+	return reward.MulDecTruncate(factor)
+}
+
+// getCommissionRebate returns the commission rebate of a validator, which is
+// the commission rebate rate of its commission if the validator is rebated,
+// capped per denom to the community pool so that the rebate never drains the
+// pool below zero.
+func (k Keeper) getCommissionRebate(ctx context.Context, params types.Params, valAddr sdk.ValAddress, commission, communityPool sdk.DecCoins) (sdk.DecCoins, error) {
+	rate := params.CommissionRebateRate
+	if commission.IsZero() || communityPool.IsZero() || rate.IsNil() || !rate.IsPositive() {
+		return sdk.DecCoins{}, nil
+	}
+
+	rebated, err := k.IsValidatorCommissionRebated(ctx, valAddr)
+	if err != nil || !rebated {
+		return sdk.DecCoins{}, err
+	}
+
+	return commission.MulDecTruncate(rate).Intersect(communityPool), nil
+}
+
+// updateValidatorRewards adds the commission to the accumulated commission of
+// the validator, the shared tokens to its current rewards and all the tokens
+// to its outstanding rewards.
+func (k Keeper) updateValidatorRewards(sdkCtx sdk.Context, val stakingtypes.ValidatorI, valBz []byte, tokens, commission, shared sdk.DecCoins, emitEvents bool) error {
+	// update current commission
+	if emitEvents {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommission,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator()),
+			),
+		)
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventValidatorCommission{Validator: val.GetOperator(), Amount: commission}); err != nil {
+			return err
+		}
+	}
+	currentCommission, err := k.ValidatorsAccumulatedCommission.Get(sdkCtx, valBz)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	currentCommission.Commission = currentCommission.Commission.Add(commission...)
+	err = k.ValidatorsAccumulatedCommission.Set(sdkCtx, valBz, currentCommission)
+	if err != nil {
+		return err
+	}
+
+	// update current rewards
+	currentRewards, err := k.ValidatorCurrentRewards.Get(sdkCtx, valBz)
+	// if the rewards do not exist it's fine, we will just add to zero.
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	currentRewards.Rewards = currentRewards.Rewards.Add(shared...)
+	err = k.ValidatorCurrentRewards.Set(sdkCtx, valBz, currentRewards)
+	if err != nil {
+		return err
+	}
+
+	// update outstanding rewards
+	if emitEvents {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewards,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator()),
+			),
+		)
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventValidatorRewards{Validator: val.GetOperator(), Amount: tokens}); err != nil {
+			return err
+		}
+	}
+
+	outstanding, err := k.ValidatorOutstandingRewards.Get(sdkCtx, valBz)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	outstanding.Rewards = outstanding.Rewards.Add(tokens...)
+	return k.ValidatorOutstandingRewards.Set(sdkCtx, valBz, outstanding)
+}
+
+// effectiveCommissionRate returns the commission rate the rewards of a
+// validator are split with, which is the validator's rate raised to the
+// minimum commission rate if it is lower, then lowered to the commission cap
+// of the validator if it is higher. The cap prevails over the minimum. The rate
+// is zero during the commission grace period of a new validator. The minimum
+// rate and the grace period are those of the given params.
+func (k Keeper) effectiveCommissionRate(ctx context.Context, params types.Params, val stakingtypes.ValidatorI) (math.LegacyDec, error) {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	inGracePeriod, err := k.inCommissionGracePeriod(ctx, valAddr, params.CommissionGracePeriodBlocks)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if inGracePeriod {
+		return math.LegacyZeroDec(), nil
+	}
+
+	rate := val.GetCommission()
+	if !params.MinCommissionRate.IsNil() {
+		rate = math.LegacyMaxDec(rate, params.MinCommissionRate)
+	}
+
+	commissionCap, err := k.ValidatorCommissionCap.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return rate, nil
+	}
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return math.LegacyMinDec(rate, commissionCap.MaxRate), nil
+}
+
+// inCommissionGracePeriod returns whether the validator was created less than
+// gracePeriod blocks ago. A validator without creation height, e.g. created
+// before the grace period was introduced, is not in it.
+func (k Keeper) inCommissionGracePeriod(ctx context.Context, valAddr sdk.ValAddress, gracePeriod uint64) (bool, error) {
+	if gracePeriod == 0 {
+		return false, nil
+	}
+
+	creation, err := k.ValidatorCreationHeights.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return height < creation.Height || height-creation.Height < gracePeriod, nil
+}
+
+// splitTokensByCommission splits the tokens allocated to a validator between
+// its commission, at the given rate, and the rewards shared with its
+// delegators, which receive in full the tokens in the denoms exempt from its
+// commission.
+func (k Keeper) splitTokensByCommission(ctx context.Context, valAddr sdk.ValAddress, tokens sdk.DecCoins, rate math.LegacyDec) (commission, shared sdk.DecCoins, err error) {
+	exemption, err := k.ValidatorCommissionExemptions.Get(ctx, valAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, nil, err
+	}
+
+	commission, shared = types.SplitTokensByCommissionExempt(tokens, rate, exemption.Denoms)
+	return commission, shared, nil
+}
+
+// afterValidatorRewardsAllocated calls the AfterValidatorRewardsAllocated
+// hook, if the hooks are set.
+func (k Keeper) afterValidatorRewardsAllocated(ctx context.Context, valAddr sdk.ValAddress, tokens sdk.DecCoins) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.AfterValidatorRewardsAllocated(ctx, valAddr, tokens)
+}
+
+// afterCommunityPoolFunded calls the AfterCommunityPoolFunded hook, if the
+// hooks are set and the amount added to the community pool is not zero.
+func (k Keeper) afterCommunityPoolFunded(ctx context.Context, amount sdk.DecCoins, source string) error {
+	if k.hooks == nil || amount.IsZero() {
+		return nil
+	}
+
+	return k.hooks.AfterCommunityPoolFunded(ctx, amount, source)
+}
+
+// notifyRewardListeners calls the reward listeners with the commission and
+// shared rewards allocated to a validator.
+func (k Keeper) notifyRewardListeners(ctx context.Context, valAddr sdk.ValAddress, commission, shared sdk.DecCoins) {
+	for _, listener := range k.rewardListeners {
+		listener.OnValidatorRewardsAllocated(ctx, valAddr, commission, shared)
+	}
+}
+
+// BatchAllocateTokensToValidators allocates tokens to a set of validators,
+// keyed by operator address, splitting each allocation according to the
+// validator's commission. It is equivalent to calling
+// AllocateTokensToValidator for every entry in ascending operator address
+// order: every validator is read and written in turn, frozen validators
+// included, so that the hooks and listeners called for a validator see, and
+// are not overwritten by, the state left by the previous ones. Only the params
+// are read once for the whole batch.
+func (k Keeper) BatchAllocateTokensToValidators(ctx context.Context, rewards map[string]sdk.DecCoins) error {
+	operators := make([]string, 0, len(rewards))
+	for operator := range rewards {
+		operators = append(operators, operator)
+	}
+	sort.Strings(operators)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, operator := range operators {
+		valBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(operator)
+		if err != nil {
+			return err
+		}
+
+		// the tokens of a validator whose reward accrual is frozen are added
+		// to the community pool
+		frozen, err := k.IsValidatorRewardsFrozen(ctx, valBz)
+		if err != nil {
+			return err
+		}
+		if frozen {
+			if err := k.redirectFrozenRewards(ctx, valBz, rewards[operator]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		val, err := k.stakingKeeper.Validator(ctx, valBz)
+		if err != nil {
+			return err
+		}
+
+		if err := k.allocateTokensToValidatorWithRebate(ctx, params, val, rewards[operator], true); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
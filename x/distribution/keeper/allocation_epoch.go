@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// allocateEpochFees allocates the fees collected in the block to the bonded
+// votes if the allocation epoch is of a single block. Otherwise the votes of
+// the block are added to the powers of the current epoch and the fees are
+// held in the carried fees of the fee pool until the last block of the epoch,
+// where the fees held are allocated with the fees of the block to the votes
+// averaged over the epoch.
+func (k Keeper) allocateEpochFees(ctx context.Context, feesCollectedInt, burned, infraFund sdk.Coins, totalPreviousPower int64, bondedVotes []comet.VoteInfo) (types.AllocationResult, error) {
+	epochBlocks, err := k.GetAllocationEpochBlocks(ctx)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	epoch, err := k.AllocationEpoch.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.AllocationResult{}, err
+	}
+
+	// the fees are allocated every block, unless an epoch left in progress by
+	// a decrease of the epoch length is to be ended by this block
+	if epochBlocks <= 1 && epoch.Blocks == 0 {
+		return k.allocateFees(ctx, feesCollectedInt, burned, infraFund, totalPreviousPower, bondedVotes)
+	}
+
+	epoch, err = k.recordEpochVotes(ctx, epoch, totalPreviousPower, bondedVotes)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	if epoch.Blocks < epochBlocks {
+		if err := k.AllocationEpoch.Set(ctx, epoch); err != nil {
+			return types.AllocationResult{}, err
+		}
+		return k.holdEpochFees(ctx, feesCollectedInt, burned, infraFund)
+	}
+
+	averagePower, averageVotes, err := k.endAllocationEpoch(ctx, epoch)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+
+	return k.allocateFees(ctx, feesCollectedInt, burned, infraFund, averagePower, averageVotes)
+}
+
+// recordEpochVotes adds the bonded votes of the block to the powers of the
+// current allocation epoch and returns the epoch including the block.
+func (k Keeper) recordEpochVotes(ctx context.Context, epoch types.AllocationEpoch, totalPreviousPower int64, bondedVotes []comet.VoteInfo) (types.AllocationEpoch, error) {
+	for _, vote := range bondedVotes {
+		consAddr := sdk.ConsAddress(vote.Validator.Address)
+		power, err := k.AllocationEpochPowers.Get(ctx, consAddr)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return types.AllocationEpoch{}, err
+		}
+
+		power.Power += vote.Validator.Power
+		if vote.BlockIDFlag == comet.BlockIDFlagCommit {
+			power.SignedPower += vote.Validator.Power
+		}
+		if err := k.AllocationEpochPowers.Set(ctx, consAddr, power); err != nil {
+			return types.AllocationEpoch{}, err
+		}
+	}
+
+	epoch.Blocks++
+	epoch.TotalPower += totalPreviousPower
+	return epoch, nil
+}
+
+// endAllocationEpoch clears the current allocation epoch and returns the total
+// power and the votes averaged over its blocks, in the order of the consensus
+// addresses. The averaged power of a validator that committed only some of
+// the blocks is split between a commit vote and an absent vote, so that the
+// proposer reward accounts for the fraction of the power that committed.
+func (k Keeper) endAllocationEpoch(ctx context.Context, epoch types.AllocationEpoch) (int64, []comet.VoteInfo, error) {
+	blocks := int64(epoch.Blocks)
+
+	var votes []comet.VoteInfo
+	err := k.AllocationEpochPowers.Walk(ctx, nil, func(consAddr sdk.ConsAddress, power types.AllocationEpochPower) (stop bool, err error) {
+		signed := power.SignedPower / blocks
+		if signed > 0 {
+			votes = append(votes, comet.VoteInfo{
+				Validator:   comet.Validator{Address: consAddr, Power: signed},
+				BlockIDFlag: comet.BlockIDFlagCommit,
+			})
+		}
+
+		if absent := power.Power/blocks - signed; absent > 0 {
+			votes = append(votes, comet.VoteInfo{
+				Validator:   comet.Validator{Address: consAddr, Power: absent},
+				BlockIDFlag: comet.BlockIDFlagAbsent,
+			})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if err := k.AllocationEpochPowers.Clear(ctx, nil); err != nil {
+		return 0, nil, err
+	}
+	if err := k.AllocationEpoch.Remove(ctx); err != nil {
+		return 0, nil, err
+	}
+
+	return epoch.TotalPower / blocks, votes, nil
+}
+
+// holdEpochFees adds the fees collected in a block of the allocation epoch,
+// net of the burned fees and of the infra fund cut, to the carried fees of the
+// fee pool, which are allocated at the end of the epoch.
+func (k Keeper) holdEpochFees(ctx context.Context, feesCollectedInt, burned, infraFund sdk.Coins) (types.AllocationResult, error) {
+	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt.Sub(burned...).Sub(infraFund...)...)
+
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return types.AllocationResult{}, err
+	}
+	feePool.CarriedFees = feePool.CarriedFees.Add(feesCollected...)
+
+	return k.finalizeAllocation(ctx, feePool, types.AllocationResult{
+		TotalFees:       feesCollected,
+		Burned:          sdk.NewDecCoinsFromCoins(burned...),
+		ToInfraFund:     sdk.NewDecCoinsFromCoins(infraFund...),
+		Subsidy:         sdk.DecCoins{},
+		Escrowed:        sdk.DecCoins{},
+		RoundingDeficit: sdk.DecCoins{},
+		ToValidators:    sdk.DecCoins{},
+		ToProposer:      sdk.DecCoins{},
+		ToCommunityPool: sdk.DecCoins{},
+		Remainder:       sdk.DecCoins{},
+		Carried:         feesCollected,
+	})
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"math/rand"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// shareFixture returns the validators' share of the fees of a block, as
+// computed by allocateFees, and the power weights of numVals validators.
+func shareFixture(numVals int) (sdk.DecCoins, []math.LegacyDec, math.LegacyDec) {
+	r := rand.New(rand.NewSource(1))
+
+	fees := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("123456789.123456789123456789")),
+		sdk.NewDecCoinFromDec("osmo", math.LegacyMustNewDecFromStr("0.000000000000001")),
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(1_000_000_000_000)),
+	)
+	feeMultiplier := fees.MulDecTruncate(math.LegacyNewDecWithPrec(98, 2))
+
+	weights := make([]math.LegacyDec, numVals)
+	totalWeight := math.LegacyZeroDec()
+	for i := range weights {
+		weights[i] = math.LegacyNewDec(r.Int63n(1_000_000))
+		totalWeight = totalWeight.Add(weights[i])
+	}
+	// a validator without power gets no share
+	weights[0] = math.LegacyZeroDec()
+
+	return feeMultiplier, weights, totalWeight
+}
+
+func TestShareScaler(t *testing.T) {
+	feeMultiplier, weights, totalWeight := shareFixture(1000)
+
+	scaler := newShareScaler(feeMultiplier, totalWeight, false)
+	for i, weight := range weights {
+		expected := feeMultiplier.MulDecTruncate(weight.QuoTruncate(totalWeight))
+		require.Equal(t, expected, scaler.share(weight), "validator %d", i)
+	}
+
+	// a rounding scaler rounds half to even as LegacyDec does
+	scaler = newShareScaler(feeMultiplier, totalWeight, true)
+	for i, weight := range weights {
+		expected := feeMultiplier.MulDec(weight.Quo(totalWeight))
+		require.True(t, expected.Equal(scaler.share(weight)), "validator %d", i)
+	}
+
+	// the weight is not modified
+	_, expWeights, _ := shareFixture(1000)
+	require.Equal(t, expWeights, weights)
+}
+
+// BenchmarkValidatorShares compares the allocations of computing the share of
+// 1000 validators by multiplying the fees by every power fraction with the
+// shareScaler.
+func BenchmarkValidatorShares(b *testing.B) {
+	feeMultiplier, weights, totalWeight := shareFixture(1000)
+
+	b.Run("MulDecTruncate", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, weight := range weights {
+				_ = feeMultiplier.MulDecTruncate(weight.QuoTruncate(totalWeight))
+			}
+		}
+	})
+
+	b.Run("shareScaler", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			scaler := newShareScaler(feeMultiplier, totalWeight, false)
+			for _, weight := range weights {
+				_ = scaler.share(weight)
+			}
+		}
+	})
+}
+
+func TestComputeSharesWorkers(t *testing.T) {
+	feeMultiplier, weights, totalWeight := shareFixture(1000)
+
+	for _, round := range []bool{false, true} {
+		// the shares computed serially by a single scaler
+		scaler := newShareScaler(feeMultiplier, totalWeight, round)
+		expShares := make([]sdk.DecCoins, len(weights))
+		expTotal := sdk.DecCoins{}
+		for i, weight := range weights {
+			expShares[i] = scaler.share(weight)
+			expTotal = expTotal.Add(expShares[i]...)
+		}
+
+		// the result does not depend on the number of workers, including
+		// more workers than weights and no bound at all
+		for _, workers := range []int{1, 3, 8, 2000, -1} {
+			shares, total := computeShares(newShareScaler(feeMultiplier, totalWeight, round), weights, workers)
+			require.Equal(t, expShares, shares, "round %t, %d workers", round, workers)
+			require.Equal(t, expTotal, total, "round %t, %d workers", round, workers)
+		}
+	}
+
+	shares, total := computeShares(newShareScaler(feeMultiplier, totalWeight, false), nil, 4)
+	require.Empty(t, shares)
+	require.True(t, total.IsZero())
+}
+
+// BenchmarkComputeShares compares the throughput of computing the shares of
+// 5000 validators with a goroutine per validator and with a pool bounded by
+// GOMAXPROCS.
+func BenchmarkComputeShares(b *testing.B) {
+	feeMultiplier, weights, totalWeight := shareFixture(5000)
+
+	for _, bc := range []struct {
+		name    string
+		workers int
+	}{
+		{"unbounded", -1},
+		{"bounded", runtime.GOMAXPROCS(0)},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = computeShares(newShareScaler(feeMultiplier, totalWeight, false), weights, bc.workers)
+			}
+		})
+	}
+}
@@ -1,15 +1,28 @@
 package keeper_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	goruntime "runtime"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
+	metrics "github.com/hashicorp/go-metrics"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	authtypes "cosmossdk.io/x/auth/types"
@@ -17,11 +30,15 @@ import (
 	"cosmossdk.io/x/distribution/keeper"
 	distrtestutil "cosmossdk.io/x/distribution/testutil"
 	disttypes "cosmossdk.io/x/distribution/types"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
@@ -54,6 +71,7 @@ func TestAllocateTokensToValidatorWithCommission(t *testing.T) {
 		"fee_collector",
 		authtypes.NewModuleAddress("gov").String(),
 	)
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
 
 	// create validator with 50% commission
 	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
@@ -85,6 +103,348 @@ func TestAllocateTokensToValidatorWithCommission(t *testing.T) {
 	require.Equal(t, expected, currentRewards.Rewards)
 }
 
+func TestAllocateTokensToValidatorMinCommissionRate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		rate          math.LegacyDec
+		expCommission math.LegacyDec
+	}{
+		{
+			name:          "rate below the minimum",
+			rate:          math.LegacyNewDecWithPrec(1, 2),
+			expCommission: math.LegacyNewDecWithPrec(5, 1),
+		},
+		{
+			name:          "rate equal to the minimum",
+			rate:          math.LegacyNewDecWithPrec(5, 2),
+			expCommission: math.LegacyNewDecWithPrec(5, 1),
+		},
+		{
+			name:          "rate above the minimum",
+			rate:          math.LegacyNewDecWithPrec(1, 1),
+			expCommission: math.LegacyNewDec(1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			// enforce a minimum commission rate of 5%
+			params := disttypes.DefaultParams()
+			params.MinCommissionRate = math.LegacyNewDecWithPrec(5, 2)
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+			val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			val.Commission = stakingtypes.NewCommission(tc.rate, math.LegacyOneDec(), math.LegacyNewDec(0))
+
+			tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10)}}
+			require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+			commission, err := distrKeeper.ValidatorsAccumulatedCommission.Get(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: tc.expCommission}}, commission.Commission)
+
+			currentRewards, err := distrKeeper.ValidatorCurrentRewards.Get(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10).Sub(tc.expCommission)}}, currentRewards.Rewards)
+		})
+	}
+}
+
+func TestAllocateTokensToValidatorCommissionCap(t *testing.T) {
+	testCases := []struct {
+		name          string
+		rate          math.LegacyDec
+		minRate       math.LegacyDec
+		maxRate       math.LegacyDec
+		expCommission math.LegacyDec
+	}{
+		{
+			name:          "no cap",
+			rate:          math.LegacyNewDecWithPrec(2, 1),
+			expCommission: math.LegacyNewDec(2),
+		},
+		{
+			name:          "rate below the cap",
+			rate:          math.LegacyNewDecWithPrec(1, 1),
+			maxRate:       math.LegacyNewDecWithPrec(15, 2),
+			expCommission: math.LegacyNewDec(1),
+		},
+		{
+			name:          "rate above the cap",
+			rate:          math.LegacyNewDecWithPrec(2, 1),
+			maxRate:       math.LegacyNewDecWithPrec(15, 2),
+			expCommission: math.LegacyNewDecWithPrec(15, 1),
+		},
+		{
+			name:          "cap below the minimum rate",
+			rate:          math.LegacyNewDecWithPrec(1, 2),
+			minRate:       math.LegacyNewDecWithPrec(5, 2),
+			maxRate:       math.LegacyNewDecWithPrec(2, 2),
+			expCommission: math.LegacyNewDecWithPrec(2, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			if !tc.minRate.IsNil() {
+				params.MinCommissionRate = tc.minRate
+			}
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+			val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			val.Commission = stakingtypes.NewCommission(tc.rate, math.LegacyOneDec(), math.LegacyNewDec(0))
+
+			if !tc.maxRate.IsNil() {
+				require.NoError(t, distrKeeper.SetValidatorCommissionCap(ctx, sdk.ValAddress(valConsAddr0), tc.maxRate))
+			}
+
+			tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10)}}
+			require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+			commission, err := distrKeeper.ValidatorsAccumulatedCommission.Get(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: tc.expCommission}}, commission.Commission)
+
+			currentRewards, err := distrKeeper.ValidatorCurrentRewards.Get(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10).Sub(tc.expCommission)}}, currentRewards.Rewards)
+		})
+	}
+}
+
+func TestAllocateTokensToValidatorCommissionExempt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// the validator takes a 20% commission on everything but atom
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(2, 1), math.LegacyOneDec(), math.LegacyNewDec(0))
+	stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr0)).Return(val, nil).AnyTimes()
+	require.NoError(t, distrKeeper.SetValidatorCommissionExemptDenoms(ctx, sdk.ValAddress(valConsAddr0), []string{"atom"}))
+
+	tokens := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(10)),
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(10)),
+	)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	commission, err := distrKeeper.ValidatorsAccumulatedCommission.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(2)}}, commission.Commission)
+
+	currentRewards, err := distrKeeper.ValidatorCurrentRewards.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	expRewards := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(10)),
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(8)),
+	)
+	require.Equal(t, expRewards, currentRewards.Rewards)
+
+	outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, tokens, outstanding.Rewards)
+
+	// once the exemption is cleared, atom is charged commission again
+	require.NoError(t, distrKeeper.SetValidatorCommissionExemptDenoms(ctx, sdk.ValAddress(valConsAddr0), nil))
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	commission, err = distrKeeper.ValidatorsAccumulatedCommission.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	expCommission := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(2)),
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(4)),
+	)
+	require.Equal(t, expCommission, commission.Commission)
+}
+
+func TestAllocateTokensToValidatorCommissionGracePeriod(t *testing.T) {
+	testCases := []struct {
+		name           string
+		gracePeriod    uint64
+		creationHeight int64 // -1 if the creation height is unknown
+		minRate        math.LegacyDec
+		expCommission  math.LegacyDec
+	}{
+		{
+			name:           "no grace period",
+			creationHeight: 95,
+			expCommission:  math.LegacyNewDec(2),
+		},
+		{
+			name:           "in grace period",
+			gracePeriod:    10,
+			creationHeight: 95,
+			expCommission:  math.LegacyZeroDec(),
+		},
+		{
+			name:           "last block of the grace period",
+			gracePeriod:    10,
+			creationHeight: 91,
+			expCommission:  math.LegacyZeroDec(),
+		},
+		{
+			name:           "in grace period with minimum rate",
+			gracePeriod:    10,
+			creationHeight: 95,
+			minRate:        math.LegacyNewDecWithPrec(5, 1),
+			expCommission:  math.LegacyZeroDec(),
+		},
+		{
+			name:           "after grace period",
+			gracePeriod:    10,
+			creationHeight: 90,
+			expCommission:  math.LegacyNewDec(2),
+		},
+		{
+			name:           "unknown creation height",
+			gracePeriod:    10,
+			creationHeight: -1,
+			expCommission:  math.LegacyNewDec(2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			params.CommissionGracePeriodBlocks = tc.gracePeriod
+			if !tc.minRate.IsNil() {
+				params.MinCommissionRate = tc.minRate
+			}
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+			val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(2, 1), math.LegacyOneDec(), math.LegacyNewDec(0))
+			stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr0)).Return(val, nil).AnyTimes()
+
+			// the validator is created at the creation height, the rewards are
+			// allocated at height 100
+			if tc.creationHeight >= 0 {
+				require.NoError(t, distrKeeper.Hooks().AfterValidatorCreated(ctx.WithBlockHeight(tc.creationHeight), sdk.ValAddress(valConsAddr0)))
+			}
+			ctx = ctx.WithBlockHeight(100)
+
+			tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10)}}
+			require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+			commission, err := distrKeeper.ValidatorsAccumulatedCommission.Get(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, tc.expCommission, commission.Commission.AmountOf(sdk.DefaultBondDenom))
+
+			// the rest is shared by the delegators
+			currentRewards, err := distrKeeper.ValidatorCurrentRewards.Get(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, math.LegacyNewDec(10).Sub(tc.expCommission), currentRewards.Rewards.AmountOf(sdk.DefaultBondDenom))
+		})
+	}
+}
+
 func TestAllocateTokensToManyValidators(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
@@ -336,3 +696,5014 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, val2OutstandingRewards.Rewards.IsValid())
 }
+
+func TestAllocateTokensDeterministic(t *testing.T) {
+	allocate := func(t *testing.T, procs int) (feePoolBz []byte, outstandingBz [][]byte) {
+		t.Helper()
+
+		prevProcs := goruntime.GOMAXPROCS(procs)
+		defer goruntime.GOMAXPROCS(prevProcs)
+
+		ctrl := gomock.NewController(t)
+		key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+		storeService := runtime.NewKVStoreService(key)
+		testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+		encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+		ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+		bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+		stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+		accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+		poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+		feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+		accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+		accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+		stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+		distrKeeper := keeper.NewKeeper(
+			encCfg.Codec,
+			storeService,
+			accountKeeper,
+			bankKeeper,
+			stakingKeeper,
+			poolKeeper,
+			"fee_collector",
+			authtypes.NewModuleAddress("gov").String(),
+		)
+
+		require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+		require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+		// create validators with uneven power and commission so that every
+		// reward is subject to truncation
+		votes := make([]comet.VoteInfo, 0, len(PKS))
+		valAddrs := make([]sdk.ValAddress, 0, len(PKS))
+		var totalPower int64
+		for i, pk := range PKS {
+			val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+			require.NoError(t, err)
+			rate := math.LegacyNewDecWithPrec(int64(i+1), 2)
+			val.Commission = stakingtypes.NewCommission(rate, rate, math.LegacyNewDec(0))
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+
+			power := int64(3*i + 7)
+			totalPower += power
+			votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: power}})
+			valAddrs = append(valAddrs, sdk.ValAddress(pk.Address()))
+		}
+
+		fees := sdk.NewCoins(
+			sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(634195840)),
+			sdk.NewCoin("utoken", math.NewInt(1234567)),
+		)
+		bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+		require.NoError(t, distrKeeper.AllocateTokens(ctx, totalPower, votes))
+
+		feePool, err := distrKeeper.FeePool.Get(ctx)
+		require.NoError(t, err)
+		feePoolBz, err = encCfg.Codec.Marshal(&feePool)
+		require.NoError(t, err)
+
+		for _, valAddr := range valAddrs {
+			outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+			require.NoError(t, err)
+			bz, err := encCfg.Codec.Marshal(&outstanding)
+			require.NoError(t, err)
+			outstandingBz = append(outstandingBz, bz)
+		}
+
+		return feePoolBz, outstandingBz
+	}
+
+	feePool1, outstanding1 := allocate(t, 1)
+	feePool8, outstanding8 := allocate(t, 8)
+
+	require.Equal(t, feePool1, feePool8)
+	require.Equal(t, outstanding1, outstanding8)
+}
+
+func TestAllocateTokensToValidatorTypedEvents(t *testing.T) {
+	ctx, distrKeeper, vals, _ := setupBatchAllocation(t, 2)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	// the second validator has a 1% commission
+	val := vals[1]
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(100)}}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	var typedEvents []proto.Message
+	for _, event := range ctx.EventManager().Events() {
+		if !strings.HasPrefix(event.Type, "cosmos.distribution.v1beta1.") {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		typedEvents = append(typedEvents, typedEvent)
+	}
+
+	require.Equal(t, []proto.Message{
+		&disttypes.EventValidatorCommission{
+			Validator: val.GetOperator(),
+			Amount:    sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(1)}},
+		},
+		&disttypes.EventValidatorRewards{
+			Validator: val.GetOperator(),
+			Amount:    tokens,
+		},
+	}, typedEvents)
+}
+
+// capturingListener records the allocations it is notified of.
+type capturingListener struct {
+	allocations []capturedAllocation
+}
+
+type capturedAllocation struct {
+	valAddr    sdk.ValAddress
+	commission sdk.DecCoins
+	shared     sdk.DecCoins
+}
+
+func (l *capturingListener) OnValidatorRewardsAllocated(_ context.Context, valAddr sdk.ValAddress, commission, shared sdk.DecCoins) {
+	l.allocations = append(l.allocations, capturedAllocation{valAddr: valAddr, commission: commission, shared: shared})
+}
+
+func TestRewardListener(t *testing.T) {
+	ctx, distrKeeper, vals, rewards := setupBatchAllocation(t, 3)
+	first, second := &capturingListener{}, &capturingListener{}
+	distrKeeper.AddRewardListener(first)
+	distrKeeper.AddRewardListener(second)
+	require.Panics(t, func() { distrKeeper.AddRewardListener(nil) })
+
+	// the second validator has a 1% commission
+	val := vals[1]
+	valAddr, err := address.NewBech32Codec("cosmosvaloper").StringToBytes(val.GetOperator())
+	require.NoError(t, err)
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(100)}}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	expected := []capturedAllocation{{
+		valAddr:    valAddr,
+		commission: sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(1)}},
+		shared:     sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(99)}},
+	}}
+	require.Equal(t, expected, first.allocations)
+	require.Equal(t, expected, second.allocations)
+
+	// the batch allocation notifies the listeners in ascending operator order
+	first.allocations = nil
+	require.NoError(t, distrKeeper.BatchAllocateTokensToValidators(ctx, rewards))
+	require.Len(t, first.allocations, len(vals))
+
+	sort.Slice(vals, func(i, j int) bool { return vals[i].GetOperator() < vals[j].GetOperator() })
+	for i, val := range vals {
+		captured := first.allocations[i]
+		require.Equal(t, val.GetOperator(), captured.valAddr.String())
+		require.Equal(t, rewards[val.GetOperator()], captured.commission.Add(captured.shared...))
+		require.True(t, rewards[val.GetOperator()].MulDecTruncate(val.GetCommission()).Equal(captured.commission))
+	}
+}
+
+// setupBatchAllocation creates a distribution keeper backed by mocks with n
+// validators of increasing commission and returns it with the rewards to
+// allocate to each of them.
+func setupBatchAllocation(tb testing.TB, n int) (sdk.Context, keeper.Keeper, []stakingtypes.Validator, map[string]sdk.DecCoins) {
+	tb.Helper()
+
+	ctrl := gomock.NewController(tb)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(tb, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(tb, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	vals := make([]stakingtypes.Validator, 0, n)
+	valsByAddr := make(map[string]stakingtypes.Validator, n)
+	rewards := make(map[string]sdk.DecCoins, n)
+	for i, pk := range simtestutil.CreateTestPubKeys(n) {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(tb, err)
+		rate := math.LegacyNewDecWithPrec(int64(i%100), 2)
+		val.Commission = stakingtypes.NewCommission(rate, rate, math.LegacyNewDec(0))
+
+		vals = append(vals, val)
+		valsByAddr[sdk.ValAddress(pk.Address()).String()] = val
+		rewards[val.GetOperator()] = sdk.DecCoins{
+			{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(int64(1000+i), 1)},
+		}
+	}
+
+	stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, valAddr sdk.ValAddress) (stakingtypes.ValidatorI, error) {
+			return valsByAddr[valAddr.String()], nil
+		},
+	).AnyTimes()
+
+	return ctx, distrKeeper, vals, rewards
+}
+
+func TestBatchAllocateTokensToValidators(t *testing.T) {
+	ctx, distrKeeper, vals, rewards := setupBatchAllocation(t, 10)
+	distrKeeper.SetRewardSampleBlocks(3)
+	ctx = ctx.WithBlockHeight(2)
+
+	// the commissions are rebated from the community pool, which a hook funds
+	// after every allocation, and a validator in the middle of the batch is
+	// frozen
+	params := disttypes.DefaultParams()
+	params.CommissionRebateRate = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	feePool := disttypes.InitialFeePool()
+	feePool.CommunityPool = sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(5)}}
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+	for _, val := range vals {
+		valBz, err := address.NewBech32Codec("cosmosvaloper").StringToBytes(val.GetOperator())
+		require.NoError(t, err)
+		require.NoError(t, distrKeeper.SetValidatorCommissionRebated(ctx, valBz, true))
+	}
+	sort.Slice(vals, func(i, j int) bool { return vals[i].GetOperator() < vals[j].GetOperator() })
+	frozenAddr, err := address.NewBech32Codec("cosmosvaloper").StringToBytes(vals[4].GetOperator())
+	require.NoError(t, err)
+	require.NoError(t, distrKeeper.SetValidatorRewardsFrozen(ctx, frozenAddr, true))
+
+	hooks := distrtestutil.NewMockDistributionHooks(gomock.NewController(t))
+	hooks.EXPECT().AfterValidatorRewardsAllocated(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ sdk.ValAddress, _ sdk.DecCoins) error {
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			if err != nil {
+				return err
+			}
+			feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoin(sdk.DefaultBondDenom, math.OneInt()))
+			return distrKeeper.FeePool.Set(ctx, feePool)
+		},
+	).Times(2 * (len(vals) - 1))
+	distrKeeper.SetHooks(hooks)
+
+	batchCtx, _ := ctx.CacheContext()
+	batchCtx = batchCtx.WithEventManager(sdk.NewEventManager())
+	loopCtx, _ := ctx.CacheContext()
+	loopCtx = loopCtx.WithEventManager(sdk.NewEventManager())
+
+	require.NoError(t, distrKeeper.BatchAllocateTokensToValidators(batchCtx, rewards))
+
+	for _, val := range vals {
+		require.NoError(t, distrKeeper.AllocateTokensToValidator(loopCtx, val, rewards[val.GetOperator()]))
+	}
+
+	// the fee pool holds the rebates, the funding of the hooks and the tokens
+	// of the frozen validator as the loop leaves them
+	batchFeePool, err := distrKeeper.FeePool.Get(batchCtx)
+	require.NoError(t, err)
+	loopFeePool, err := distrKeeper.FeePool.Get(loopCtx)
+	require.NoError(t, err)
+	require.Equal(t, loopFeePool, batchFeePool)
+
+	for _, val := range vals {
+		valBz, err := address.NewBech32Codec("cosmosvaloper").StringToBytes(val.GetOperator())
+		require.NoError(t, err)
+		if bytes.Equal(valBz, frozenAddr) {
+			has, err := distrKeeper.ValidatorOutstandingRewards.Has(batchCtx, valBz)
+			require.NoError(t, err)
+			require.False(t, has)
+			continue
+		}
+
+		batchCommission, err := distrKeeper.ValidatorsAccumulatedCommission.Get(batchCtx, valBz)
+		require.NoError(t, err)
+		loopCommission, err := distrKeeper.ValidatorsAccumulatedCommission.Get(loopCtx, valBz)
+		require.NoError(t, err)
+		require.Equal(t, loopCommission, batchCommission)
+
+		batchCurrent, err := distrKeeper.ValidatorCurrentRewards.Get(batchCtx, valBz)
+		require.NoError(t, err)
+		loopCurrent, err := distrKeeper.ValidatorCurrentRewards.Get(loopCtx, valBz)
+		require.NoError(t, err)
+		require.Equal(t, loopCurrent, batchCurrent)
+
+		batchOutstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(batchCtx, valBz)
+		require.NoError(t, err)
+		loopOutstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(loopCtx, valBz)
+		require.NoError(t, err)
+		require.Equal(t, loopOutstanding, batchOutstanding)
+
+		batchSample, err := distrKeeper.ValidatorRewardSamples.Get(batchCtx, collections.Join(sdk.ValAddress(valBz), uint64(2)))
+		require.NoError(t, err)
+		loopSample, err := distrKeeper.ValidatorRewardSamples.Get(loopCtx, collections.Join(sdk.ValAddress(valBz), uint64(2)))
+		require.NoError(t, err)
+		require.Equal(t, loopSample, batchSample)
+		require.Equal(t, rewards[val.GetOperator()], batchSample.Rewards)
+	}
+
+	require.Equal(t, loopCtx.EventManager().Events(), batchCtx.EventManager().Events())
+}
+
+func BenchmarkAllocateTokensToValidators(b *testing.B) {
+	ctx, distrKeeper, vals, rewards := setupBatchAllocation(b, 500)
+
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, val := range vals {
+				if err := distrKeeper.AllocateTokensToValidator(ctx, val, rewards[val.GetOperator()]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := distrKeeper.BatchAllocateTokensToValidators(ctx, rewards); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkAllocateTokensLargeValidatorSet measures the time and memory
+// allocated by the allocation of the fees to a large validator set. The
+// state writes of the allocation are serial, see AllocateTokens, and the
+// reward of every validator is held until the remainder is allocated.
+func BenchmarkAllocateTokensLargeValidatorSet(b *testing.B) {
+	const n = 10_000
+
+	ctrl := gomock.NewController(b)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(b, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(b, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(b, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	valsByConsAddr := make(map[string]stakingtypes.Validator, n)
+	votes := make([]comet.VoteInfo, 0, n)
+	for i := 0; i < n; i++ {
+		pk := ed25519.GenPrivKeyFromSecret([]byte{byte(i >> 8), byte(i)}).PubKey()
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(b, err)
+		val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 2), math.LegacyNewDecWithPrec(5, 2), math.LegacyNewDec(0))
+		valsByConsAddr[sdk.GetConsAddress(pk).String()] = val
+		votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 100}})
+	}
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.ValidatorI, error) {
+			return valsByConsAddr[consAddr.String()], nil
+		},
+	).AnyTimes()
+
+	fees := sdk.NewCoins(
+		sdk.NewCoin("atom", math.NewInt(1_000_003)),
+		sdk.NewCoin("photon", math.NewInt(7_777_777)),
+		sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(123_456_789)),
+	)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).AnyTimes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := distrKeeper.AllocateTokens(ctx, int64(n*100), votes); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// countingStoreService opens stores counting the reads of keys with the given
+// prefix.
+type countingStoreService struct {
+	corestore.KVStoreService
+	prefix []byte
+	reads  *int
+}
+
+func (s countingStoreService) OpenKVStore(ctx context.Context) corestore.KVStore {
+	return countingStore{KVStore: s.KVStoreService.OpenKVStore(ctx), prefix: s.prefix, reads: s.reads}
+}
+
+type countingStore struct {
+	corestore.KVStore
+	prefix []byte
+	reads  *int
+}
+
+func (s countingStore) Get(key []byte) ([]byte, error) {
+	if bytes.HasPrefix(key, s.prefix) {
+		*s.reads++
+	}
+
+	return s.KVStore.Get(key)
+}
+
+func TestAllocateTokensParamsReads(t *testing.T) {
+	// paramsReads returns the number of reads of the params by the allocation
+	// of the fees to n validators
+	paramsReads := func(n int) int {
+		ctrl := gomock.NewController(t)
+		key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+		testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+		encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+		ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+		bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+		stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+		accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+		poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+		feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+		accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+		accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+		stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+		reads := 0
+		distrKeeper := keeper.NewKeeper(
+			encCfg.Codec,
+			countingStoreService{KVStoreService: runtime.NewKVStoreService(key), prefix: disttypes.ParamsKey.Bytes(), reads: &reads},
+			accountKeeper,
+			bankKeeper,
+			stakingKeeper,
+			poolKeeper,
+			"fee_collector",
+			authtypes.NewModuleAddress("gov").String(),
+		)
+
+		// the commission of every validator is raised to the minimum rate and
+		// rebated, after the grace period
+		params := disttypes.DefaultParams()
+		params.MinCommissionRate = math.LegacyNewDecWithPrec(1, 1)
+		params.CommissionRebateRate = math.LegacyNewDecWithPrec(5, 1)
+		params.CommissionGracePeriodBlocks = 10
+		require.NoError(t, distrKeeper.Params.Set(ctx, params))
+		feePool := disttypes.InitialFeePool()
+		feePool.CommunityPool = sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+		require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+		valsByConsAddr := make(map[string]stakingtypes.Validator, n)
+		votes := make([]comet.VoteInfo, 0, n)
+		for i := 0; i < n; i++ {
+			pk := ed25519.GenPrivKeyFromSecret([]byte{byte(i)}).PubKey()
+			val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+			require.NoError(t, err)
+			require.NoError(t, distrKeeper.SetValidatorCommissionRebated(ctx, sdk.ValAddress(pk.Address()), true))
+			valsByConsAddr[sdk.GetConsAddress(pk).String()] = val
+			votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 100}})
+		}
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.ValidatorI, error) {
+				return valsByConsAddr[consAddr.String()], nil
+			},
+		).AnyTimes()
+
+		fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+		bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+		reads = 0
+		require.NoError(t, distrKeeper.AllocateTokens(ctx.WithBlockHeight(100), int64(n*100), votes))
+		return reads
+	}
+
+	// the params are read once per allocation, not once per validator
+	require.Equal(t, paramsReads(1), paramsReads(10))
+}
+
+func TestAllocateTokensInvalidCommunityTax(t *testing.T) {
+	testCases := []struct {
+		name string
+		tax  math.LegacyDec
+	}{
+		{"tax of one", math.LegacyOneDec()},
+		{"tax above one", math.LegacyNewDecWithPrec(15, 1)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+			params := disttypes.DefaultParams()
+			params.CommunityTax = tc.tax
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			votes := []comet.VoteInfo{
+				{
+					Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100},
+				},
+			}
+
+			err := distrKeeper.AllocateTokens(ctx, 100, votes)
+			require.ErrorIs(t, err, disttypes.ErrInvalidCommunityTax)
+		})
+	}
+}
+
+// mustTypedEvent converts a typed event to the event it is emitted as.
+func mustTypedEvent(tev proto.Message) sdk.Event {
+	event, err := sdk.TypedEventToEvent(tev)
+	if err != nil {
+		panic(err)
+	}
+	return event
+}
+
+func TestAllocateTokensAggregateRewardEvents(t *testing.T) {
+	testCases := []struct {
+		name      string
+		aggregate bool
+		expEvents sdk.Events
+	}{
+		{
+			name:      "per validator events",
+			aggregate: false,
+			expEvents: sdk.Events{
+				sdk.NewEvent(disttypes.EventTypeCommission,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, "24.500000000000000000stake"),
+					sdk.NewAttribute(disttypes.AttributeKeyValidator, sdk.ValAddress(valConsAddr0).String())),
+				mustTypedEvent(&disttypes.EventValidatorCommission{
+					Validator: sdk.ValAddress(valConsAddr0).String(),
+					Amount:    sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(245, 1)}},
+				}),
+				sdk.NewEvent(disttypes.EventTypeRewards,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, "49.000000000000000000stake"),
+					sdk.NewAttribute(disttypes.AttributeKeyValidator, sdk.ValAddress(valConsAddr0).String())),
+				mustTypedEvent(&disttypes.EventValidatorRewards{
+					Validator: sdk.ValAddress(valConsAddr0).String(),
+					Amount:    sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(49)}},
+				}),
+				sdk.NewEvent(disttypes.EventTypeCommission,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, "24.500000000000000000stake"),
+					sdk.NewAttribute(disttypes.AttributeKeyValidator, sdk.ValAddress(valConsAddr1).String())),
+				mustTypedEvent(&disttypes.EventValidatorCommission{
+					Validator: sdk.ValAddress(valConsAddr1).String(),
+					Amount:    sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(245, 1)}},
+				}),
+				sdk.NewEvent(disttypes.EventTypeRewards,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, "49.000000000000000000stake"),
+					sdk.NewAttribute(disttypes.AttributeKeyValidator, sdk.ValAddress(valConsAddr1).String())),
+				mustTypedEvent(&disttypes.EventValidatorRewards{
+					Validator: sdk.ValAddress(valConsAddr1).String(),
+					Amount:    sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(49)}},
+				}),
+			},
+		},
+		{
+			name:      "aggregated event",
+			aggregate: true,
+			expEvents: sdk.Events{
+				sdk.NewEvent(disttypes.EventTypeRewards,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, "98.000000000000000000stake"),
+					sdk.NewAttribute(disttypes.AttributeKeyCommunityPool, "2.000000000000000000stake")),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+			distrKeeper.SetAggregateRewardEvents(tc.aggregate)
+
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			// create two validators with 50% commission
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			votes := []comet.VoteInfo{
+				{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}},
+				{Validator: comet.Validator{Address: valConsPk1.Address(), Power: 100}},
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			require.NoError(t, distrKeeper.AllocateTokens(ctx, 200, votes))
+			require.Equal(t, tc.expEvents, ctx.EventManager().Events())
+		})
+	}
+}
+
+func TestAllocateTokensDecimalPoolRemainderEvent(t *testing.T) {
+	testCases := []struct {
+		name         string
+		pks          []cryptotypes.PubKey
+		expRemainder string
+	}{
+		{
+			// 98stake are evenly split between two validators
+			name: "no remainder",
+			pks:  []cryptotypes.PubKey{valConsPk0, valConsPk1},
+		},
+		{
+			// 98stake split in three leaves a truncation remainder
+			name:         "remainder",
+			pks:          []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2},
+			expRemainder: "0.000000000000000098stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			votes := make([]comet.VoteInfo, 0, len(tc.pks))
+			for _, pk := range tc.pks {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 100}})
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			require.NoError(t, distrKeeper.AllocateTokens(ctx, int64(100*len(tc.pks)), votes))
+
+			var remainderEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == disttypes.EventTypeDecimalPoolRemainder {
+					remainderEvents = append(remainderEvents, event)
+				}
+			}
+
+			if tc.expRemainder == "" {
+				require.Empty(t, remainderEvents)
+				return
+			}
+
+			require.Equal(t, []sdk.Event{
+				sdk.NewEvent(disttypes.EventTypeDecimalPoolRemainder,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, tc.expRemainder)),
+			}, remainderEvents)
+
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expRemainder, feePool.DecimalPool.String())
+		})
+	}
+}
+
+func TestAllocateTokensBurnRate(t *testing.T) {
+	testCases := []struct {
+		name string
+		rate math.LegacyDec
+		// expected burned and per validator outstanding rewards
+		expBurned      sdk.Coins
+		expOutstanding sdk.DecCoins
+	}{
+		{
+			name:           "no burn",
+			rate:           math.LegacyZeroDec(),
+			expBurned:      sdk.NewCoins(),
+			expOutstanding: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("7.35")), sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(49))),
+		},
+		{
+			// 1.5atom is truncated to 1atom
+			name:           "burn 10%",
+			rate:           math.LegacyNewDecWithPrec(1, 1),
+			expBurned:      sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(1)), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10))),
+			expOutstanding: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("6.86")), sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("44.1"))),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress()).AnyTimes()
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			params.BurnRate = tc.rate
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			votes := make([]comet.VoteInfo, 0, 2)
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 100}})
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(15)), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+			if !tc.expBurned.IsZero() {
+				bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(distrAcc.GetAddress()), tc.expBurned)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 200, votes)
+			require.NoError(t, err)
+
+			// the burned fees are neither allocated to the validators nor to
+			// the community pool
+			require.Equal(t, sdk.NewDecCoinsFromCoins(tc.expBurned...), result.Burned)
+			require.Equal(t, sdk.NewDecCoinsFromCoins(fees.Sub(tc.expBurned...)...), result.TotalFees)
+			require.Equal(t, result.TotalFees, result.ToValidators.Add(result.ToCommunityPool...).Add(result.Remainder...))
+
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+				outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(pk.Address()))
+				require.NoError(t, err)
+				require.Equal(t, tc.expOutstanding, outstanding.Rewards)
+			}
+
+			var burnEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == disttypes.EventTypeBurnFees {
+					burnEvents = append(burnEvents, event)
+				}
+			}
+			if tc.expBurned.IsZero() {
+				require.Empty(t, burnEvents)
+			} else {
+				require.Equal(t, []sdk.Event{
+					sdk.NewEvent(disttypes.EventTypeBurnFees, sdk.NewAttribute(sdk.AttributeKeyAmount, tc.expBurned.String())),
+				}, burnEvents)
+			}
+		})
+	}
+}
+
+func TestAllocateTokensInfraFund(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rate     math.LegacyDec
+		burnRate math.LegacyDec
+		// expected burned, infra fund cut and per validator outstanding rewards
+		expBurned      sdk.Coins
+		expInfraFund   sdk.Coins
+		expOutstanding sdk.DecCoins
+	}{
+		{
+			name:           "no infra fund",
+			rate:           math.LegacyZeroDec(),
+			burnRate:       math.LegacyZeroDec(),
+			expBurned:      sdk.NewCoins(),
+			expInfraFund:   sdk.NewCoins(),
+			expOutstanding: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("7.35")), sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(49))),
+		},
+		{
+			name:           "infra fund 20%",
+			rate:           math.LegacyNewDecWithPrec(2, 1),
+			burnRate:       math.LegacyZeroDec(),
+			expBurned:      sdk.NewCoins(),
+			expInfraFund:   sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(3)), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(20))),
+			expOutstanding: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("5.88")), sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("39.2"))),
+		},
+		{
+			// the cut is taken from the fees left after the burn, 2.8atom being
+			// truncated to 2atom
+			name:           "infra fund 20% after a 10% burn",
+			rate:           math.LegacyNewDecWithPrec(2, 1),
+			burnRate:       math.LegacyNewDecWithPrec(1, 1),
+			expBurned:      sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(1)), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10))),
+			expInfraFund:   sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(2)), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(18))),
+			expOutstanding: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("5.88")), sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("35.28"))),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress()).AnyTimes()
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			params.InfraFundRate = tc.rate
+			params.InfraFundModule = "infra"
+			params.BurnRate = tc.burnRate
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			votes := make([]comet.VoteInfo, 0, 2)
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 100}})
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(15)), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+			if !tc.expBurned.IsZero() {
+				bankKeeper.EXPECT().BurnCoins(gomock.Any(), []byte(distrAcc.GetAddress()), tc.expBurned)
+			}
+			if !tc.expInfraFund.IsZero() {
+				bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, "infra", tc.expInfraFund)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 200, votes)
+			require.NoError(t, err)
+
+			// the infra fund cut is neither allocated to the validators nor to
+			// the community pool
+			require.Equal(t, sdk.NewDecCoinsFromCoins(tc.expInfraFund...), result.ToInfraFund)
+			require.Equal(t, sdk.NewDecCoinsFromCoins(fees.Sub(tc.expBurned...).Sub(tc.expInfraFund...)...), result.TotalFees)
+			require.Equal(t, result.TotalFees, result.ToValidators.Add(result.ToCommunityPool...).Add(result.Remainder...))
+
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+				outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(pk.Address()))
+				require.NoError(t, err)
+				require.Equal(t, tc.expOutstanding, outstanding.Rewards)
+			}
+
+			var infraFundEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == disttypes.EventTypeInfraFund {
+					infraFundEvents = append(infraFundEvents, event)
+				}
+			}
+			if tc.expInfraFund.IsZero() {
+				require.Empty(t, infraFundEvents)
+			} else {
+				require.Equal(t, []sdk.Event{
+					sdk.NewEvent(disttypes.EventTypeInfraFund,
+						sdk.NewAttribute(sdk.AttributeKeyModule, "infra"),
+						sdk.NewAttribute(sdk.AttributeKeyAmount, tc.expInfraFund.String())),
+				}, infraFundEvents)
+			}
+		})
+	}
+}
+
+func TestAllocateTokensSubsidy(t *testing.T) {
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	testCases := []struct {
+		name               string
+		floor              sdk.Coins
+		fees               int64
+		communityPool      sdk.DecCoins
+		expSubsidy         sdk.DecCoins
+		expOutstanding     sdk.DecCoins
+		expToCommunityPool sdk.DecCoins
+		expCommunityPool   sdk.DecCoins
+	}{
+		{
+			name:               "no subsidy floor",
+			fees:               40,
+			communityPool:      decCoins("100"),
+			expSubsidy:         sdk.DecCoins{},
+			expOutstanding:     decCoins("19.6"),
+			expToCommunityPool: decCoins("0.8"),
+			expCommunityPool:   decCoins("100.8"),
+		},
+		{
+			name:               "fees above the floor",
+			floor:              sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))),
+			fees:               150,
+			communityPool:      decCoins("100"),
+			expSubsidy:         sdk.DecCoins{},
+			expOutstanding:     decCoins("73.5"),
+			expToCommunityPool: decCoins("3"),
+			expCommunityPool:   decCoins("103"),
+		},
+		{
+			name:               "low fees subsidized up to the floor",
+			floor:              sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))),
+			fees:               40,
+			communityPool:      decCoins("100"),
+			expSubsidy:         decCoins("60"),
+			expOutstanding:     decCoins("49"),
+			expToCommunityPool: decCoins("2"),
+			expCommunityPool:   decCoins("42"),
+		},
+		{
+			name:               "subsidy capped by the community pool",
+			floor:              sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))),
+			fees:               40,
+			communityPool:      decCoins("25"),
+			expSubsidy:         decCoins("25"),
+			expOutstanding:     decCoins("31.85"),
+			expToCommunityPool: decCoins("1.3"),
+			expCommunityPool:   decCoins("1.3"),
+		},
+		{
+			name:               "depleted community pool",
+			floor:              sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))),
+			fees:               40,
+			communityPool:      sdk.DecCoins{},
+			expSubsidy:         sdk.DecCoins{},
+			expOutstanding:     decCoins("19.6"),
+			expToCommunityPool: decCoins("0.8"),
+			expCommunityPool:   decCoins("0.8"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			params.SubsidyFloor = tc.floor
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			feePool := disttypes.InitialFeePool()
+			feePool.CommunityPool = tc.communityPool
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+			votes := make([]comet.VoteInfo, 0, 2)
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 100}})
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(tc.fees)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 200, votes)
+			require.NoError(t, err)
+
+			// the subsidy is allocated with the fees but is not counted as
+			// collected
+			require.Equal(t, sdk.NewDecCoinsFromCoins(fees...), result.TotalFees)
+			require.Equal(t, tc.expSubsidy, result.Subsidy)
+			require.Equal(t, result.TotalFees.Add(result.Subsidy...), result.ToValidators.Add(result.ToCommunityPool...).Add(result.Remainder...))
+			require.Equal(t, tc.expToCommunityPool, result.ToCommunityPool)
+
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+				outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(pk.Address()))
+				require.NoError(t, err)
+				require.Equal(t, tc.expOutstanding, outstanding.Rewards)
+			}
+
+			// the community pool pays the subsidy and is never drained below zero
+			feePool, err = distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expCommunityPool, feePool.CommunityPool)
+
+			// the subsidy moves funds within the module, the rewards are
+			// conserved
+			_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+			require.False(t, broken)
+
+			var subsidyEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == disttypes.EventTypeSubsidizeRewards {
+					subsidyEvents = append(subsidyEvents, event)
+				}
+			}
+			if tc.expSubsidy.IsZero() {
+				require.Empty(t, subsidyEvents)
+			} else {
+				require.Equal(t, []sdk.Event{
+					sdk.NewEvent(disttypes.EventTypeSubsidizeRewards, sdk.NewAttribute(sdk.AttributeKeyAmount, tc.expSubsidy.String())),
+				}, subsidyEvents)
+			}
+		})
+	}
+}
+
+func TestAllocateTokensSkipsZeroPowerVotes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// create two validators with 0% commission, the second one has no power
+	valAddr0 := sdk.ValAddress(valConsAddr0)
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val0.Commission = stakingtypes.NewCommission(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0, nil).AnyTimes()
+
+	valAddr1 := sdk.ValAddress(valConsAddr1)
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	votes := []comet.VoteInfo{
+		{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}},
+		{Validator: comet.Validator{Address: valConsPk1.Address(), Power: 0}},
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 100, votes))
+
+	val0OutstandingRewards, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(98)}}, val0OutstandingRewards.Rewards)
+
+	// no state was written for the zero power validator
+	_, err = distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr1)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	_, err = distrKeeper.ValidatorsAccumulatedCommission.Get(ctx, valAddr1)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	_, err = distrKeeper.ValidatorCurrentRewards.Get(ctx, valAddr1)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// and no events were emitted for it
+	for _, event := range ctx.EventManager().Events() {
+		for _, attr := range event.Attributes {
+			if attr.Key == disttypes.AttributeKeyValidator {
+				require.NotEqual(t, valAddr1.String(), attr.Value)
+			}
+		}
+	}
+}
+
+func TestAllocateTokensDuplicateVotes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// create two validators with 0% commission
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		val.Commission = stakingtypes.NewCommission(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	// the first validator appears twice in the votes
+	votes := []comet.VoteInfo{
+		{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 50}},
+		{Validator: comet.Validator{Address: valConsPk1.Address(), Power: 50}},
+		{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}},
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 200, votes)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.ValidatorsRewarded)
+
+	// the rewards of both votes are summed
+	outstanding0, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr("73.5")}}, outstanding0.Rewards)
+
+	current0, err := distrKeeper.ValidatorCurrentRewards.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, outstanding0.Rewards, current0.Rewards)
+
+	outstanding1, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(valConsAddr1))
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr("24.5")}}, outstanding1.Rewards)
+
+	// and allocated with a single rewards event
+	var rewardEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == disttypes.EventTypeRewards {
+			rewardEvents = append(rewardEvents, event)
+		}
+	}
+	require.Equal(t, []sdk.Event{
+		sdk.NewEvent(disttypes.EventTypeRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "73.500000000000000000stake"),
+			sdk.NewAttribute(disttypes.AttributeKeyValidator, sdk.ValAddress(valConsAddr0).String())),
+		sdk.NewEvent(disttypes.EventTypeRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "24.500000000000000000stake"),
+			sdk.NewAttribute(disttypes.AttributeKeyValidator, sdk.ValAddress(valConsAddr1).String())),
+	}, rewardEvents)
+}
+
+func TestAllocateTokensWithResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// create three validators with equal power so that each share is truncated
+	votes := make([]comet.VoteInfo, 0, 3)
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2} {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+		votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 3, votes)
+	require.NoError(t, err)
+
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+	require.Equal(t, disttypes.AllocationResult{
+		TotalFees:          decCoins("100"),
+		Burned:             sdk.DecCoins{},
+		ToInfraFund:        sdk.DecCoins{},
+		Subsidy:            sdk.DecCoins{},
+		Escrowed:           sdk.DecCoins{},
+		RoundingDeficit:    sdk.DecCoins{},
+		ToValidators:       decCoins("97.999999999999999902"),
+		ToProposer:         sdk.DecCoins{},
+		CommunityTax:       decCoins("2"),
+		ToCommunityPool:    decCoins("2"),
+		Remainder:          decCoins("0.000000000000000098"),
+		ValidatorsRewarded: 3,
+	}, result)
+
+	// the result matches the state
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, result.ToCommunityPool, feePool.CommunityPool)
+	require.Equal(t, result.Remainder, feePool.DecimalPool)
+	require.Equal(t, result.TotalFees, result.ToValidators.Add(result.ToCommunityPool...).Add(result.Remainder...))
+}
+
+func TestAllocateTokensDistributionDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).Times(2)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.DistributionPaused = true
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// create three validators with equal power
+	votes := make([]comet.VoteInfo, 0, 3)
+	valAddrs := make([]sdk.ValAddress, 0, 3)
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2} {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+		votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+		valAddrs = append(valAddrs, sdk.ValAddress(pk.Address()))
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(2)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).Times(2)
+
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	// while paused, all the fees are held in the decimal pool
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 3, votes)
+	require.NoError(t, err)
+	require.Equal(t, decCoins("100"), result.Remainder)
+	require.True(t, result.ToValidators.IsZero())
+	require.True(t, result.ToCommunityPool.IsZero())
+	require.Zero(t, result.ValidatorsRewarded)
+
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, feePool.CommunityPool.IsZero())
+	require.Equal(t, decCoins("100"), feePool.DecimalPool)
+	for _, valAddr := range valAddrs {
+		_, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	}
+
+	// once resumed, the collected fees are distributed normally
+	params.DistributionPaused = false
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	result, err = distrKeeper.AllocateTokensWithResult(ctx, 3, votes)
+	require.NoError(t, err)
+	require.Equal(t, decCoins("97.999999999999999902"), result.ToValidators)
+	require.Equal(t, decCoins("2"), result.ToCommunityPool)
+	require.Equal(t, 3, result.ValidatorsRewarded)
+
+	// no tokens are lost across the paused and resumed blocks
+	feePool, err = distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, decCoins("100.000000000000000098"), feePool.DecimalPool)
+
+	total := feePool.CommunityPool.Add(feePool.DecimalPool...)
+	for _, valAddr := range valAddrs {
+		outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+		require.NoError(t, err)
+		total = total.Add(outstanding.Rewards...)
+	}
+	require.Equal(t, decCoins("200"), total)
+}
+
+func TestAllocateTokensMissingValidator(t *testing.T) {
+	errStore := errors.New("store error")
+	testCases := []struct {
+		name   string
+		val    stakingtypes.ValidatorI
+		err    error
+		expErr error
+	}{
+		{name: "validator not found", val: stakingtypes.Validator{}, err: stakingtypes.ErrNoValidatorFound},
+		{name: "nil validator"},
+		{name: "other error", val: stakingtypes.Validator{}, err: errStore, expErr: errStore},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			// create two validators, the validator of the third vote has
+			// been removed since it voted
+			votes := make([]comet.VoteInfo, 0, 3)
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+			}
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk2)).Return(tc.val, tc.err)
+			votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: valConsPk2.Address(), Power: 1}})
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 3, votes)
+			if tc.expErr != nil {
+				// the error is wrapped with the consensus address of the validator
+				require.ErrorIs(t, err, tc.expErr)
+				require.ErrorContains(t, err, sdk.GetConsAddress(valConsPk2).String())
+				return
+			}
+			require.NoError(t, err)
+
+			// the share of the missing validator is added to the community pool
+			decCoins := func(amount string) sdk.DecCoins {
+				return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+			}
+			require.Equal(t, disttypes.AllocationResult{
+				TotalFees:          decCoins("100"),
+				Burned:             sdk.DecCoins{},
+				ToInfraFund:        sdk.DecCoins{},
+				Subsidy:            sdk.DecCoins{},
+				Escrowed:           sdk.DecCoins{},
+				RoundingDeficit:    sdk.DecCoins{},
+				ToValidators:       decCoins("65.333333333333333268"),
+				ToProposer:         sdk.DecCoins{},
+				CommunityTax:       decCoins("2"),
+				ToCommunityPool:    decCoins("34.666666666666666634"),
+				Remainder:          decCoins("0.000000000000000098"),
+				ValidatorsRewarded: 2,
+			}, result)
+
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, result.ToCommunityPool, feePool.CommunityPool)
+			require.Equal(t, result.Remainder, feePool.DecimalPool)
+		})
+	}
+}
+
+func TestAllocateTokensFrozenValidator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// create two validators with equal power, the second one being frozen
+	vals := make([]stakingtypes.ValidatorI, 0, 2)
+	votes := make([]comet.VoteInfo, 0, 2)
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+		stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(pk.Address())).Return(val, nil).AnyTimes()
+		vals = append(vals, val)
+		votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+	}
+	frozenAddr := sdk.ValAddress(valConsAddr1)
+	require.NoError(t, distrKeeper.SetValidatorRewardsFrozen(ctx, frozenAddr, true))
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	decCoins := func(amount int64) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(amount)}}
+	}
+
+	// the share of the frozen validator is added to the community pool with
+	// the community tax
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 2, votes)
+	require.NoError(t, err)
+	require.Equal(t, decCoins(49), result.ToValidators)
+	require.Equal(t, decCoins(51), result.ToCommunityPool)
+	require.Equal(t, 1, result.ValidatorsRewarded)
+
+	has, err := distrKeeper.ValidatorOutstandingRewards.Has(ctx, frozenAddr)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// so are the tokens allocated directly, or in a batch, to the frozen
+	// validator
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, vals[1], decCoins(10)))
+	require.NoError(t, distrKeeper.BatchAllocateTokensToValidators(ctx, map[string]sdk.DecCoins{
+		vals[0].GetOperator(): decCoins(5),
+		vals[1].GetOperator(): decCoins(5),
+	}))
+
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, decCoins(66), feePool.CommunityPool)
+
+	has, err = distrKeeper.ValidatorOutstandingRewards.Has(ctx, frozenAddr)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, decCoins(54), outstanding.Rewards)
+
+	// once unfrozen, the validator accrues rewards again
+	require.NoError(t, distrKeeper.SetValidatorRewardsFrozen(ctx, frozenAddr, false))
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, vals[1], decCoins(10)))
+
+	outstanding, err = distrKeeper.ValidatorOutstandingRewards.Get(ctx, frozenAddr)
+	require.NoError(t, err)
+	require.Equal(t, decCoins(10), outstanding.Rewards)
+}
+
+func TestAllocateTokensRewardMultiplier(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	// the community pool holds 10stake
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	feePool := disttypes.InitialFeePool()
+	feePool.CommunityPool = decCoins("10")
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+	// create two validators with equal power, the first one with a bonus and
+	// the second one with a penalty
+	votes := make([]comet.VoteInfo, 0, 2)
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+		votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+	}
+	bonusAddr, penaltyAddr := sdk.ValAddress(valConsAddr0), sdk.ValAddress(valConsAddr1)
+	require.NoError(t, distrKeeper.SetValidatorRewardMultiplier(ctx, bonusAddr, math.LegacyNewDecWithPrec(12, 1)))
+	require.NoError(t, distrKeeper.SetValidatorRewardMultiplier(ctx, penaltyAddr, math.LegacyNewDecWithPrec(8, 1)))
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(2)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).Times(2)
+
+	requireOutstanding := func(valAddr sdk.ValAddress, exp sdk.DecCoins) {
+		t.Helper()
+		outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, exp, outstanding.Rewards)
+	}
+	requireCommunityPool := func(exp sdk.DecCoins) {
+		t.Helper()
+		feePool, err := distrKeeper.FeePool.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, exp, feePool.CommunityPool)
+	}
+
+	// each validator is allocated 49stake: the bonus of 9.8stake is taken
+	// from the community pool and the penalty of 9.8stake is added to it with
+	// the 2stake of community tax
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 2, votes)
+	require.NoError(t, err)
+	require.Equal(t, decCoins("88.2"), result.ToValidators)
+	requireOutstanding(bonusAddr, decCoins("58.8"))
+	requireOutstanding(penaltyAddr, decCoins("39.2"))
+	requireCommunityPool(decCoins("12"))
+
+	_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+	require.False(t, broken)
+
+	// a bonus of 49stake is capped to the 12stake of the community pool,
+	// which is then added the 2stake of community tax, and a reset
+	// multiplier allocates the whole reward
+	require.NoError(t, distrKeeper.SetValidatorRewardMultiplier(ctx, bonusAddr, math.LegacyNewDec(2)))
+	require.NoError(t, distrKeeper.SetValidatorRewardMultiplier(ctx, penaltyAddr, math.LegacyOneDec()))
+
+	result, err = distrKeeper.AllocateTokensWithResult(ctx, 2, votes)
+	require.NoError(t, err)
+	require.Equal(t, decCoins("98"), result.ToValidators)
+	requireOutstanding(bonusAddr, decCoins("119.8"))
+	requireOutstanding(penaltyAddr, decCoins("88.2"))
+	requireCommunityPool(decCoins("2"))
+
+	_, broken = keeper.RewardConservationInvariant(distrKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestAllocateTokensCommissionRebate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	// half of the commission of a rebated validator is rebated, the
+	// community pool holds 10stake
+	params := disttypes.DefaultParams()
+	params.CommissionRebateRate = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	feePool := disttypes.InitialFeePool()
+	feePool.CommunityPool = decCoins("10")
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+	// create two validators with equal power and a 50% commission, the first
+	// one being rebated
+	vals := make([]stakingtypes.ValidatorI, 0, 2)
+	votes := make([]comet.VoteInfo, 0, 2)
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyOneDec(), math.LegacyZeroDec())
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+		stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(pk.Address())).Return(val, nil).AnyTimes()
+		vals = append(vals, val)
+		votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+	}
+	rebatedAddr, otherAddr := sdk.ValAddress(valConsAddr0), sdk.ValAddress(valConsAddr1)
+	require.NoError(t, distrKeeper.SetValidatorCommissionRebated(ctx, rebatedAddr, true))
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	requireRewards := func(valAddr sdk.ValAddress, expCommission, expCurrent, expOutstanding sdk.DecCoins) {
+		t.Helper()
+		commission, err := distrKeeper.ValidatorsAccumulatedCommission.Get(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, expCommission, commission.Commission)
+		current, err := distrKeeper.ValidatorCurrentRewards.Get(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, expCurrent, current.Rewards)
+		outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, expOutstanding, outstanding.Rewards)
+	}
+	requireCommunityPool := func(exp sdk.DecCoins) {
+		t.Helper()
+		feePool, err := distrKeeper.FeePool.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, exp, feePool.CommunityPool)
+	}
+
+	// each validator is allocated 49stake, the rebate of 12.25stake of the
+	// rebated validator is capped to the 10stake of the community pool, which
+	// is then added the 2stake of community tax
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 2, votes)
+	require.NoError(t, err)
+	require.Equal(t, decCoins("98"), result.ToValidators)
+	requireRewards(rebatedAddr, decCoins("24.5"), decCoins("34.5"), decCoins("59"))
+	requireRewards(otherAddr, decCoins("24.5"), decCoins("24.5"), decCoins("49"))
+	requireCommunityPool(decCoins("2"))
+
+	_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+	require.False(t, broken)
+
+	// the rebate of a direct allocation drains the rest of the community pool
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, vals[0], decCoins("10")))
+	requireRewards(rebatedAddr, decCoins("29.5"), decCoins("41.5"), decCoins("71"))
+	requireCommunityPool(nil)
+
+	// nothing is rebated from an empty community pool
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, vals[0], decCoins("10")))
+	requireRewards(rebatedAddr, decCoins("34.5"), decCoins("46.5"), decCoins("81"))
+	requireCommunityPool(nil)
+
+	// once the community pool is funded again, the allocations are rebated in
+	// full, in a batch too, the other validator never being rebated
+	feePool, err = distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	feePool.CommunityPool = decCoins("100")
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+	require.NoError(t, distrKeeper.BatchAllocateTokensToValidators(ctx, map[string]sdk.DecCoins{
+		vals[0].GetOperator(): decCoins("10"),
+		vals[1].GetOperator(): decCoins("10"),
+	}))
+	requireRewards(rebatedAddr, decCoins("39.5"), decCoins("54"), decCoins("93.5"))
+	requireRewards(otherAddr, decCoins("29.5"), decCoins("29.5"), decCoins("59"))
+	requireCommunityPool(decCoins("97.5"))
+
+	// once removed from the rebated validators, the validator is no longer
+	// rebated
+	require.NoError(t, distrKeeper.SetValidatorCommissionRebated(ctx, rebatedAddr, false))
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, vals[0], decCoins("10")))
+	requireRewards(rebatedAddr, decCoins("44.5"), decCoins("59"), decCoins("103.5"))
+	requireCommunityPool(decCoins("97.5"))
+}
+
+func TestAllocateTokensWithholdJailedRewards(t *testing.T) {
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	testCases := []struct {
+		name               string
+		withhold           bool
+		expToValidators    sdk.DecCoins
+		expToCommunityPool sdk.DecCoins
+		expRewarded        int
+	}{
+		{
+			name:               "jailed validator rewarded",
+			withhold:           false,
+			expToValidators:    decCoins("97.999999999999999902"),
+			expToCommunityPool: decCoins("2"),
+			expRewarded:        3,
+		},
+		{
+			name:               "jailed validator rewards withheld",
+			withhold:           true,
+			expToValidators:    decCoins("65.333333333333333268"),
+			expToCommunityPool: decCoins("34.666666666666666634"),
+			expRewarded:        2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			params.WithholdJailedRewards = tc.withhold
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			// create three validators with equal power, the last one is jailed
+			votes := make([]comet.VoteInfo, 0, 3)
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2} {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				val.Jailed = pk == valConsPk2
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 3, votes)
+			require.NoError(t, err)
+			require.Equal(t, tc.expToValidators, result.ToValidators)
+			require.Equal(t, tc.expToCommunityPool, result.ToCommunityPool)
+			require.Equal(t, decCoins("0.000000000000000098"), result.Remainder)
+			require.Equal(t, tc.expRewarded, result.ValidatorsRewarded)
+
+			// the jailed validator is credited only if its rewards are not withheld
+			_, err = distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(valConsPk2.Address()))
+			if tc.withhold {
+				require.ErrorIs(t, err, collections.ErrNotFound)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAllocateTokensMinSelfBondForRewards(t *testing.T) {
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	testCases := []struct {
+		name               string
+		minSelfBond        math.Int
+		expToValidators    sdk.DecCoins
+		expToCommunityPool sdk.DecCoins
+		expRewarded        []bool
+	}{
+		{
+			name:               "no minimum",
+			minSelfBond:        math.ZeroInt(),
+			expToValidators:    decCoins("97.999999999999999902"),
+			expToCommunityPool: decCoins("2"),
+			expRewarded:        []bool{true, true, true},
+		},
+		{
+			name:               "validator without self-delegation below the minimum",
+			minSelfBond:        math.NewInt(50),
+			expToValidators:    decCoins("65.333333333333333268"),
+			expToCommunityPool: decCoins("34.666666666666666634"),
+			expRewarded:        []bool{true, true, false},
+		},
+		{
+			name:               "slashed self-delegation below the minimum",
+			minSelfBond:        math.NewInt(51),
+			expToValidators:    decCoins("32.666666666666666634"),
+			expToCommunityPool: decCoins("67.333333333333333268"),
+			expRewarded:        []bool{true, false, false},
+		},
+		{
+			name:               "every validator below the minimum",
+			minSelfBond:        math.NewInt(61),
+			expToValidators:    sdk.DecCoins{},
+			expToCommunityPool: decCoins("99.999999999999999902"),
+			expRewarded:        []bool{false, false, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			params.MinSelfBondForRewards = tc.minSelfBond
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			// create three validators with equal power: the first one
+			// self-delegates 60 tokens, the second one self-delegated 100
+			// tokens before being slashed by half and the last one has no
+			// self-delegation
+			pks := []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2}
+			selfShares := []math.LegacyDec{math.LegacyNewDec(60), math.LegacyNewDec(100), {}}
+			votes := make([]comet.VoteInfo, 0, len(pks))
+			for i, pk := range pks {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				if i == 1 {
+					val.Tokens = math.NewInt(50)
+				}
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+
+				// the self-delegations are only looked up if a minimum is set
+				if !tc.minSelfBond.IsPositive() {
+					continue
+				}
+				valAddr := sdk.ValAddress(pk.Address())
+				if selfShares[i].IsNil() {
+					stakingKeeper.EXPECT().Delegation(gomock.Any(), sdk.AccAddress(valAddr), valAddr).Return(nil, collections.ErrNotFound)
+				} else {
+					del := stakingtypes.NewDelegation(sdk.AccAddress(valAddr).String(), valAddr.String(), selfShares[i])
+					stakingKeeper.EXPECT().Delegation(gomock.Any(), sdk.AccAddress(valAddr), valAddr).Return(del, nil)
+				}
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 3, votes)
+			require.NoError(t, err)
+			require.Equal(t, tc.expToValidators, result.ToValidators)
+			require.Equal(t, tc.expToCommunityPool, result.ToCommunityPool)
+
+			// only the validators with enough self-delegation are credited
+			for i, pk := range pks {
+				_, err = distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(pk.Address()))
+				if tc.expRewarded[i] {
+					require.NoError(t, err)
+				} else {
+					require.ErrorIs(t, err, collections.ErrNotFound)
+				}
+			}
+		})
+	}
+}
+
+func TestAllocateTokensMaxRewardedValidators(t *testing.T) {
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	// the second vote has no power and is never rewarded nor ranked, the
+	// first and fourth votes tie with 20 power
+	powers := []int64{20, 0, 30, 20, 30}
+
+	testCases := []struct {
+		name               string
+		maxRewarded        uint64
+		expToValidators    sdk.DecCoins
+		expToCommunityPool sdk.DecCoins
+		expRewarded        []bool
+	}{
+		{
+			name:               "unlimited",
+			maxRewarded:        0,
+			expToValidators:    decCoins("98"),
+			expToCommunityPool: decCoins("2"),
+			expRewarded:        []bool{true, false, true, true, true},
+		},
+		{
+			name:               "cap not exceeded by the votes with power",
+			maxRewarded:        4,
+			expToValidators:    decCoins("98"),
+			expToCommunityPool: decCoins("2"),
+			expRewarded:        []bool{true, false, true, true, true},
+		},
+		{
+			name:               "tie broken by the order of the votes",
+			maxRewarded:        3,
+			expToValidators:    decCoins("78.4"),
+			expToCommunityPool: decCoins("21.6"),
+			expRewarded:        []bool{true, false, true, false, true},
+		},
+		{
+			name:               "only the top validators rewarded",
+			maxRewarded:        2,
+			expToValidators:    decCoins("58.8"),
+			expToCommunityPool: decCoins("41.2"),
+			expRewarded:        []bool{false, false, true, false, true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			params.MaxRewardedValidators = tc.maxRewarded
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			// only the rewarded validators are looked up
+			votes := make([]comet.VoteInfo, 0, len(powers))
+			for i, power := range powers {
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: PKS[i].Address(), Power: power}})
+				if !tc.expRewarded[i] {
+					continue
+				}
+
+				val, err := distrtestutil.CreateValidator(PKS[i], math.NewInt(power))
+				require.NoError(t, err)
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(PKS[i])).Return(val, nil)
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+			require.NoError(t, err)
+			require.Equal(t, tc.expToValidators, result.ToValidators)
+			require.Equal(t, tc.expToCommunityPool, result.ToCommunityPool)
+
+			for i, pk := range PKS {
+				_, err = distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(pk.Address()))
+				if tc.expRewarded[i] {
+					require.NoError(t, err)
+				} else {
+					require.ErrorIs(t, err, collections.ErrNotFound)
+				}
+			}
+
+			_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+			require.False(t, broken)
+		})
+	}
+}
+
+func TestAllocateTokensAllocationEpochAccumulation(t *testing.T) {
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(valConsAddr0), sdk.ValAddress(valConsAddr1), sdk.ValAddress(valConsAddr2)}
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).Times(3)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.AllocationEpochBlocks = 3
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	votes := []comet.VoteInfo{
+		{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 50}, BlockIDFlag: comet.BlockIDFlagCommit},
+		{Validator: comet.Validator{Address: valConsPk1.Address(), Power: 50}, BlockIDFlag: comet.BlockIDFlagCommit},
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(3)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).Times(3)
+
+	// the fees of the blocks before the end of the epoch are held in the
+	// carried fees, no validator is looked up nor rewarded
+	held := sdk.DecCoins{}
+	for block := uint64(1); block < 3; block++ {
+		result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoinsFromCoins(fees...), result.Carried)
+		require.True(t, result.ToValidators.IsZero())
+
+		held = held.Add(sdk.NewDecCoinsFromCoins(fees...)...)
+		feePool, err := distrKeeper.FeePool.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, held, feePool.CarriedFees)
+
+		epoch, err := distrKeeper.AllocationEpoch.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, disttypes.AllocationEpoch{Blocks: block, TotalPower: 100 * int64(block)}, epoch)
+
+		_, err = distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddrs[0])
+		require.ErrorIs(t, err, collections.ErrNotFound)
+
+		_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+		require.False(t, broken)
+	}
+
+	// the last block of the epoch allocates the fees held with its own fees
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(50))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0, nil)
+
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(50))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk1)).Return(val1, nil)
+
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+	require.NoError(t, err)
+	require.Equal(t, held, result.Released)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(294)}}, result.ToValidators)
+
+	for _, valAddr := range valAddrs[:2] {
+		outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(147)}}, outstanding.Rewards)
+	}
+
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, feePool.CarriedFees.IsZero())
+
+	// the epoch is cleared for the next one
+	_, err = distrKeeper.AllocationEpoch.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = distrKeeper.AllocationEpochPowers.Get(ctx, sdk.GetConsAddress(valConsPk0))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestAllocateTokensAllocationEpochAveragePower(t *testing.T) {
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(valConsAddr0), sdk.ValAddress(valConsAddr1), sdk.ValAddress(valConsAddr2)}
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).Times(2)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.AllocationEpochBlocks = 2
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(2)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).Times(2)
+
+	// the first validator grows from 10 to 30 power, the second one misses
+	// the second block and the third one joins the set in the second block
+	_, err := distrKeeper.AllocateTokensWithResult(ctx, 50, []comet.VoteInfo{
+		{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 10}, BlockIDFlag: comet.BlockIDFlagCommit},
+		{Validator: comet.Validator{Address: valConsPk1.Address(), Power: 40}, BlockIDFlag: comet.BlockIDFlagCommit},
+	})
+	require.NoError(t, err)
+
+	power, err := distrKeeper.AllocationEpochPowers.Get(ctx, sdk.GetConsAddress(valConsPk1))
+	require.NoError(t, err)
+	require.Equal(t, disttypes.AllocationEpochPower{Power: 40, SignedPower: 40}, power)
+
+	// the averaged power of the second validator is split between a commit
+	// and an absent vote, it is looked up once for each
+	for i, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2} {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(10))
+		require.NoError(t, err)
+		require.Equal(t, valAddrs[i].String(), val.GetOperator())
+		call := stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil)
+		if i == 1 {
+			call.Times(2)
+		}
+	}
+
+	// the averaged powers are 20, 40 and 20 out of a total of 80, the 200
+	// fees of the epoch less the community tax are split accordingly
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 110, []comet.VoteInfo{
+		{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 30}, BlockIDFlag: comet.BlockIDFlagCommit},
+		{Validator: comet.Validator{Address: valConsPk1.Address(), Power: 40}, BlockIDFlag: comet.BlockIDFlagAbsent},
+		{Validator: comet.Validator{Address: valConsPk2.Address(), Power: 40}, BlockIDFlag: comet.BlockIDFlagCommit},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(196)}}, result.ToValidators)
+
+	for i, expReward := range []int64{49, 98, 49} {
+		outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddrs[i])
+		require.NoError(t, err)
+		require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(expReward)}}, outstanding.Rewards)
+	}
+
+	_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+	require.False(t, broken)
+}
+
+// uptimeFactors provides fixed uptime factors to the allocation
+type uptimeFactors map[string]math.LegacyDec
+
+func (f uptimeFactors) GetUptimeFactors(context.Context) (map[string]math.LegacyDec, error) {
+	return f, nil
+}
+
+func TestAllocateTokensUptimeFactors(t *testing.T) {
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	testCases := []struct {
+		name               string
+		factors            uptimeFactors
+		expRewards         []sdk.DecCoins
+		expToCommunityPool sdk.DecCoins
+		expErr             error
+	}{
+		{
+			name:               "no uptime provider",
+			expRewards:         []sdk.DecCoins{decCoins("49"), decCoins("49")},
+			expToCommunityPool: decCoins("2"),
+		},
+		{
+			name: "full uptime",
+			factors: uptimeFactors{
+				sdk.ConsAddress(valConsAddr0).String(): math.LegacyOneDec(),
+			},
+			expRewards:         []sdk.DecCoins{decCoins("49"), decCoins("49")},
+			expToCommunityPool: decCoins("2"),
+		},
+		{
+			name: "reduced uptime",
+			factors: uptimeFactors{
+				sdk.ConsAddress(valConsAddr0).String(): math.LegacyNewDecWithPrec(5, 1),
+				sdk.ConsAddress(valConsAddr1).String(): math.LegacyNewDecWithPrec(9, 1),
+			},
+			expRewards:         []sdk.DecCoins{decCoins("24.5"), decCoins("44.1")},
+			expToCommunityPool: decCoins("31.4"),
+		},
+		{
+			name: "no uptime",
+			factors: uptimeFactors{
+				sdk.ConsAddress(valConsAddr0).String(): math.LegacyZeroDec(),
+			},
+			expRewards:         []sdk.DecCoins{nil, decCoins("49")},
+			expToCommunityPool: decCoins("51"),
+		},
+		{
+			name: "factor above one",
+			factors: uptimeFactors{
+				sdk.ConsAddress(valConsAddr0).String(): math.LegacyNewDecWithPrec(15, 1),
+			},
+			expErr: disttypes.ErrInvalidUptimeFactor,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+			if tc.factors != nil {
+				distrKeeper.SetUptimeProvider(tc.factors)
+			}
+
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			votes := make([]comet.VoteInfo, 0, 2)
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(50))
+				require.NoError(t, err)
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).MaxTimes(1)
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 50}})
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expToCommunityPool, result.ToCommunityPool)
+
+			for i, valAddr := range []sdk.ValAddress{sdk.ValAddress(valConsAddr0), sdk.ValAddress(valConsAddr1)} {
+				outstanding, err := distrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+				require.NoError(t, err)
+				require.Equal(t, tc.expRewards[i], outstanding)
+			}
+
+			_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+			require.False(t, broken)
+		})
+	}
+}
+
+func TestAllocateTokensEscrowJailedRewards(t *testing.T) {
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	testCases := []struct {
+		name      string
+		tombstone bool
+	}{
+		{name: "unjail releases the escrow"},
+		{name: "tombstone forfeits the escrow", tombstone: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+			tombstoned := false
+			distrKeeper.SetTombstoneFunc(func(_ context.Context, consAddr sdk.ConsAddress) bool {
+				return tombstoned && consAddr.Equals(sdk.GetConsAddress(valConsPk2))
+			})
+
+			params := disttypes.DefaultParams()
+			params.EscrowJailedRewards = true
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			// create three validators with equal power, the last one is jailed
+			var jailedVal stakingtypes.Validator
+			votes := make([]comet.VoteInfo, 0, 3)
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2} {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				if pk == valConsPk2 {
+					val.Jailed = true
+					jailedVal = val
+					stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).DoAndReturn(
+						func(context.Context, sdk.ConsAddress) (stakingtypes.ValidatorI, error) { return jailedVal, nil },
+					).AnyTimes()
+					stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(pk.Address())).DoAndReturn(
+						func(context.Context, sdk.ValAddress) (stakingtypes.ValidatorI, error) { return jailedVal, nil },
+					).AnyTimes()
+				} else {
+					stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				}
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+			}
+			jailedAddr := sdk.ValAddress(valConsPk2.Address())
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			allocate := func() disttypes.AllocationResult {
+				bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+				bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+				result, err := distrKeeper.AllocateTokensWithResult(ctx, 3, votes)
+				require.NoError(t, err)
+
+				_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+				require.False(t, broken)
+				return result
+			}
+
+			// the share of the jailed validator is escrowed instead of being
+			// credited to it
+			result := allocate()
+			require.Equal(t, decCoins("32.666666666666666634"), result.Escrowed)
+			require.Equal(t, decCoins("65.333333333333333268"), result.ToValidators)
+			require.Equal(t, decCoins("2"), result.ToCommunityPool)
+			require.Equal(t, 2, result.ValidatorsRewarded)
+
+			_, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, jailedAddr)
+			require.ErrorIs(t, err, collections.ErrNotFound)
+			escrowed, err := distrKeeper.ValidatorEscrowedRewards.Get(ctx, jailedAddr)
+			require.NoError(t, err)
+			require.Equal(t, result.Escrowed, escrowed.Rewards)
+			require.Equal(t, result.Escrowed, distrKeeper.GetTotalEscrowedRewards(ctx))
+
+			// the escrow is not released while the validator is jailed
+			_, err = distrKeeper.ReleaseEscrowedRewards(ctx, jailedAddr)
+			require.ErrorIs(t, err, disttypes.ErrValidatorJailed)
+
+			if !tc.tombstone {
+				// the escrow is released once the validator is bonded again
+				jailedVal.Jailed = false
+				require.NoError(t, distrKeeper.Hooks().AfterValidatorBonded(ctx, sdk.GetConsAddress(valConsPk2), jailedAddr))
+
+				outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, jailedAddr)
+				require.NoError(t, err)
+				require.Equal(t, escrowed.Rewards, outstanding.Rewards)
+				currentRewards, err := distrKeeper.ValidatorCurrentRewards.Get(ctx, jailedAddr)
+				require.NoError(t, err)
+				require.Equal(t, escrowed.Rewards, currentRewards.Rewards)
+				require.Empty(t, distrKeeper.GetTotalEscrowedRewards(ctx))
+
+				// releasing an empty escrow is a no-op
+				released, err := distrKeeper.ReleaseEscrowedRewards(ctx, jailedAddr)
+				require.NoError(t, err)
+				require.True(t, released.IsZero())
+
+				// the validator is credited its share of the next blocks
+				result = allocate()
+				require.True(t, result.Escrowed.IsZero())
+				require.Equal(t, 3, result.ValidatorsRewarded)
+				return
+			}
+
+			// the escrow and the share of a tombstoned validator are forfeited
+			// to the community pool
+			tombstoned = true
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			result = allocate()
+			require.True(t, result.Escrowed.IsZero())
+			require.Equal(t, decCoins("34.666666666666666634"), result.ToCommunityPool)
+			require.Empty(t, distrKeeper.GetTotalEscrowedRewards(ctx))
+
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, decCoins("2").Add(escrowed.Rewards...).Add(result.ToCommunityPool...), feePool.CommunityPool)
+
+			var forfeitEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == disttypes.EventTypeForfeitEscrowedRewards {
+					forfeitEvents = append(forfeitEvents, event)
+				}
+			}
+			require.Equal(t, []sdk.Event{
+				sdk.NewEvent(
+					disttypes.EventTypeForfeitEscrowedRewards,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, escrowed.Rewards.String()),
+					sdk.NewAttribute(disttypes.AttributeKeyValidator, jailedAddr.String()),
+				),
+			}, forfeitEvents)
+		})
+	}
+}
+
+func TestAllocateTokensPowerWeightFunc(t *testing.T) {
+	sqrt := func(power int64) math.LegacyDec {
+		weight, err := math.LegacyNewDec(power).ApproxSqrt()
+		if err != nil {
+			panic(err)
+		}
+		return weight
+	}
+
+	testCases := []struct {
+		name   string
+		weight disttypes.PowerWeightFunc
+		// expected outstanding rewards of the validators, in the order of
+		// valConsPk0, valConsPk1 and valConsPk2
+		expOutstanding []string
+		expErr         error
+	}{
+		{
+			name:           "linear",
+			expOutstanding: []string{"6.999999999999999944", "27.999999999999999972", "62.999999999999999986"},
+		},
+		{
+			name:           "square root",
+			weight:         sqrt,
+			expOutstanding: []string{"16.333333333333333268", "32.666666666666666634", "49"},
+		},
+		{
+			name:   "negative weight",
+			weight: func(power int64) math.LegacyDec { return math.LegacyNewDec(-power) },
+			expErr: disttypes.ErrInvalidPowerWeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+			if tc.weight != nil {
+				distrKeeper.SetPowerWeightFunc(tc.weight)
+			}
+
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			// create three validators with a power of 1, 4 and 9
+			pks := []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2}
+			votes := make([]comet.VoteInfo, 0, len(pks))
+			for i, pk := range pks {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				power := int64((i + 1) * (i + 1))
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: power}})
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 14, votes)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for i, pk := range pks {
+				outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(pk.Address()))
+				require.NoError(t, err)
+				require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(tc.expOutstanding[i])}}, outstanding.Rewards)
+			}
+
+			// no tokens are lost whatever the weighting
+			require.Equal(t, result.TotalFees, result.ToValidators.Add(result.ToCommunityPool...).Add(result.Remainder...))
+		})
+	}
+}
+
+var errNoDecimalPower = errors.New("no decimal power")
+
+type decimalPowers map[string]math.LegacyDec
+
+func (p decimalPowers) GetValidatorPower(_ context.Context, consAddr sdk.ConsAddress) (math.LegacyDec, error) {
+	power, ok := p[consAddr.String()]
+	if !ok {
+		return math.LegacyDec{}, errNoDecimalPower
+	}
+	return power, nil
+}
+
+func TestAllocateTokensPowerSource(t *testing.T) {
+	pks := []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2}
+	powers := func(amounts ...string) decimalPowers {
+		p := decimalPowers{}
+		for i, amount := range amounts {
+			p[sdk.GetConsAddress(pks[i]).String()] = math.LegacyMustNewDecFromStr(amount)
+		}
+		return p
+	}
+
+	testCases := []struct {
+		name   string
+		source disttypes.PowerSource
+		// expected outstanding rewards of the validators, in the order of
+		// valConsPk0, valConsPk1 and valConsPk2
+		expOutstanding []string
+		expErr         error
+	}{
+		{
+			name:           "int64 power",
+			expOutstanding: []string{"6.999999999999999944", "27.999999999999999972", "62.999999999999999986"},
+		},
+		{
+			name:           "decimal power equal to the int64 power",
+			source:         powers("1", "4", "9"),
+			expOutstanding: []string{"6.999999999999999944", "27.999999999999999972", "62.999999999999999986"},
+		},
+		{
+			name:           "fractional decimal power",
+			source:         powers("2.5", "5", "12.5"),
+			expOutstanding: []string{"12.25", "24.5", "61.25"},
+		},
+		{
+			name:   "negative decimal power",
+			source: powers("1", "-4", "9"),
+			expErr: disttypes.ErrInvalidPowerWeight,
+		},
+		{
+			name:   "missing decimal power",
+			source: powers("1", "4"),
+			expErr: errNoDecimalPower,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+			if tc.source != nil {
+				distrKeeper.SetPowerSource(tc.source)
+			}
+
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			// create three validators with an int64 power of 1, 4 and 9
+			votes := make([]comet.VoteInfo, 0, len(pks))
+			for i, pk := range pks {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				power := int64((i + 1) * (i + 1))
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: power}})
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 14, votes)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for i, pk := range pks {
+				outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(pk.Address()))
+				require.NoError(t, err)
+				require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(tc.expOutstanding[i])}}, outstanding.Rewards)
+			}
+
+			// no tokens are lost whatever the power
+			require.Equal(t, result.TotalFees, result.ToValidators.Add(result.ToCommunityPool...).Add(result.Remainder...))
+		})
+	}
+}
+
+func TestAllocateTokensTelemetry(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{})
+	})
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val, nil).AnyTimes()
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 100, votes))
+
+	data := sink.Data()
+	require.NotEmpty(t, data)
+	gauges := data[len(data)-1].Gauges
+
+	expected := map[string]float32{
+		"test.distribution.allocation.fees_collected;denom=stake": 100,
+		"test.distribution.allocation.validators;denom=stake":     98,
+		"test.distribution.allocation.community_pool;denom=stake": 2,
+		"test.distribution.allocation.validators_rewarded":        1,
+	}
+	for name, value := range expected {
+		gauge, ok := gauges[name]
+		require.True(t, ok, "missing gauge %s", name)
+		require.Equal(t, value, gauge.Value)
+	}
+}
+
+func TestAllocateTokensMultipleFeeCollectors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	protocolFeesAcc := authtypes.NewEmptyModuleAccount("protocol_fees")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "protocol_fees").Return(protocolFeesAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	distrKeeper.SetFeeCollectorNames("fee_collector", "protocol_fees")
+
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	valAddr0 := sdk.ValAddress(valConsAddr0)
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0, nil).AnyTimes()
+
+	// both fee sources are drained into the distribution module account
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(60)))
+	protocolFees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(40)), sdk.NewCoin("utoken", math.NewInt(50)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), protocolFeesAcc.GetAddress()).Return(protocolFees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "protocol_fees", disttypes.ModuleName, protocolFees)
+
+	votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fees.Add(protocolFees...)...), result.TotalFees)
+
+	// 98% of the sum of both sources goes to the validator
+	val0OutstandingRewards, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{
+		{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(98)},
+		{Denom: "utoken", Amount: math.LegacyNewDec(49)},
+	}, val0OutstandingRewards.Rewards)
+}
+
+func TestAllocateTokensSetFeeCollectorName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	newFeeCollectorAcc := authtypes.NewEmptyModuleAccount("new_fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAddress("new_fee_collector").Return(newFeeCollectorAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "new_fee_collector").Return(newFeeCollectorAcc)
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0, nil).AnyTimes()
+	votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+
+	// the fees are drained from the fee collector given to the keeper
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fees...), result.TotalFees)
+
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	_, err = msgServer.SetFeeCollectorName(ctx, disttypes.NewMsgSetFeeCollectorName(authtypes.NewModuleAddress("gov").String(), "new_fee_collector"))
+	require.NoError(t, err)
+
+	// after the switch the fees are drained from the new fee collector only
+	newFees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(50)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), newFeeCollectorAcc.GetAddress()).Return(newFees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "new_fee_collector", disttypes.ModuleName, newFees)
+
+	result, err = distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(newFees...), result.TotalFees)
+}
+
+func TestAllocateTokensDistributableDenoms(t *testing.T) {
+	testCases := []struct {
+		name                string
+		distributableDenoms []string
+		expFees             sdk.Coins
+	}{
+		{
+			name:    "every denom distributable",
+			expFees: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)), sdk.NewCoin("uspam", math.NewInt(1000))),
+		},
+		{
+			name:                "spam denom filtered out",
+			distributableDenoms: []string{sdk.DefaultBondDenom},
+			expFees:             sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))),
+		},
+		{
+			name:                "no distributable denom collected",
+			distributableDenoms: []string{"utoken"},
+			expFees:             sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			params.DistributableDenoms = tc.distributableDenoms
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			valAddr0 := sdk.ValAddress(valConsAddr0)
+			val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0, nil).AnyTimes()
+
+			// only the distributable fees are moved out of the fee collector
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)), sdk.NewCoin("uspam", math.NewInt(1000)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, tc.expFees)
+
+			votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDecCoinsFromCoins(tc.expFees...), result.TotalFees)
+
+			// 98% of the distributable fees goes to the validator
+			val0OutstandingRewards, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr0)
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDecCoinsFromCoins(tc.expFees...).MulDec(math.LegacyNewDecWithPrec(98, 2)), val0OutstandingRewards.Rewards)
+		})
+	}
+}
+
+func TestAllocateTokensDecimalPoolFlush(t *testing.T) {
+	testCases := []struct {
+		name             string
+		decimalPool      string
+		expCommunityPool string
+		expDecimalPool   string
+	}{
+		{"at threshold", "5.5", "0", "5.5"},
+		{"above threshold", "6.5", "6", "0.5"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			params.DecimalPoolFlushThreshold = math.NewInt(5)
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+			decCoins := func(amount string) sdk.DecCoins {
+				return sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr(amount)))
+			}
+			feePool := disttypes.InitialFeePool()
+			feePool.DecimalPool = decCoins(tc.decimalPool)
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+			// no fees are collected, only the existing decimal pool is considered
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(sdk.Coins{})
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, sdk.Coins{})
+
+			require.NoError(t, distrKeeper.AllocateTokens(ctx, 0, nil))
+
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.True(t, decCoins(tc.expCommunityPool).Equal(feePool.CommunityPool))
+			require.True(t, decCoins(tc.expDecimalPool).Equal(feePool.DecimalPool))
+		})
+	}
+}
+
+func TestAllocateTokensZeroPowerStrategy(t *testing.T) {
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	testCases := []struct {
+		name     string
+		strategy disttypes.ZeroPowerStrategy
+		// expected fee pool after the block with no bonded power
+		expCommunityPool sdk.DecCoins
+		expDecimalPool   sdk.DecCoins
+		expCarriedFees   sdk.DecCoins
+		// expected outstanding rewards of the validator after the next block
+		// with bonded power, which collects no fees
+		expOutstanding sdk.DecCoins
+	}{
+		{
+			name:             "community pool",
+			strategy:         disttypes.ZeroPowerStrategyCommunityPool,
+			expCommunityPool: decCoins("100"),
+		},
+		{
+			name:           "decimal pool",
+			strategy:       disttypes.ZeroPowerStrategyDecimalPool,
+			expDecimalPool: decCoins("100"),
+		},
+		{
+			// the carried fees are allocated in the next block, net of the
+			// 2% community tax
+			name:           "carry",
+			strategy:       disttypes.ZeroPowerStrategyCarry,
+			expCarriedFees: decCoins("100"),
+			expOutstanding: decCoins("98"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).Times(2)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			params.ZeroPowerStrategy = tc.strategy
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			// a block with no bonded power
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 0, nil)
+			require.NoError(t, err)
+			require.Equal(t, 0, result.ValidatorsRewarded)
+
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.True(t, tc.expCommunityPool.Equal(feePool.CommunityPool))
+			require.True(t, tc.expDecimalPool.Equal(feePool.DecimalPool))
+			require.True(t, tc.expCarriedFees.Equal(feePool.CarriedFees))
+			require.True(t, tc.expCarriedFees.Equal(result.Carried))
+			_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+			require.False(t, broken)
+
+			// the next block with bonded power collects no fees
+			val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr0).Return(val, nil).AnyTimes()
+			votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(sdk.Coins{})
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, sdk.Coins{})
+
+			result, err = distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+			require.NoError(t, err)
+			require.True(t, tc.expCarriedFees.Equal(result.Released))
+
+			outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(valConsPk0.Address()))
+			require.NoError(t, err)
+			require.True(t, tc.expOutstanding.Equal(outstanding.Rewards))
+
+			feePool, err = distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.True(t, feePool.CarriedFees.IsZero())
+			_, broken = keeper.RewardConservationInvariant(distrKeeper)(ctx)
+			require.False(t, broken)
+		})
+	}
+}
+
+func TestAllocateTokensRemainderStrategy(t *testing.T) {
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	testCases := []struct {
+		name         string
+		strategy     disttypes.RemainderStrategy
+		expRemainder sdk.DecCoins
+		// expected outstanding rewards of the validators, in the order of
+		// valConsPk0, valConsPk1 and valConsPk2
+		expOutstanding []string
+	}{
+		{
+			name:           "decimal pool",
+			strategy:       disttypes.RemainderStrategyDecimalPool,
+			expRemainder:   decCoins("0.000000000000000098"),
+			expOutstanding: []string{"32.666666666666666634", "32.666666666666666634", "32.666666666666666634"},
+		},
+		{
+			name:           "proportional",
+			strategy:       disttypes.RemainderStrategyProportional,
+			expRemainder:   sdk.DecCoins{},
+			expOutstanding: []string{"32.666666666666666667", "32.666666666666666667", "32.666666666666666666"},
+		},
+		{
+			name:           "proposer",
+			strategy:       disttypes.RemainderStrategyProposer,
+			expRemainder:   sdk.DecCoins{},
+			expOutstanding: []string{"32.666666666666666634", "32.666666666666666732", "32.666666666666666634"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+			distrKeeper.SetRemainderStrategy(tc.strategy)
+
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+			require.NoError(t, distrKeeper.PreviousProposer.Set(ctx, valConsAddr1))
+
+			// create three validators with equal power so that each share is truncated
+			votes := make([]comet.VoteInfo, 0, 3)
+			valAddrs := make([][]byte, 0, 3)
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2} {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+
+				valAddr, err := address.NewBech32Codec("cosmosvaloper").StringToBytes(val.GetOperator())
+				require.NoError(t, err)
+				valAddrs = append(valAddrs, valAddr)
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 3, votes)
+			require.NoError(t, err)
+			require.Equal(t, tc.expRemainder, result.Remainder)
+			require.Equal(t, decCoins("2"), result.ToCommunityPool)
+
+			// the total allocated matches the fees collected
+			require.Equal(t, result.TotalFees, result.ToValidators.Add(result.ToCommunityPool...).Add(result.Remainder...))
+
+			total := sdk.DecCoins{}
+			for i, valAddr := range valAddrs {
+				outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+				require.NoError(t, err)
+				require.Equal(t, decCoins(tc.expOutstanding[i]), outstanding.Rewards)
+				total = total.Add(outstanding.Rewards...)
+			}
+
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			total = total.Add(feePool.CommunityPool...).Add(feePool.DecimalPool...)
+			require.Equal(t, sdk.NewDecCoinsFromCoins(fees...), total)
+		})
+	}
+}
+
+func TestAllocateTokensRoundingMode(t *testing.T) {
+	const blocks = 1000
+
+	// allocate random fees to six validators of equal power over many blocks,
+	// each power fraction of 1/6 is truncated down or rounded up
+	// it returns the dust left in the decimal pool and the number of blocks
+	// with a rounding deficit
+	allocate := func(t *testing.T, mode disttypes.RoundingMode) (sdk.DecCoins, int) {
+		t.Helper()
+
+		ctrl := gomock.NewController(t)
+		key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+		storeService := runtime.NewKVStoreService(key)
+		testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+		encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+		ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+		bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+		stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+		accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+		poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+		feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+		accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+		accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+		stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+		distrKeeper := keeper.NewKeeper(
+			encCfg.Codec,
+			storeService,
+			accountKeeper,
+			bankKeeper,
+			stakingKeeper,
+			poolKeeper,
+			"fee_collector",
+			authtypes.NewModuleAddress("gov").String(),
+		)
+
+		params := disttypes.DefaultParams()
+		params.RoundingMode = mode
+		require.NoError(t, distrKeeper.Params.Set(ctx, params))
+		require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+		votes := make([]comet.VoteInfo, 0, 6)
+		valAddrs := make([][]byte, 0, 6)
+		for _, pk := range simtestutil.CreateTestPubKeys(6) {
+			val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+			require.NoError(t, err)
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+			votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+
+			valAddr, err := address.NewBech32Codec("cosmosvaloper").StringToBytes(val.GetOperator())
+			require.NoError(t, err)
+			valAddrs = append(valAddrs, valAddr)
+		}
+
+		r := rand.New(rand.NewSource(1))
+		collected := sdk.NewCoins()
+		deficits := 0
+		for i := 0; i < blocks; i++ {
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1+r.Int63n(1_000_000))))
+			collected = collected.Add(fees...)
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 6, votes)
+			require.NoError(t, err)
+			if !result.RoundingDeficit.IsZero() {
+				deficits++
+			}
+		}
+
+		feePool, err := distrKeeper.FeePool.Get(ctx)
+		require.NoError(t, err)
+
+		// the allocation conserves the collected fees in both modes
+		total := feePool.CommunityPool.Add(feePool.DecimalPool...)
+		for _, valAddr := range valAddrs {
+			outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+			require.NoError(t, err)
+			total = total.Add(outstanding.Rewards...)
+		}
+		require.Equal(t, sdk.NewDecCoinsFromCoins(collected...), total)
+
+		return feePool.DecimalPool, deficits
+	}
+
+	truncatedDust, truncatedDeficits := allocate(t, disttypes.RoundingModeTruncate)
+	roundedDust, roundedDeficits := allocate(t, disttypes.RoundingModeRound)
+
+	// the truncation dust grows with every block, while the rounding deficits
+	// are drawn from the dust of other blocks
+	require.Zero(t, truncatedDeficits)
+	require.Positive(t, roundedDeficits)
+	require.True(t, truncatedDust.IsAllPositive())
+	require.True(t, roundedDust.AmountOf(sdk.DefaultBondDenom).MulInt64(100).LT(truncatedDust.AmountOf(sdk.DefaultBondDenom)))
+}
+
+func TestAllocateTokensProposerReward(t *testing.T) {
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	testCases := []struct {
+		name     string
+		proposer sdk.ConsAddress
+		// block id flag of the second validator's vote, the first one always commits
+		secondVote       comet.BlockIDFlag
+		expOutstanding0  string
+		expOutstanding1  string
+		expCommunityPool string
+	}{
+		{
+			// proposer multiplier = 0.01 + 0.04 * 1 = 0.05, vote multiplier = 0.93
+			name:             "all votes committed",
+			proposer:         valConsAddr0,
+			secondVote:       comet.BlockIDFlagCommit,
+			expOutstanding0:  "51.5",
+			expOutstanding1:  "46.5",
+			expCommunityPool: "2",
+		},
+		{
+			// proposer multiplier = 0.01 + 0.04 * 0.5 = 0.03, vote multiplier = 0.95
+			name:             "half of the votes committed",
+			proposer:         valConsAddr0,
+			secondVote:       comet.BlockIDFlagAbsent,
+			expOutstanding0:  "50.5",
+			expOutstanding1:  "47.5",
+			expCommunityPool: "2",
+		},
+		{
+			// the proposer reward goes to the community pool
+			name:             "unknown proposer",
+			proposer:         valConsAddr2,
+			secondVote:       comet.BlockIDFlagCommit,
+			expOutstanding0:  "46.5",
+			expOutstanding1:  "46.5",
+			expCommunityPool: "7",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			params.BaseProposerReward = math.LegacyNewDecWithPrec(1, 2)
+			params.BonusProposerReward = math.LegacyNewDecWithPrec(4, 2)
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+			require.NoError(t, distrKeeper.PreviousProposer.Set(ctx, tc.proposer))
+
+			val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+			require.NoError(t, err)
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr0).Return(val0, nil).AnyTimes()
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr1).Return(val1, nil).AnyTimes()
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr2).Return(nil, stakingtypes.ErrNoValidatorFound).AnyTimes()
+
+			votes := []comet.VoteInfo{
+				{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}, BlockIDFlag: comet.BlockIDFlagCommit},
+				{Validator: comet.Validator{Address: valConsPk1.Address(), Power: 100}, BlockIDFlag: tc.secondVote},
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			require.NoError(t, distrKeeper.AllocateTokens(ctx, 200, votes))
+
+			val0Rewards, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, decCoins(tc.expOutstanding0), val0Rewards.Rewards)
+
+			val1Rewards, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(valConsAddr1))
+			require.NoError(t, err)
+			require.Equal(t, decCoins(tc.expOutstanding1), val1Rewards.Rewards)
+
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, decCoins(tc.expCommunityPool), feePool.CommunityPool)
+		})
+	}
+}
+
+func TestAllocateTokensVotePowerMismatch(t *testing.T) {
+	testCases := []struct {
+		name               string
+		totalPreviousPower int64
+		strict             bool
+		expLog             bool
+		expErr             error
+	}{
+		{"matching power", 200, false, false, nil},
+		{"mismatch within tolerance", 210, true, false, nil},
+		{"mismatch is logged", 300, false, true, nil},
+		{"mismatch fails in strict mode", 300, true, false, disttypes.ErrVotePowerMismatch},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+
+			var logs bytes.Buffer
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()}).WithLogger(log.NewLogger(&logs, log.ColorOption(false)))
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+			distrKeeper.SetVotePowerCheck(math.LegacyNewDecWithPrec(1, 1), tc.strict)
+
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+			require.NoError(t, err)
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr0).Return(val0, nil).AnyTimes()
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr1).Return(val1, nil).AnyTimes()
+
+			votes := []comet.VoteInfo{
+				{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}},
+				{Validator: comet.Validator{Address: valConsPk1.Address(), Power: 100}},
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			err = distrKeeper.AllocateTokens(ctx, tc.totalPreviousPower, votes)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expLog, strings.Contains(logs.String(), "sum of vote powers does not match total previous power"))
+		})
+	}
+}
+
+func TestAllocateTokensSummaryLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+
+	var logs bytes.Buffer
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()}).WithLogger(log.NewLogger(&logs, log.OutputJSONOption()))
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	votes := make([]comet.VoteInfo, 0, 3)
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2} {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+		votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 3, votes))
+
+	// a single summary line is logged at the end of the allocation
+	var summaries []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		if entry["message"] == "allocated tokens" {
+			summaries = append(summaries, entry)
+		}
+	}
+	require.Len(t, summaries, 1)
+
+	summary := summaries[0]
+	require.Equal(t, "debug", summary["level"])
+	require.Equal(t, "x/distribution", summary["module"])
+	require.Equal(t, "100.000000000000000000stake", summary["total_fees"])
+	require.Equal(t, "2.000000000000000000stake", summary["community_tax"])
+	require.Equal(t, "97.999999999999999902stake", summary["to_validators"])
+	require.Equal(t, "2.000000000000000000stake", summary["to_community_pool"])
+	require.Equal(t, "0.000000000000000098stake", summary["to_decimal_pool"])
+	require.Equal(t, float64(3), summary["validator_count"])
+}
+
+func TestAllocateTokensToValidatorHooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// register two hooks capturing their callbacks
+	type callback struct {
+		valAddr sdk.ValAddress
+		tokens  sdk.DecCoins
+	}
+	var calls []callback
+	capture := func(_ context.Context, valAddr sdk.ValAddress, tokens sdk.DecCoins) error {
+		calls = append(calls, callback{valAddr, tokens})
+		return nil
+	}
+	hook0 := distrtestutil.NewMockDistributionHooks(ctrl)
+	hook0.EXPECT().AfterValidatorRewardsAllocated(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(capture).Times(2)
+	hook1 := distrtestutil.NewMockDistributionHooks(ctrl)
+	hook1.EXPECT().AfterValidatorRewardsAllocated(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(capture).Times(2)
+	distrKeeper.SetHooks(disttypes.NewMultiDistributionHooks(hook0, hook1))
+	require.Panics(t, func() { distrKeeper.SetHooks(hook0) })
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+	require.NoError(t, err)
+
+	tokens0 := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10)}}
+	tokens1 := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(20)}}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val0, tokens0))
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val1, tokens1))
+
+	valAddr0, err := valCodec.StringToBytes(val0.GetOperator())
+	require.NoError(t, err)
+	valAddr1, err := valCodec.StringToBytes(val1.GetOperator())
+	require.NoError(t, err)
+
+	// every hook is called, in order, once the rewards are allocated
+	require.Equal(t, []callback{
+		{valAddr0, tokens0},
+		{valAddr0, tokens0},
+		{valAddr1, tokens1},
+		{valAddr1, tokens1},
+	}, calls)
+
+	// an error returned by a hook is returned to the caller
+	hook0.EXPECT().AfterValidatorRewardsAllocated(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("hook failure"))
+	hook1.EXPECT().AfterValidatorRewardsAllocated(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	require.ErrorContains(t, distrKeeper.AllocateTokensToValidator(ctx, val0, tokens0), "hook failure")
+}
+
+func TestAllocateTokensCommunityPoolFundedHooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), sdk.DefaultBondDenom).Return(true).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// the decimal pool is flushed at the end of the allocation
+	params := disttypes.DefaultParams()
+	params.DecimalPoolFlushThreshold = math.OneInt()
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	feePool := disttypes.InitialFeePool()
+	feePool.DecimalPool = sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr("5.5")}}
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+	// register two hooks capturing their callbacks
+	type callback struct {
+		amount sdk.DecCoins
+		source string
+	}
+	var calls []callback
+	capture := func(_ context.Context, amount sdk.DecCoins, source string) error {
+		calls = append(calls, callback{amount, source})
+		return nil
+	}
+	hook0 := distrtestutil.NewMockDistributionHooks(ctrl)
+	hook0.EXPECT().AfterValidatorRewardsAllocated(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	hook0.EXPECT().AfterCommunityPoolFunded(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(capture).Times(2)
+	hook1 := distrtestutil.NewMockDistributionHooks(ctrl)
+	hook1.EXPECT().AfterValidatorRewardsAllocated(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	hook1.EXPECT().AfterCommunityPoolFunded(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(capture).Times(2)
+	distrKeeper.SetHooks(disttypes.NewMultiDistributionHooks(hook0, hook1))
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val, nil)
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 100, votes))
+
+	// every hook is told of the community tax, then of the integer part of
+	// the decimal pool flushed to the community pool
+	communityTax := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(2)}}
+	flushed := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(5)}}
+	require.Equal(t, []callback{
+		{communityTax, disttypes.CommunityPoolSourceAllocation},
+		{communityTax, disttypes.CommunityPoolSourceAllocation},
+		{flushed, disttypes.CommunityPoolSourceDecimalPool},
+		{flushed, disttypes.CommunityPoolSourceDecimalPool},
+	}, calls)
+
+	feePool, err = distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, communityTax.Add(flushed...), feePool.CommunityPool)
+}
+
+func TestAllocateTokensContextCanceled(t *testing.T) {
+	testCases := []struct {
+		name string
+		// cancelAfter is the number of validators allocated before the
+		// context is canceled
+		cancelAfter int
+	}{
+		{name: "canceled before the allocation", cancelAfter: 0},
+		{name: "canceled mid-allocation", cancelAfter: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+
+			goCtx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			ctx := testCtx.Ctx.WithContext(goCtx).WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			// cancel the context once enough validators are allocated
+			allocated := 0
+			hooks := distrtestutil.NewMockDistributionHooks(ctrl)
+			hooks.EXPECT().AfterValidatorRewardsAllocated(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(context.Context, sdk.ValAddress, sdk.DecCoins) error {
+					allocated++
+					if allocated == tc.cancelAfter {
+						cancel()
+					}
+					return nil
+				},
+			).Times(tc.cancelAfter)
+			distrKeeper.SetHooks(hooks)
+
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			pks := []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2}
+			votes := make([]comet.VoteInfo, 0, len(pks))
+			for _, pk := range pks {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			if tc.cancelAfter == 0 {
+				cancel()
+			}
+			_, err := distrKeeper.AllocateTokensWithResult(ctx, int64(len(pks)), votes)
+			require.ErrorIs(t, err, context.Canceled)
+
+			// the allocation stops once canceled, and the rewards of the
+			// validators allocated before are rolled back
+			for _, pk := range pks {
+				_, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(pk.Address()))
+				require.ErrorIs(t, err, collections.ErrNotFound)
+			}
+		})
+	}
+}
+
+func TestAllocateTokensMalformedVoteAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// the vote with an empty address is rejected before the fees are
+	// collected or any validator is looked up
+	votes := []comet.VoteInfo{
+		{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}},
+		{Validator: comet.Validator{Address: []byte{}, Power: 100}},
+		{Validator: comet.Validator{Address: valConsPk2.Address(), Power: 100}},
+	}
+
+	_, err := distrKeeper.AllocateTokensWithResult(ctx, 300, votes)
+	require.ErrorIs(t, err, disttypes.ErrInvalidVote)
+	require.ErrorContains(t, err, "vote 1 with address")
+	require.NotContains(t, err.Error(), "vote 0")
+	require.NotContains(t, err.Error(), "vote 2")
+
+	_, _, err = distrKeeper.SimulateAllocation(ctx, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), 300, votes)
+	require.ErrorIs(t, err, disttypes.ErrInvalidVote)
+
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk2} {
+		_, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(pk.Address()))
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	}
+}
+
+func TestComputeAllocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.CommunityTax = math.LegacyNewDecWithPrec(15, 2)
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// three validators of different power and commission
+	pks := []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2}
+	votes := make([]comet.VoteInfo, 0, len(pks))
+	for i, pk := range pks {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		rate := math.LegacyNewDecWithPrec(int64(5*i), 2)
+		val.Commission = stakingtypes.NewCommission(rate, rate, math.LegacyNewDec(0))
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+		votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: int64(10 * (i + 1))}})
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(1001)), sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(777)))
+
+	listener := &capturingListener{}
+	distrKeeper.AddRewardListener(listener)
+	hooks := distrtestutil.NewMockDistributionHooks(ctrl)
+	distrKeeper.SetHooks(hooks)
+
+	// the dry-run neither writes state, emits events nor notifies the
+	// listeners and the hooks, which expect no call yet
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	allocations, communityPool, err := distrKeeper.ComputeAllocation(ctx, fees, 60, votes)
+	require.NoError(t, err)
+	require.Len(t, allocations, len(pks))
+	require.Empty(t, ctx.EventManager().Events())
+	require.Empty(t, listener.allocations)
+	for _, pk := range pks {
+		has, err := distrKeeper.ValidatorOutstandingRewards.Has(ctx, sdk.ValAddress(pk.Address()))
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, feePool.CommunityPool.IsZero())
+
+	// and matches the actual allocation
+	hooks.EXPECT().AfterValidatorRewardsAllocated(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(len(pks))
+	hooks.EXPECT().AfterCommunityPoolFunded(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 60, votes))
+	require.Len(t, listener.allocations, len(pks))
+
+	for _, pk := range pks {
+		valAddr := sdk.ValAddress(pk.Address())
+		outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, outstanding.Rewards, allocations[valAddr.String()])
+	}
+
+	feePool, err = distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, feePool.CommunityPool, communityPool)
+	require.False(t, communityPool.IsZero())
+}
+
+func TestAllocateTokensCommunityPoolRoutes(t *testing.T) {
+	testCases := []struct {
+		name string
+		// treasuryFirst lists the treasury route before the protocolpool one
+		treasuryFirst    bool
+		treasuryWeight   math.LegacyDec
+		expTreasury      sdk.Coins
+		expCommunityPool sdk.DecCoins
+	}{
+		{
+			// the treasury is sent the whole coins of 40% of 100.5, the
+			// protocolpool route is allocated the rest
+			name:             "protocolpool first",
+			treasuryWeight:   math.LegacyNewDecWithPrec(4, 1),
+			expTreasury:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)),
+			expCommunityPool: sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("60.5"))},
+		},
+		{
+			// the protocolpool route is allocated 40% of 100.5 and the
+			// treasury the rest, whose sub-unit dust is added to the
+			// community pool
+			name:             "treasury first",
+			treasuryFirst:    true,
+			treasuryWeight:   math.LegacyNewDecWithPrec(6, 1),
+			expTreasury:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60)),
+			expCommunityPool: sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("40.5"))},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			routes := []disttypes.CommunityPoolRoute{
+				{Module: pooltypes.ModuleName, Weight: math.LegacyOneDec().Sub(tc.treasuryWeight)},
+				{Module: "treasury", Weight: tc.treasuryWeight},
+			}
+			if tc.treasuryFirst {
+				routes[0], routes[1] = routes[1], routes[0]
+			}
+			params := disttypes.DefaultParams()
+			params.CommunityTax = math.LegacyNewDecWithPrec(1, 1)
+			params.CommunityPoolRoutes = routes
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr0).Return(val, nil).AnyTimes()
+			votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+
+			// 904.5 is allocated to the validator and 100.5 is the community pool cut
+			fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1005))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+			bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, "treasury", tc.expTreasury)
+
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+			require.NoError(t, err)
+			require.Equal(t, []disttypes.RoutedCommunityFunds{{Module: "treasury", Amount: tc.expTreasury}}, result.Routed)
+			require.Equal(t, tc.expCommunityPool, result.ToCommunityPool)
+
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expCommunityPool, feePool.CommunityPool)
+			require.True(t, feePool.DecimalPool.IsZero())
+
+			var routed []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == disttypes.EventTypeRouteCommunityFunds {
+					routed = append(routed, event)
+				}
+			}
+			require.Equal(t, []sdk.Event{sdk.NewEvent(
+				disttypes.EventTypeRouteCommunityFunds,
+				sdk.NewAttribute(sdk.AttributeKeyModule, "treasury"),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, tc.expTreasury.String()),
+			)}, routed)
+
+			// the routed funds are accounted for by the conservation invariant
+			_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+			require.False(t, broken)
+		})
+	}
+}
+
+func TestAllocateTokensCommunityPoolDenomDestinations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.CommunityTax = math.LegacyNewDecWithPrec(1, 1)
+	params.CommunityPoolDenomDestinations = []disttypes.CommunityPoolDenomDestination{
+		{Denom: sdk.DefaultBondDenom, Module: "treasury"},
+		{Denom: "usdc", Module: "insurance"},
+	}
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr0).Return(val, nil).AnyTimes()
+	votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+
+	// the community pool cut is 100.5stake and 201usdc, whose whole coins are
+	// sent to the destination of each denom
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1005), sdk.NewInt64Coin("usdc", 2010))
+	expTreasury := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	expInsurance := sdk.NewCoins(sdk.NewInt64Coin("usdc", 201))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, "treasury", expTreasury)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, "insurance", expInsurance)
+
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+	require.NoError(t, err)
+	require.Equal(t, []disttypes.RoutedCommunityFunds{
+		{Module: "treasury", Amount: expTreasury},
+		{Module: "insurance", Amount: expInsurance},
+	}, result.Routed)
+
+	// the sub-unit dust, which cannot be sent, stays in the community pool
+	expCommunityPool := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("0.5"))}
+	require.Equal(t, expCommunityPool, result.ToCommunityPool)
+
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expCommunityPool, feePool.CommunityPool)
+
+	_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestAllocateTokensKeepCommunityFundsLocal(t *testing.T) {
+	testCases := []struct {
+		name      string
+		keepLocal bool
+		// expProtocolPool is the community pool cut sent to x/protocolpool
+		expProtocolPool  sdk.Coins
+		expCommunityPool sdk.DecCoins
+	}{
+		{
+			// the whole community pool cut of 100.5 is held in the fee pool
+			name:             "kept local",
+			keepLocal:        true,
+			expCommunityPool: sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("100.5"))},
+		},
+		{
+			// the whole coins of the cut are sent to x/protocolpool and the
+			// sub-unit dust, which cannot be sent, stays in the fee pool
+			name:             "sent to protocolpool",
+			expProtocolPool:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			expCommunityPool: sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("0.5"))},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			params := disttypes.DefaultParams()
+			params.CommunityTax = math.LegacyNewDecWithPrec(1, 1)
+			params.KeepCommunityFundsLocal = tc.keepLocal
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr0).Return(val, nil).AnyTimes()
+			votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+
+			// 904.5 is allocated to the validator and 100.5 is the community pool cut
+			fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1005))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+			bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+			var expRouted []disttypes.RoutedCommunityFunds
+			if !tc.expProtocolPool.IsZero() {
+				bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, pooltypes.ModuleName, tc.expProtocolPool)
+				expRouted = []disttypes.RoutedCommunityFunds{{Module: pooltypes.ModuleName, Amount: tc.expProtocolPool}}
+			}
+
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+			require.NoError(t, err)
+			require.Equal(t, expRouted, result.Routed)
+			require.Equal(t, tc.expCommunityPool, result.ToCommunityPool)
+
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expCommunityPool, feePool.CommunityPool)
+
+			// the community pool held in the fee pool is returned by its query
+			querier := keeper.NewQuerier(distrKeeper)
+			res, err := querier.FeePoolCommunityPool(ctx, &disttypes.QueryFeePoolCommunityPoolRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tc.expCommunityPool, res.Pool)
+
+			_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+			require.False(t, broken)
+		})
+	}
+}
+
+func TestAllocateTokensSendDisabledCommunityFunds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+
+	var logs bytes.Buffer
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()}).WithLogger(log.NewLogger(&logs, log.ColorOption(false)))
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.CommunityTax = math.LegacyNewDecWithPrec(1, 1)
+	params.KeepCommunityFundsLocal = false
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr0).Return(val, nil).AnyTimes()
+	votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+
+	// the community pool cut is 100.5stake and 201frozen, whose sends are
+	// disabled: only the stake is sent to x/protocolpool
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1005), sdk.NewInt64Coin("frozen", 2010))
+	expProtocolPool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), sdk.DefaultBondDenom).Return(true).AnyTimes()
+	bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), "frozen").Return(false).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, pooltypes.ModuleName, expProtocolPool)
+
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+	require.NoError(t, err)
+	require.Equal(t, []disttypes.RoutedCommunityFunds{{Module: pooltypes.ModuleName, Amount: expProtocolPool}}, result.Routed)
+
+	// the frozen coins are kept in the decimal pool and the stake dust in the
+	// community pool
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("0.5"))}, feePool.CommunityPool)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("frozen", 201)), feePool.DecimalPool)
+	require.Contains(t, logs.String(), "community pool funds of a send-disabled denom kept in the decimal pool")
+	require.Contains(t, logs.String(), "amount=201frozen")
+
+	record, err := distrKeeper.LastAllocationRecord.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(expProtocolPool...), record.Routed)
+
+	_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestAllocateTokensDecimalPoolSamples(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	distrKeeper.SetDecimalPoolSampleBlocks(3)
+
+	// while the distribution is paused, the fees are added to the decimal pool
+	params := disttypes.DefaultParams()
+	params.DistributionPaused = true
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)))
+	for height := int64(1); height <= 5; height++ {
+		ctx = ctx.WithBlockHeight(height).WithHeaderInfo(header.Info{Height: height})
+		bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+		require.NoError(t, distrKeeper.AllocateTokens(ctx, 0, nil))
+	}
+
+	// only the samples of the last 3 blocks are kept
+	var heights []uint64
+	err := distrKeeper.DecimalPoolSamples.Walk(ctx, nil, func(height uint64, sample disttypes.DecimalPoolSample) (bool, error) {
+		heights = append(heights, height)
+		require.Equal(t, sdk.NewDecCoinsFromCoins(fees...), sample.Remainder)
+		require.True(t, sample.Deficit.IsZero())
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4, 5}, heights)
+}
+
+func TestAllocateTokensToValidatorRewardSamples(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testCtx.Ctx
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	distrKeeper.SetRewardSampleBlocks(3)
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// create validator without commission
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	valAddr, err := valCodec.StringToBytes(val.GetOperator())
+	require.NoError(t, err)
+
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10)}}
+	for height := int64(1); height <= 5; height++ {
+		ctx = ctx.WithBlockHeight(height).WithHeaderInfo(header.Info{Height: height, Time: start.Add(time.Duration(height) * time.Second)})
+		require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+	}
+	// a second allocation in the same block adds to its sample
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	// only the samples of the last 3 blocks are kept
+	var heights []uint64
+	err = distrKeeper.ValidatorRewardSamples.Walk(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, uint64](valAddr), func(key collections.Pair[sdk.ValAddress, uint64], sample disttypes.ValidatorRewardSample) (bool, error) {
+		heights = append(heights, key.K2())
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4, 5}, heights)
+
+	sample, err := distrKeeper.ValidatorRewardSamples.Get(ctx, collections.Join(sdk.ValAddress(valAddr), uint64(5)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(20)}}, sample.Rewards)
+	require.Equal(t, start.Add(5*time.Second), sample.Time)
+
+	// removing the validator removes its samples
+	require.NoError(t, distrKeeper.Hooks().AfterValidatorRemoved(ctx, nil, valAddr))
+	has, err := distrKeeper.ValidatorRewardSamples.Has(ctx, collections.Join(sdk.ValAddress(valAddr), uint64(5)))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+// failingStoreService opens stores failing the writes of keys with the given
+// prefix.
+type failingStoreService struct {
+	corestore.KVStoreService
+	prefix []byte
+}
+
+func (s failingStoreService) OpenKVStore(ctx context.Context) corestore.KVStore {
+	return failingStore{KVStore: s.KVStoreService.OpenKVStore(ctx), prefix: s.prefix}
+}
+
+type failingStore struct {
+	corestore.KVStore
+	prefix []byte
+}
+
+func (s failingStore) Set(key, value []byte) error {
+	if bytes.HasPrefix(key, s.prefix) {
+		return errors.New("write failure")
+	}
+
+	return s.KVStore.Set(key, value)
+}
+
+func TestAllocateTokensPartialValidatorWrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	// the keeper fails to write the outstanding rewards, after the commission
+	// and the current rewards of the validator are written
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		failingStoreService{KVStoreService: storeService, prefix: disttypes.ValidatorOutstandingRewardsPrefix.Bytes()},
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// create validator with 50% commission
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val, nil).AnyTimes()
+	votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	_, err = distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+	require.ErrorContains(t, err, "write failure")
+
+	// the commission and current rewards are rolled back with the allocation
+	valAddr := sdk.ValAddress(valConsAddr0)
+	_, err = distrKeeper.ValidatorsAccumulatedCommission.Get(ctx, valAddr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = distrKeeper.ValidatorCurrentRewards.Get(ctx, valAddr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// and no event is emitted
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestAllocateTokensFeePoolWriteFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress()).Times(2)
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).Times(2)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	newKeeper := func(storeService corestore.KVStoreService) keeper.Keeper {
+		return keeper.NewKeeper(
+			encCfg.Codec,
+			storeService,
+			accountKeeper,
+			bankKeeper,
+			stakingKeeper,
+			poolKeeper,
+			"fee_collector",
+			authtypes.NewModuleAddress("gov").String(),
+		)
+	}
+	distrKeeper := newKeeper(storeService)
+	// the failing keeper shares the store but fails to write the fee pool
+	failingKeeper := newKeeper(failingStoreService{KVStoreService: storeService, prefix: disttypes.FeePoolKey.Bytes()})
+
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val, nil).AnyTimes()
+	votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+
+	// the fees are sent from the fee collector before the fee pool fails to
+	// be written
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(2)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).Times(2)
+
+	_, err = failingKeeper.AllocateTokensWithResult(ctx, 100, votes)
+	require.ErrorContains(t, err, "write failure")
+
+	// nothing is committed: the rewards allocated before the failure are
+	// rolled back with the send, which runs in the same branched context
+	_, err = distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = distrKeeper.LastAllocationRecord.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, feePool.CommunityPool.IsZero())
+	require.True(t, feePool.DecimalPool.IsZero())
+	require.Empty(t, ctx.EventManager().Events())
+
+	// the fees left in the fee collector are allocated by the next allocation
+	result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fees...), result.TotalFees)
+	outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, result.ToValidators, outstanding.Rewards)
+
+	_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestAllocateTokensSanitizesFees(t *testing.T) {
+	testCases := []struct {
+		name    string
+		fees    sdk.Coins
+		expSent sdk.Coins
+		expErr  error
+	}{
+		{
+			name:    "zero amount",
+			fees:    sdk.Coins{sdk.NewInt64Coin("atom", 0), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)},
+			expSent: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		},
+		{
+			name:    "only zero amounts",
+			fees:    sdk.Coins{sdk.NewInt64Coin("atom", 0), sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+			expSent: sdk.Coins{},
+		},
+		{
+			name:   "negative amount",
+			fees:   sdk.Coins{sdk.NewInt64Coin("atom", 10), sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: math.NewInt(-1)}},
+			expErr: disttypes.ErrInvalidFees,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val, nil).AnyTimes()
+			votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+
+			// the fee collector balances are not validated by the mocked bank keeper
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(tc.fees)
+			if tc.expErr != nil {
+				err := distrKeeper.AllocateTokens(ctx, 100, votes)
+				require.ErrorIs(t, err, tc.expErr)
+				require.ErrorContains(t, err, "fee_collector")
+				return
+			}
+
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, tc.expSent)
+			result, err := distrKeeper.AllocateTokensWithResult(ctx, 100, votes)
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDecCoinsFromCoins(tc.expSent...), result.TotalFees)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FundCommunityPool sends amount from the depositor's account to the
+// community pool held by x/protocolpool. Any account can contribute.
+func (k Keeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, depositor sdk.AccAddress) error {
+	if k.poolKeeper == nil {
+		return types.ErrCommunityPoolUnavailable
+	}
+
+	if err := k.poolKeeper.FundCommunityPool(ctx, amount, depositor); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundCommunityPool,
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// ValidateCommunityPoolSpend checks that the community pool can cover a spend
+// of amount, so that a community pool spend proposal can be rejected at
+// submission rather than failing at execution. The balance of the x/protocolpool
+// community pool, if available, is combined with the community and decimal
+// pools of the fee pool, truncated to integer amounts.
+func (k Keeper) ValidateCommunityPoolSpend(ctx context.Context, amount sdk.Coins) error {
+	if err := validateAmount(amount); err != nil {
+		return err
+	}
+
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	available, _ := feePool.CommunityPool.Add(feePool.DecimalPool...).TruncateDecimal()
+	if k.poolKeeper != nil {
+		pool, err := k.poolKeeper.GetCommunityPool(ctx)
+		if err != nil {
+			return err
+		}
+		available = available.Add(pool...)
+	}
+
+	if shortfall := spendShortfall(available, amount); !shortfall.IsZero() {
+		return errorsmod.Wrapf(types.ErrBadDistribution, "cannot spend %s, shortfall: %s", amount, shortfall)
+	}
+
+	return nil
+}
+
+// spendShortfall returns the part of amount the available coins cannot cover.
+func spendShortfall(available, amount sdk.Coins) sdk.Coins {
+	shortfall := sdk.NewCoins()
+	for _, coin := range amount {
+		if diff := coin.Amount.Sub(available.AmountOf(coin.Denom)); diff.IsPositive() {
+			shortfall = shortfall.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+
+	return shortfall
+}
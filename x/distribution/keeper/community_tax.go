@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommunityTaxAt returns the community tax that applied to the fees allocated
+// at a height. The rate in effect when the history started to be recorded is
+// assumed to apply from height zero, and the current rate is returned if no
+// change was recorded. It fails with ErrCommunityTaxNotRecorded for a height
+// older than the changes kept by the CommunityTaxHistoryLength parameter.
+func (k Keeper) CommunityTaxAt(ctx context.Context, height int64) (math.LegacyDec, error) {
+	if height < 0 {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrCommunityTaxNotRecorded, "negative height %d", height)
+	}
+
+	iter, err := k.CommunityTaxHistory.Iterate(ctx, new(collections.Range[uint64]).EndInclusive(uint64(height)).Descending())
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	defer iter.Close()
+
+	if iter.Valid() {
+		record, err := iter.Value()
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		return record.CommunityTax, nil
+	}
+
+	empty, err := k.communityTaxHistoryEmpty(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !empty {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrCommunityTaxNotRecorded, "height %d", height)
+	}
+
+	return k.GetCommunityTax(ctx)
+}
+
+// recordCommunityTaxChange records in the community tax history the community
+// tax of params if it differs from the current one, the new rate applying to
+// the fees allocated from the next block. The first change recorded also
+// records the current rate from height zero. The oldest changes beyond the
+// CommunityTaxHistoryLength parameter of params are pruned, and nothing is
+// recorded if it is zero.
+func (k Keeper) recordCommunityTaxChange(ctx context.Context, current, params types.Params) error {
+	length := params.CommunityTaxHistoryLength
+	if length == 0 {
+		return nil
+	}
+
+	if current.CommunityTax.IsNil() || !current.CommunityTax.Equal(params.CommunityTax) {
+		empty, err := k.communityTaxHistoryEmpty(ctx)
+		if err != nil {
+			return err
+		}
+		if empty && !current.CommunityTax.IsNil() {
+			if err := k.CommunityTaxHistory.Set(ctx, 0, types.CommunityTaxRecord{Height: 0, CommunityTax: current.CommunityTax}); err != nil {
+				return err
+			}
+		}
+
+		height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) + 1
+		if err := k.CommunityTaxHistory.Set(ctx, height, types.CommunityTaxRecord{Height: height, CommunityTax: params.CommunityTax}); err != nil {
+			return err
+		}
+	}
+
+	return k.pruneCommunityTaxHistory(ctx, length)
+}
+
+// communityTaxHistoryEmpty returns whether no community tax change is
+// recorded.
+func (k Keeper) communityTaxHistoryEmpty(ctx context.Context) (bool, error) {
+	iter, err := k.CommunityTaxHistory.Iterate(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return !iter.Valid(), nil
+}
+
+// pruneCommunityTaxHistory removes the oldest records of the community tax
+// history until at most length records are left.
+func (k Keeper) pruneCommunityTaxHistory(ctx context.Context, length uint64) error {
+	var heights []uint64
+	err := k.CommunityTaxHistory.Walk(ctx, nil, func(height uint64, _ types.CommunityTaxRecord) (stop bool, err error) {
+		heights = append(heights, height)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for uint64(len(heights)) > length {
+		if err := k.CommunityTaxHistory.Remove(ctx, heights[0]); err != nil {
+			return err
+		}
+		heights = heights[1:]
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithdrawAndCompound withdraws the rewards of a delegation and delegates the
+// bond denom part of them to the same validator. The rewards in other denoms
+// are sent to the delegator's withdraw address. It returns the compounded
+// rewards, the delegation shares issued for them and the rewards withdrawn.
+// It fails if the delegator already withdrew the maximum amount of the block.
+func (k Keeper) WithdrawAndCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coin, math.LegacyDec, sdk.Coins, error) {
+	if err := k.checkWithdrawCap(ctx, delAddr); err != nil {
+		return sdk.Coin{}, math.LegacyDec{}, nil, err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, math.LegacyDec{}, nil, err
+	}
+
+	// the bond denom rewards are paid to the delegator, to be delegated from
+	// its account
+	send := func(ctx context.Context, delAddr sdk.AccAddress, coins sdk.Coins) error {
+		compounded := sdk.NewCoins(sdk.NewCoin(bondDenom, coins.AmountOf(bondDenom)))
+		if !compounded.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, compounded); err != nil {
+				return err
+			}
+		}
+
+		withdrawn := coins.Sub(compounded...)
+		if withdrawn.IsZero() {
+			return nil
+		}
+		return k.sendRewards(ctx, delAddr, withdrawn)
+	}
+
+	rewards, _, err := k.withdrawAndReinitializeDelegation(ctx, delAddr, valAddr, send)
+	if err != nil {
+		return sdk.Coin{}, math.LegacyDec{}, nil, err
+	}
+
+	compounded := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	withdrawn := sdk.NewCoins(rewards...).Sub(compounded)
+	if compounded.IsZero() {
+		return compounded, math.LegacyZeroDec(), withdrawn, nil
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, math.LegacyDec{}, nil, err
+	}
+
+	shares, err := k.stakingKeeper.Delegate(ctx, delAddr, compounded.Amount, stakingtypes.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Coin{}, math.LegacyDec{}, nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompoundRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, compounded.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+
+	return compounded, shares, withdrawn, nil
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"context"
+	stdmath "math"
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendDecimalPoolToCommunityPool moves the integer part of every send-enabled
+// denom held in the decimal pool to the community pool, leaving only sub-unit
+// amounts and the send-disabled denoms in the decimal pool. The integer part of
+// the denoms with a community pool destination is sent to the destination
+// modules instead.
+func (k Keeper) SendDecimalPoolToCommunityPool(ctx context.Context) error {
+	_, _, err := k.SendDecimalPoolToCommunityPoolWithResult(ctx)
+	return err
+}
+
+// SendDecimalPoolToCommunityPoolWithResult performs the same move as
+// SendDecimalPoolToCommunityPool and returns the integer amounts moved to the
+// community pool and the sub-unit amounts remaining in the decimal pool.
+func (k Keeper) SendDecimalPoolToCommunityPoolWithResult(ctx context.Context) (sdk.Coins, sdk.DecCoins, error) {
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	feePool, moved, routed, err := k.moveDecimalPoolToCommunityPool(ctx, feePool, math.ZeroInt())
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := k.FeePool.Set(ctx, feePool); err != nil {
+		return nil, nil, err
+	}
+
+	for _, r := range routed {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, r.Module, r.Amount); err != nil {
+			return nil, nil, err
+		}
+		k.emitRouteCommunityFundsEvent(ctx, r)
+	}
+
+	if err := k.afterCommunityPoolFunded(ctx, sdk.NewDecCoinsFromCoins(moved...), types.CommunityPoolSourceDecimalPool); err != nil {
+		return nil, nil, err
+	}
+
+	k.emitDecimalPoolFlushEvent(ctx, moved, routed)
+	return moved, feePool.DecimalPool, nil
+}
+
+// moveDecimalPoolToCommunityPool moves the integer part of the decimal pool to
+// the community pool for every denom whose integer amount is greater than
+// threshold, and returns the amounts moved. The integer part of the denoms
+// with a community pool destination is taken out of the decimal pool too, and
+// returned as the funds to send to the destination modules. The send-disabled
+// denoms, which the community pool could not spend, are left in the decimal
+// pool. The denoms are visited, and the remaining decimal pool is stored, in
+// sorted order whatever the order the decimal pool was stored in.
+func (k Keeper) moveDecimalPoolToCommunityPool(ctx context.Context, feePool types.FeePool, threshold math.Int) (types.FeePool, sdk.Coins, []types.RoutedCommunityFunds, error) {
+	destinations, err := k.GetCommunityPoolDenomDestinations(ctx)
+	if err != nil {
+		return types.FeePool{}, nil, nil, err
+	}
+
+	decimalPool := make(sdk.DecCoins, len(feePool.DecimalPool))
+	copy(decimalPool, feePool.DecimalPool)
+	sort.SliceStable(decimalPool, func(i, j int) bool { return decimalPool[i].Denom < decimalPool[j].Denom })
+
+	moved := sdk.NewCoins()
+	flushed := sdk.NewCoins()
+	var routed []types.RoutedCommunityFunds
+	for _, coin := range decimalPool {
+		amount := coin.Amount.TruncateInt()
+		if !amount.GT(threshold) || !k.bankKeeper.IsSendEnabledDenom(ctx, coin.Denom) {
+			continue
+		}
+
+		flushed = flushed.Add(sdk.NewCoin(coin.Denom, amount))
+		if module, ok := destinations[coin.Denom]; ok && module != pooltypes.ModuleName {
+			routed = addRoutedCommunityFunds(routed, module, sdk.NewCoin(coin.Denom, amount))
+			continue
+		}
+		moved = moved.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	if flushed.IsZero() {
+		return feePool, moved, routed, nil
+	}
+
+	feePool.DecimalPool = decimalPool.Sub(sdk.NewDecCoinsFromCoins(flushed...))
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(moved...)...)
+	return feePool, moved, routed, nil
+}
+
+// emitDecimalPoolFlushEvent records the amounts a flush moved from the decimal
+// pool to the community pool and to the community pool destinations, with one
+// amount attribute per denom in sorted denom order. Nothing is emitted if
+// nothing was moved.
+func (k Keeper) emitDecimalPoolFlushEvent(ctx context.Context, moved sdk.Coins, routed []types.RoutedCommunityFunds) {
+	for _, r := range routed {
+		moved = moved.Add(r.Amount...)
+	}
+	if moved.IsZero() {
+		return
+	}
+
+	attrs := make([]sdk.Attribute, 0, len(moved))
+	for _, coin := range moved.Sort() {
+		attrs = append(attrs, sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFlushDecimalPool, attrs...))
+}
+
+// recordDecimalPoolSample records the remainder the allocation added to the
+// decimal pool and the rounding deficit it drew from it at the current block,
+// and prunes the samples older than the sampled blocks. Nothing is recorded
+// if the decimal pool sampling is disabled.
+func (k Keeper) recordDecimalPoolSample(ctx context.Context, result types.AllocationResult) error {
+	if k.decimalPoolSampleBlocks == 0 {
+		return nil
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	sample := types.DecimalPoolSample{
+		Remainder: result.Remainder,
+		Deficit:   result.RoundingDeficit,
+	}
+	if err := k.DecimalPoolSamples.Set(ctx, height, sample); err != nil {
+		return err
+	}
+
+	if height < k.decimalPoolSampleBlocks {
+		return nil
+	}
+
+	return k.DecimalPoolSamples.Clear(ctx, new(collections.Range[uint64]).EndInclusive(height-k.decimalPoolSampleBlocks))
+}
+
+// EstimateBlocksToDecimalFlush estimates the number of blocks until the
+// integer part of the decimal pool amount of the denom increments by one, from
+// the average net remainder added to the decimal pool by the allocations of
+// the sampled blocks. The estimate is a heuristic which assumes that the
+// remainders of the coming blocks match the recent ones; it ignores the
+// flushes of the decimal pool and is not authoritative. An error is returned
+// if the decimal pool sampling is disabled or if the sampled blocks did not
+// grow the decimal pool amount of the denom.
+func (k Keeper) EstimateBlocksToDecimalFlush(ctx context.Context, denom string) (uint64, error) {
+	if k.decimalPoolSampleBlocks == 0 {
+		return 0, errorsmod.Wrap(types.ErrNoDecimalPoolEstimate, "decimal pool sampling is disabled")
+	}
+
+	net := math.LegacyZeroDec()
+	samples := int64(0)
+	err := k.DecimalPoolSamples.Walk(ctx, nil, func(_ uint64, sample types.DecimalPoolSample) (stop bool, err error) {
+		net = net.Add(sample.Remainder.AmountOf(denom)).Sub(sample.Deficit.AmountOf(denom))
+		samples++
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if samples == 0 || !net.IsPositive() {
+		return 0, errorsmod.Wrapf(types.ErrNoDecimalPoolEstimate, "the decimal pool amount of %s is not growing", denom)
+	}
+
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// the amount missing to reach the next integer, a whole unit if the
+	// amount is an integer
+	amount := feePool.DecimalPool.AmountOf(denom)
+	missing := math.LegacyOneDec().Sub(amount.Sub(amount.TruncateDec()))
+
+	// blocks = ceil(missing / (net / samples)), at least one block
+	blocks := missing.MulInt64(samples).Quo(net).Ceil().TruncateInt()
+	switch {
+	case !blocks.IsPositive():
+		return 1, nil
+	case !blocks.IsUint64():
+		return stdmath.MaxUint64, nil
+	default:
+		return blocks.Uint64(), nil
+	}
+}
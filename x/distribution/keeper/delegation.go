@@ -87,27 +87,42 @@ func (k Keeper) calculateDelegationRewardsBetween(ctx context.Context, val staki
 
 // calculate the total rewards accrued by a delegation
 func (k Keeper) CalculateDelegationRewards(ctx context.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, endingPeriod uint64) (rewards sdk.DecCoins, err error) {
+	periods, err := k.calculateDelegationRewardsPeriods(ctx, val, del, endingPeriod)
+	if err != nil {
+		return sdk.DecCoins{}, err
+	}
+
+	for _, period := range periods {
+		rewards = rewards.Add(period.Rewards...)
+	}
+	return rewards, nil
+}
+
+// calculateDelegationRewardsPeriods calculates the rewards accrued by a
+// delegation between each of the periods of its validator split by a slash,
+// in period order.
+func (k Keeper) calculateDelegationRewardsPeriods(ctx context.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, endingPeriod uint64) (periods []types.DelegationRewardsPeriod, err error) {
 	addrCodec := k.authKeeper.AddressCodec()
 	delAddr, err := addrCodec.StringToBytes(del.GetDelegatorAddr())
 	if err != nil {
-		return sdk.DecCoins{}, err
+		return nil, err
 	}
 
 	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
 	if err != nil {
-		return sdk.DecCoins{}, err
+		return nil, err
 	}
 
 	// fetch starting info for delegation
 	startingInfo, err := k.DelegatorStartingInfo.Get(ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delAddr)))
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
-		return sdk.DecCoins{}, err
+		return nil, err
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if startingInfo.Height == uint64(sdkCtx.BlockHeight()) {
 		// started this height, no rewards yet
-		return sdk.DecCoins{}, nil
+		return nil, nil
 	}
 
 	startingPeriod := startingInfo.PreviousPeriod
@@ -133,7 +148,11 @@ func (k Keeper) CalculateDelegationRewards(ctx context.Context, val stakingtypes
 					if err != nil {
 						panic(err)
 					}
-					rewards = rewards.Add(delRewards...)
+					periods = append(periods, types.DelegationRewardsPeriod{
+						StartingPeriod: startingPeriod,
+						EndingPeriod:   endingPeriod,
+						Rewards:        delRewards,
+					})
 
 					// Note: It is necessary to truncate so we don't allow withdrawing
 					// more rewards than owed.
@@ -144,7 +163,7 @@ func (k Keeper) CalculateDelegationRewards(ctx context.Context, val stakingtypes
 			},
 		)
 		if err != nil {
-			return sdk.DecCoins{}, err
+			return nil, err
 		}
 	}
 
@@ -189,49 +208,64 @@ func (k Keeper) CalculateDelegationRewards(ctx context.Context, val stakingtypes
 	// calculate rewards for final period
 	delRewards, err := k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake)
 	if err != nil {
-		return sdk.DecCoins{}, err
+		return nil, err
 	}
 
-	rewards = rewards.Add(delRewards...)
-	return rewards, nil
+	periods = append(periods, types.DelegationRewardsPeriod{
+		StartingPeriod: startingPeriod,
+		EndingPeriod:   endingPeriod,
+		Rewards:        delRewards,
+	})
+	return periods, nil
 }
 
-func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI) (sdk.Coins, error) {
+// rewardsSender pays the withdrawn rewards of a delegator.
+type rewardsSender func(ctx context.Context, delAddr sdk.AccAddress, coins sdk.Coins) error
+
+// withdrawDelegationRewards withdraws the rewards of a delegation, paying them
+// with send. It returns the rewards withdrawn and the breakdown of the
+// withdrawal.
+func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, send rewardsSender) (sdk.Coins, types.DelegationWithdrawalBreakdown, error) {
 	addrCodec := k.authKeeper.AddressCodec()
 	delAddr, err := addrCodec.StringToBytes(del.GetDelegatorAddr())
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
 	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
 	// check existence of delegator starting info
 	hasInfo, err := k.DelegatorStartingInfo.Has(ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delAddr)))
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
 	if !hasInfo {
-		return nil, types.ErrEmptyDelegationDistInfo
+		return nil, types.DelegationWithdrawalBreakdown{}, types.ErrEmptyDelegationDistInfo
 	}
 
 	// end current period and calculate rewards
 	endingPeriod, err := k.IncrementValidatorPeriod(ctx, val)
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
-	rewardsRaw, err := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	periods, err := k.calculateDelegationRewardsPeriods(ctx, val, del, endingPeriod)
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
+	}
+
+	var rewardsRaw sdk.DecCoins
+	for _, period := range periods {
+		rewardsRaw = rewardsRaw.Add(period.Rewards...)
 	}
 
 	outstanding, err := k.GetValidatorOutstandingRewardsCoins(ctx, sdk.ValAddress(valAddr))
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
 	// defensive edge case may happen on the very final digits
@@ -249,18 +283,35 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.
 	}
 
 	// truncate reward dec coins, return remainder to community pool
-	finalRewards, remainder := rewards.TruncateDecimal()
+	truncated, remainder := rewards.TruncateDecimal()
+
+	previouslyCarried, err := k.getDelegationCarriedRewards(ctx, delAddr, sdk.ValAddress(valAddr))
+	if err != nil {
+		return nil, types.DelegationWithdrawalBreakdown{}, err
+	}
+
+	// the rewards above the maximum withdrawal of the block are carried over
+	finalRewards, err := k.capWithdrawal(ctx, delAddr, sdk.ValAddress(valAddr), truncated)
+	if err != nil {
+		return nil, types.DelegationWithdrawalBreakdown{}, err
+	}
+
+	carried, err := k.getDelegationCarriedRewards(ctx, delAddr, sdk.ValAddress(valAddr))
+	if err != nil {
+		return nil, types.DelegationWithdrawalBreakdown{}, err
+	}
+
+	breakdown := types.DelegationWithdrawalBreakdown{
+		Periods:           periods,
+		Carried:           carried,
+		Dust:              rewardsRaw.Sub(sdk.NewDecCoinsFromCoins(truncated...)),
+		PreviouslyCarried: previouslyCarried,
+	}
 
 	// add coins to user account
 	if !finalRewards.IsZero() {
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
-		if err != nil {
-			return nil, err
-		}
-
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, finalRewards)
-		if err != nil {
-			return nil, err
+		if err := send(ctx, delAddr, finalRewards); err != nil {
+			return nil, types.DelegationWithdrawalBreakdown{}, err
 		}
 	}
 
@@ -268,42 +319,42 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.
 	// transaction was successful
 	err = k.ValidatorOutstandingRewards.Set(ctx, sdk.ValAddress(valAddr), types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(rewards)})
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
 	feePool, err := k.FeePool.Get(ctx)
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
 	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
 	err = k.FeePool.Set(ctx, feePool)
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
 	// decrement reference count of starting period
 	startingInfo, err := k.DelegatorStartingInfo.Get(ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delAddr)))
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
 	startingPeriod := startingInfo.PreviousPeriod
 	err = k.decrementReferenceCount(ctx, sdk.ValAddress(valAddr), startingPeriod)
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
 	// remove delegator starting info
 	err = k.DelegatorStartingInfo.Remove(ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delAddr)))
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
 	if finalRewards.IsZero() {
 		baseDenom, err := k.stakingKeeper.BondDenom(ctx)
 		if err != nil {
-			return nil, err
+			return nil, types.DelegationWithdrawalBreakdown{}, err
 		}
 
 		// Note, we do not call the NewCoins constructor as we do not want the zero
@@ -321,5 +372,5 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.
 		),
 	)
 
-	return finalRewards, nil
+	return finalRewards, breakdown, nil
 }
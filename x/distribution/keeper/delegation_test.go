@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -21,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -111,6 +114,81 @@ func TestCalculateRewardsBasic(t *testing.T) {
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(initial / 2)}}, valCommission.Commission)
 }
 
+func TestGetValidatorCurrentPeriodRewardRatio(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(1000))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil)
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+
+	// no rewards have been allocated yet
+	ratio, err := distrKeeper.GetValidatorCurrentPeriodRewardRatio(ctx, valAddr)
+	require.NoError(t, err)
+	require.True(t, ratio.IsZero())
+
+	for _, amount := range []int64{10, 30} {
+		tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(amount)}}
+		require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+		currentBefore, err := distrKeeper.ValidatorCurrentRewards.Get(ctx, valAddr)
+		require.NoError(t, err)
+
+		ratio, err = distrKeeper.GetValidatorCurrentPeriodRewardRatio(ctx, valAddr)
+		require.NoError(t, err)
+
+		// the current period is left untouched
+		currentAfter, err := distrKeeper.ValidatorCurrentRewards.Get(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, currentBefore, currentAfter)
+
+		// the ratio is the one recorded by a subsequent increment
+		period, err := distrKeeper.IncrementValidatorPeriod(ctx, val)
+		require.NoError(t, err)
+		historical, err := distrKeeper.ValidatorHistoricalRewards.Get(ctx, collections.Join(valAddr, period))
+		require.NoError(t, err)
+		require.Equal(t, historical.CumulativeRewardRatio, ratio)
+	}
+
+	// half of the 40stake allocated is shared among 1000 tokens
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(2, 2)}}, ratio)
+}
+
 func getValHistoricalReferenceCount(k keeper.Keeper, ctx sdk.Context) int {
 	count := 0
 	err := k.ValidatorHistoricalRewards.Walk(
@@ -533,6 +611,682 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// the rewards must be sent to the withdraw address of the delegator
+	delAddr := sdk.AccAddress("delegator___________")
+	withdrawAddr := sdk.AccAddress("withdraw____________")
+	require.NoError(t, distrKeeper.DelegatorsWithdrawAddress.Set(ctx, delAddr, withdrawAddr))
+
+	// delegate to five validators without commission
+	delegations := make([]stakingtypes.Delegation, 0, len(PKS))
+	validators := make([]stakingtypes.Validator, 0, len(PKS))
+	for _, pk := range PKS {
+		valAddr := sdk.ValAddress(pk.Address())
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+
+		del := stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), val.DelegatorShares)
+		stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, valAddr).Return(del, nil).AnyTimes()
+
+		// run the necessary hooks manually (given that we are not running an actual staking module)
+		require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, delAddr, valAddr))
+
+		delegations = append(delegations, del)
+		validators = append(validators, val)
+	}
+	stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), delAddr).Return(delegations, nil).Times(2)
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate different rewards to every validator
+	expTotal := sdk.NewCoins()
+	for i, val := range validators {
+		initial := sdk.TokensFromConsensusPower(int64(i+1), sdk.DefaultPowerReduction)
+		tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+		require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+		expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial)}
+		bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, withdrawAddr, expRewards)
+		expTotal = expTotal.Add(expRewards...)
+	}
+
+	total, err := distrKeeper.WithdrawAllDelegationRewards(ctx, delAddr)
+	require.NoError(t, err)
+	require.Equal(t, expTotal, total)
+
+	// every delegation has been reinitialized, nothing is left to withdraw
+	total, err = distrKeeper.WithdrawAllDelegationRewards(ctx, delAddr)
+	require.NoError(t, err)
+	require.True(t, total.IsZero())
+}
+
+func TestWithdrawDelegationRewardsOperatorRewardsAddr(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// create a validator without commission, with a self-delegation and an
+	// external delegation of half of its shares each
+	valAddr := sdk.ValAddress(valConsAddr0)
+	operatorAddr := sdk.AccAddress(valAddr)
+	delAddr := sdk.AccAddress(valConsAddr1)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+
+	require.NoError(t, distrKeeper.Hooks().AfterValidatorCreated(ctx, valAddr))
+	for _, addr := range []sdk.AccAddress{operatorAddr, delAddr} {
+		del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares.QuoInt64(2))
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+		require.NoError(t, distrKeeper.Hooks().BeforeDelegationCreated(ctx, addr, valAddr))
+		require.NoError(t, distrKeeper.Hooks().AfterDelegationModified(ctx, addr, valAddr))
+	}
+
+	// the operator rewards address takes precedence over the operator's
+	// withdraw address
+	withdrawAddr := sdk.AccAddress("withdraw____________")
+	rewardsAddr := sdk.AccAddress("operations__________")
+	blockedAddr := sdk.AccAddress("blocked_____________")
+	require.NoError(t, distrKeeper.DelegatorsWithdrawAddress.Set(ctx, operatorAddr, withdrawAddr))
+	bankKeeper.EXPECT().BlockedAddr(rewardsAddr).Return(false)
+	bankKeeper.EXPECT().BlockedAddr(blockedAddr).Return(true)
+	require.ErrorIs(t, distrKeeper.SetValidatorOperatorRewardsAddr(ctx, valAddr, blockedAddr), sdkerrors.ErrUnauthorized)
+	require.NoError(t, distrKeeper.SetValidatorOperatorRewardsAddr(ctx, valAddr, rewardsAddr))
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
+
+	// the external delegation is paid to the delegator
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, delAddr, expRewards)
+	rewards, err := distrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, expRewards, rewards)
+
+	// the self-delegation is paid to the operator rewards address
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, rewardsAddr, expRewards)
+	rewards, err = distrKeeper.WithdrawDelegationRewards(ctx, operatorAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, expRewards, rewards)
+
+	// once the routing is removed, the self-delegation is paid to the
+	// operator's withdraw address again
+	require.NoError(t, distrKeeper.SetValidatorOperatorRewardsAddr(ctx, valAddr, nil))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, withdrawAddr, expRewards)
+	rewards, err = distrKeeper.WithdrawDelegationRewards(ctx, operatorAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, expRewards, rewards)
+}
+
+func TestWithdrawDelegationRewardsMaxWithdrawPerBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// a delegator can withdraw 60stake per block
+	params := disttypes.DefaultParams()
+	params.MaxWithdrawPerBlock = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// create validator without commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+
+	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+
+	stakeCoins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(100))}
+
+	// the withdrawal hits the cap, the rest is carried over
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, stakeCoins(60))
+	rewards, err := distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(60), rewards)
+
+	carried, err := distrKeeper.DelegatorCarriedRewards.Get(ctx, collections.Join(addr, valAddr))
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(40), carried.Rewards)
+	require.Equal(t, stakeCoins(40), distrKeeper.GetTotalCarriedRewards(ctx))
+
+	// further withdrawals of the block are refused
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.ErrorIs(t, err, disttypes.ErrWithdrawCapReached)
+	_, err = distrKeeper.WithdrawAllDelegationRewards(ctx, addr)
+	require.ErrorIs(t, err, disttypes.ErrWithdrawCapReached)
+
+	// the next block pays out the carried rewards within its cap
+	ctx = ctx.WithBlockHeight(3)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, stakeCoins(40))
+	require.NoError(t, distrKeeper.ResetBlockWithdrawals(ctx))
+	has, err := distrKeeper.DelegatorCarriedRewards.Has(ctx, collections.Join(addr, valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the rest of the cap of the block is left for the new rewards
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, stakeCoins(20))
+	rewards, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(20), rewards)
+
+	carried, err = distrKeeper.DelegatorCarriedRewards.Get(ctx, collections.Join(addr, valAddr))
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(80), carried.Rewards)
+
+	// once the cap is removed, the carried rewards are paid with the next
+	// withdrawal
+	ctx = ctx.WithBlockHeight(4)
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, stakeCoins(80))
+	require.NoError(t, distrKeeper.ResetBlockWithdrawals(ctx))
+	require.True(t, distrKeeper.GetTotalCarriedRewards(ctx).IsZero())
+}
+
+func TestWithdrawDelegationRewardsCarriedPerDelegation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// a delegator can withdraw 50stake per block, 5stake at least
+	params := disttypes.DefaultParams()
+	params.MaxWithdrawPerBlock = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	params.MinWithdrawAmount = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// the operator of a validator without commission sends the rewards of its
+	// self-delegation to a dedicated address and delegates to a second
+	// validator without commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	rewardsAddr := sdk.AccAddress("operations__________")
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+
+	otherValAddr := sdk.ValAddress(valConsAddr1)
+	otherVal, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+	require.NoError(t, err)
+	otherVal.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+
+	selfDel := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
+	externalDel := stakingtypes.NewDelegation(addr.String(), otherValAddr.String(), otherVal.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), otherValAddr).Return(otherVal, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(selfDel, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, otherValAddr).Return(externalDel, nil).AnyTimes()
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, otherValAddr))
+	require.NoError(t, distrKeeper.ValidatorsOperatorRewardsAddress.Set(ctx, valAddr, rewardsAddr))
+
+	stakeCoins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	stakeTokens := func(amount int64) sdk.DecCoins {
+		return sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(amount))}
+	}
+	carriedOf := func(valAddr sdk.ValAddress) sdk.Coins {
+		carried, err := distrKeeper.DelegatorCarriedRewards.Get(ctx, collections.Join(addr, valAddr))
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.NewCoins()
+		}
+		require.NoError(t, err)
+		return carried.Rewards
+	}
+
+	// the self-delegation uses the cap of the block, the rewards of the
+	// external delegation above it are carried over
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, stakeTokens(40)))
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, otherVal, stakeTokens(50)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, rewardsAddr, stakeCoins(40))
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.NoError(t, err)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, stakeCoins(10))
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, otherValAddr)
+	require.NoError(t, err)
+	require.True(t, carriedOf(valAddr).IsZero())
+	require.Equal(t, stakeCoins(40), carriedOf(otherValAddr))
+
+	// the carried rewards of the external delegation are paid out to the
+	// delegator, not to the rewards address of the operator
+	ctx = ctx.WithBlockHeight(3)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, stakeCoins(40))
+	require.NoError(t, distrKeeper.ResetBlockWithdrawals(ctx))
+	require.True(t, distrKeeper.GetTotalCarriedRewards(ctx).IsZero())
+
+	// the rest of the cap of the block is shared by the self-delegation, the
+	// rewards above it being carried over on their own
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, stakeTokens(30)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, rewardsAddr, stakeCoins(10))
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(20), carriedOf(valAddr))
+
+	// the carried rewards of the self-delegation follow its rewards
+	ctx = ctx.WithBlockHeight(4)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, rewardsAddr, stakeCoins(20))
+	require.NoError(t, distrKeeper.ResetBlockWithdrawals(ctx))
+	require.True(t, carriedOf(valAddr).IsZero())
+
+	// the rewards of the external delegation below the minimum are left
+	// unclaimed in its own bucket
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, otherVal, stakeTokens(33)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, stakeCoins(30))
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, otherValAddr)
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(3), carriedOf(otherValAddr))
+
+	// they are neither paid out at the beginning of the next block nor added
+	// to a withdrawal from the self-delegation
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, distrKeeper.ResetBlockWithdrawals(ctx))
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, stakeTokens(10)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, rewardsAddr, stakeCoins(10))
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.NoError(t, err)
+
+	unclaimed, err := distrKeeper.GetUnclaimedRewards(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(3), unclaimed)
+	require.Equal(t, stakeCoins(3), carriedOf(otherValAddr))
+}
+
+func TestWithdrawDelegationRewardsWithBreakdown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// a delegator can withdraw 60stake per block
+	params := disttypes.DefaultParams()
+	params.MaxWithdrawPerBlock = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// create validator without commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	valPower := int64(100)
+	val, err := distrtestutil.CreateValidator(valConsPk0, sdk.TokensFromConsensusPower(valPower, sdk.DefaultPowerReduction))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+
+	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).DoAndReturn(
+		func(context.Context, sdk.ValAddress) (stakingtypes.ValidatorI, error) { return val, nil },
+	).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+
+	// allocate rewards before and after a slash of 50%, splitting them in
+	// two periods
+	ctx = ctx.WithBlockHeight(2)
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(1005, 1)}}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	ctx = ctx.WithBlockHeight(3)
+	distrtestutil.SlashValidator(ctx, valConsAddr0, ctx.BlockHeight(), valPower, math.LegacyNewDecWithPrec(5, 1), &val, &distrKeeper, stakingKeeper)
+
+	ctx = ctx.WithBlockHeight(4)
+	tokens = sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(1025, 2)}}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	// the withdrawal hits the cap, the rest is carried over and the sub-unit
+	// part is left unwithdrawn
+	stakeCoins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, stakeCoins(60))
+	rewards, breakdown, err := distrKeeper.WithdrawDelegationRewardsWithBreakdown(ctx, addr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(60), rewards)
+	require.Len(t, breakdown.Periods, 2)
+	require.Equal(t, breakdown.Periods[0].EndingPeriod, breakdown.Periods[1].StartingPeriod)
+	require.Equal(t, stakeCoins(50), breakdown.Carried)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(75, 2)}}, breakdown.Dust)
+	require.True(t, breakdown.PreviouslyCarried.IsZero())
+
+	// the rewards of the periods add up to the amount withdrawn, the carried
+	// coins and the dust
+	var total sdk.DecCoins
+	for _, period := range breakdown.Periods {
+		total = total.Add(period.Rewards...)
+	}
+	require.Equal(t, total, sdk.NewDecCoinsFromCoins(rewards.Add(breakdown.Carried...)...).Add(breakdown.Dust...))
+
+	// once the cap is removed, the next withdrawal pays out the carried
+	// rewards
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, stakeCoins(50))
+	rewards, breakdown, err = distrKeeper.WithdrawDelegationRewardsWithBreakdown(ctx, addr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(50), rewards)
+	require.Equal(t, stakeCoins(50), breakdown.PreviouslyCarried)
+	require.True(t, breakdown.Carried.IsZero())
+}
+
+func TestWithdrawDelegationRewardsMaxWithdrawPerBlockOperatorRewardsAddr(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// a delegator can withdraw 60stake per block
+	params := disttypes.DefaultParams()
+	params.MaxWithdrawPerBlock = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// create validator without commission, self-delegated
+	valAddr := sdk.ValAddress(valConsAddr0)
+	operatorAddr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+
+	del := stakingtypes.NewDelegation(operatorAddr.String(), valAddr.String(), val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), operatorAddr, valAddr).Return(del, nil).AnyTimes()
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, operatorAddr, valAddr))
+
+	// the self-delegation rewards are routed to the operator rewards address
+	rewardsAddr := sdk.AccAddress("operations__________")
+	bankKeeper.EXPECT().BlockedAddr(rewardsAddr).Return(false)
+	require.NoError(t, distrKeeper.SetValidatorOperatorRewardsAddr(ctx, valAddr, rewardsAddr))
+
+	stakeCoins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	// the withdrawal hits the cap, the rest is carried over
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(100))}))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, rewardsAddr, stakeCoins(60))
+	rewards, err := distrKeeper.WithdrawDelegationRewards(ctx, operatorAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(60), rewards)
+
+	// the carried rewards are paid to the operator rewards address too
+	ctx = ctx.WithBlockHeight(3)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, rewardsAddr, stakeCoins(40))
+	require.NoError(t, distrKeeper.ResetBlockWithdrawals(ctx))
+	require.True(t, distrKeeper.GetTotalCarriedRewards(ctx).IsZero())
+}
+
+func TestWithdrawDelegationRewardsMinWithdrawAmount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	// a withdrawal pays at least 50stake
+	params := disttypes.DefaultParams()
+	params.MinWithdrawAmount = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// create validator without commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+
+	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+
+	stakeCoins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	stakeTokens := func(amount int64) sdk.DecCoins {
+		return sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(amount))}
+	}
+	withdraw := func() *disttypes.MsgWithdrawDelegatorRewardResponse {
+		resp, err := msgServer.WithdrawDelegatorReward(ctx, disttypes.NewMsgWithdrawDelegatorReward(addr.String(), valAddr.String()))
+		require.NoError(t, err)
+		return resp
+	}
+
+	// the rewards below the minimum are left unclaimed
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, stakeTokens(30)))
+	resp := withdraw()
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, math.ZeroInt())}, resp.Amount)
+	require.Equal(t, stakeCoins(30), resp.Unclaimed)
+	require.Equal(t, stakeCoins(30), distrKeeper.GetTotalCarriedRewards(ctx))
+
+	// the unclaimed rewards are not paid out at the beginning of the next block
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(t, distrKeeper.ResetBlockWithdrawals(ctx))
+	require.Equal(t, stakeCoins(30), distrKeeper.GetTotalCarriedRewards(ctx))
+
+	// they accrue until a withdrawal reaches the minimum
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, stakeTokens(19)))
+	resp = withdraw()
+	require.Equal(t, stakeCoins(49), resp.Unclaimed)
+
+	ctx = ctx.WithBlockHeight(4)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, stakeTokens(1)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, stakeCoins(50))
+	resp = withdraw()
+	require.Equal(t, stakeCoins(50), resp.Amount)
+	require.True(t, resp.Unclaimed.IsZero())
+	has, err := distrKeeper.DelegatorCarriedRewards.Has(ctx, collections.Join(addr, valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// rewards at the minimum are paid right away
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, stakeTokens(50)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, stakeCoins(50))
+	resp = withdraw()
+	require.Equal(t, stakeCoins(50), resp.Amount)
+	require.True(t, resp.Unclaimed.IsZero())
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
@@ -651,6 +1405,82 @@ func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial}}, valCommission.Commission)
 }
 
+func TestCalculateRewardsSlashScenarios(t *testing.T) {
+	power := func(p int64) math.Int { return sdk.TokensFromConsensusPower(p, sdk.DefaultPowerReduction) }
+	half := math.LegacyNewDecWithPrec(5, 1)
+
+	// the F1 calculation truncates the reward ratios and the stakes
+	tolerance := math.LegacyNewDecWithPrec(1, 6)
+
+	testCases := []struct {
+		name     string
+		scenario distrtestutil.SlashScenario
+	}{
+		{
+			name: "slash between two allocations",
+			scenario: distrtestutil.SlashScenario{
+				Tolerance:   tolerance,
+				Commission:  half,
+				Delegations: []distrtestutil.ScenarioDelegation{{Amount: power(100)}, {Block: 0, Amount: power(50)}},
+				Blocks: []distrtestutil.ScenarioBlock{
+					{Rewards: power(30), Slashes: []math.LegacyDec{half}},
+					{Rewards: power(30)},
+				},
+			},
+		},
+		{
+			name: "delegation between two slashes",
+			scenario: distrtestutil.SlashScenario{
+				Tolerance:   tolerance,
+				Commission:  math.LegacyNewDecWithPrec(1, 1),
+				Delegations: []distrtestutil.ScenarioDelegation{{Amount: power(100)}, {Block: 1, Amount: power(100)}},
+				Blocks: []distrtestutil.ScenarioBlock{
+					{Rewards: power(10), Slashes: []math.LegacyDec{half}},
+					{Rewards: power(10), Slashes: []math.LegacyDec{math.LegacyNewDecWithPrec(2, 1)}},
+					{Rewards: power(10)},
+				},
+			},
+		},
+		{
+			name: "many slashes in the same block",
+			scenario: distrtestutil.SlashScenario{
+				Tolerance:  tolerance,
+				Commission: math.LegacyZeroDec(),
+				Delegations: []distrtestutil.ScenarioDelegation{
+					{Amount: power(100)},
+					{Block: 0, Amount: power(200)},
+					{Block: 2, Amount: power(70)},
+				},
+				Blocks: []distrtestutil.ScenarioBlock{
+					{Rewards: power(50), Slashes: []math.LegacyDec{math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(3, 1)}},
+					{Slashes: []math.LegacyDec{math.LegacyNewDecWithPrec(5, 2)}},
+					{Rewards: power(20), Slashes: []math.LegacyDec{half, half}},
+					{Rewards: power(20)},
+				},
+			},
+		},
+		{
+			name: "slash with odd token amounts",
+			scenario: distrtestutil.SlashScenario{
+				Tolerance:   tolerance,
+				Commission:  math.LegacyNewDecWithPrec(7, 2),
+				Delegations: []distrtestutil.ScenarioDelegation{{Amount: math.NewInt(997)}, {Block: 1, Amount: math.NewInt(333)}},
+				Blocks: []distrtestutil.ScenarioBlock{
+					{Rewards: math.NewInt(101), Slashes: []math.LegacyDec{math.LegacyNewDecWithPrec(33, 2)}},
+					{Rewards: math.NewInt(211), Slashes: []math.LegacyDec{math.LegacyNewDecWithPrec(1, 2)}},
+					{Rewards: math.NewInt(7)},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			distrtestutil.RunSlashScenario(t, tc.scenario)
+		})
+	}
+}
+
 func TestCalculateRewardsMultiDelegatorMultiSlash(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
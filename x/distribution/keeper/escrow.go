@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetTotalEscrowedRewards returns the sum of the escrowed rewards of all
+// validators.
+func (k Keeper) GetTotalEscrowedRewards(ctx context.Context) (totalEscrowed sdk.DecCoins) {
+	err := k.ValidatorEscrowedRewards.Walk(ctx, nil, func(_ sdk.ValAddress, escrowed types.ValidatorEscrowedRewards) (stop bool, err error) {
+		totalEscrowed = totalEscrowed.Add(escrowed.Rewards...)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return totalEscrowed
+}
+
+// escrowRewards adds rewards allocated to a jailed validator to its escrow.
+func (k Keeper) escrowRewards(ctx context.Context, valAddr sdk.ValAddress, rewards sdk.DecCoins) error {
+	escrowed, err := k.ValidatorEscrowedRewards.Get(ctx, valAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	escrowed.Rewards = escrowed.Rewards.Add(rewards...)
+	if err := k.ValidatorEscrowedRewards.Set(ctx, valAddr, escrowed); err != nil {
+		return err
+	}
+
+	k.emitEscrowEvent(ctx, types.EventTypeEscrowRewards, valAddr, rewards)
+	return nil
+}
+
+// removeEscrowedRewards deletes the escrow of a validator and returns the
+// rewards it held, empty if the validator has no escrow.
+func (k Keeper) removeEscrowedRewards(ctx context.Context, valAddr sdk.ValAddress) (sdk.DecCoins, error) {
+	escrowed, err := k.ValidatorEscrowedRewards.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.DecCoins{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ValidatorEscrowedRewards.Remove(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	return escrowed.Rewards, nil
+}
+
+// ReleaseEscrowedRewards credits the escrowed rewards of a validator to the
+// validator, its commission included, as if they were allocated now, and
+// returns the rewards released. It fails if the validator is still jailed.
+// It is called when a validator is bonded again.
+func (k Keeper) ReleaseEscrowedRewards(ctx context.Context, valAddr sdk.ValAddress) (sdk.DecCoins, error) {
+	has, err := k.ValidatorEscrowedRewards.Has(ctx, valAddr)
+	if err != nil || !has {
+		return sdk.DecCoins{}, err
+	}
+
+	val, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, errorsmod.Wrap(types.ErrNoValidatorExists, valAddr.String())
+	}
+	if val.IsJailed() {
+		return nil, errorsmod.Wrap(types.ErrValidatorJailed, valAddr.String())
+	}
+
+	escrowed, err := k.removeEscrowedRewards(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.allocateTokensToValidatorWithRebate(ctx, params, val, escrowed, true); err != nil {
+		return nil, err
+	}
+
+	k.emitEscrowEvent(ctx, types.EventTypeReleaseEscrowedRewards, valAddr, escrowed)
+	return escrowed, nil
+}
+
+// ForfeitEscrowedRewards moves the escrowed rewards of a validator to the
+// community pool and returns the rewards forfeited. It is called by the
+// allocation for a tombstoned validator and when a validator is removed, and
+// can be called by the application when a validator is tombstoned outside of
+// the bonded votes.
+func (k Keeper) ForfeitEscrowedRewards(ctx context.Context, valAddr sdk.ValAddress) (sdk.DecCoins, error) {
+	escrowed, err := k.removeEscrowedRewards(ctx, valAddr)
+	if err != nil || escrowed.IsZero() {
+		return escrowed, err
+	}
+
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Add(escrowed...)
+	if err := k.FeePool.Set(ctx, feePool); err != nil {
+		return nil, err
+	}
+
+	k.emitEscrowEvent(ctx, types.EventTypeForfeitEscrowedRewards, valAddr, escrowed)
+	return escrowed, nil
+}
+
+func (k Keeper) emitEscrowEvent(ctx context.Context, eventType string, valAddr sdk.ValAddress, amount sdk.DecCoins) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -22,6 +23,12 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) {
 	if err := k.Params.Set(ctx, data.Params); err != nil {
 		panic(err)
 	}
+	if err := k.checkBurnerPermission(ctx, data.Params); err != nil {
+		panic(err)
+	}
+	if err := k.checkCommunityFundsDestination(data.Params); err != nil {
+		panic(err)
+	}
 
 	for _, dwi := range data.DelegatorWithdrawInfos {
 		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(dwi.DelegatorAddress)
@@ -128,7 +135,196 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) {
 		}
 	}
 
+	for _, vcwi := range data.ValidatorCommissionWithdrawInfos {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(vcwi.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		withdrawAddress, err := k.authKeeper.AddressCodec().StringToBytes(vcwi.WithdrawAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorsCommissionWithdrawAddress.Set(ctx, valAddr, withdrawAddress)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range data.ValidatorOperatorRewardsAddresses {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		rewardsAddr, err := k.authKeeper.AddressCodec().StringToBytes(record.RewardsAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorsOperatorRewardsAddress.Set(ctx, valAddr, rewardsAddr)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, dws := range data.DelegatorWithdrawSplits {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(dws.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.DelegatorsWithdrawSplit.Set(ctx, delegatorAddress, types.NewWithdrawSplit(dws.Entries...))
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, escrowed := range data.ValidatorEscrowedRewards {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(escrowed.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorEscrowedRewards.Set(ctx, valAddr, types.ValidatorEscrowedRewards{Rewards: escrowed.Rewards})
+		if err != nil {
+			panic(err)
+		}
+		moduleHoldings = moduleHoldings.Add(escrowed.Rewards...)
+	}
+
+	for _, commissionCap := range data.ValidatorCommissionCaps {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(commissionCap.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorCommissionCap.Set(ctx, valAddr, types.NewValidatorCommissionCap(commissionCap.MaxRate))
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, multiplier := range data.ValidatorRewardMultipliers {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(multiplier.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorRewardMultiplier.Set(ctx, valAddr, types.NewValidatorRewardMultiplier(multiplier.Multiplier))
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if data.AllocationEpoch.Blocks > 0 {
+		if err := k.AllocationEpoch.Set(ctx, data.AllocationEpoch); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range data.AllocationEpochPowers {
+		consAddr, err := k.stakingKeeper.ConsensusAddressCodec().StringToBytes(record.ConsensusAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.AllocationEpochPowers.Set(ctx, consAddr, record.Power)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, carried := range data.DelegatorCarriedRewards {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(carried.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(carried.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		// the payout of the rewards carried over is queued anew
+		err = k.carryRewards(ctx, delAddr, valAddr, carried.Rewards, data.Params.MinWithdrawAmount)
+		if err != nil {
+			panic(err)
+		}
+		moduleHoldings = moduleHoldings.Add(sdk.NewDecCoinsFromCoins(carried.Rewards...)...)
+	}
+
+	for _, creation := range data.ValidatorCreationHeights {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(creation.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorCreationHeights.Set(ctx, valAddr, types.ValidatorCreationHeight{Height: creation.Height})
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, exemption := range data.ValidatorCommissionExemptions {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(exemption.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorCommissionExemptions.Set(ctx, valAddr, types.NewValidatorCommissionExemption(exemption.Denoms...))
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, frozen := range data.FrozenValidators {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(frozen)
+		if err != nil {
+			panic(err)
+		}
+		err = k.FrozenValidators.Set(ctx, valAddr)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, withdrawal := range data.ValidatorCommissionWithdrawals {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(withdrawal.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.CommissionWithdrawals.Set(ctx, collections.Join(sdk.ValAddress(valAddr), withdrawal.Withdrawal.Height), withdrawal.Withdrawal)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range data.ValidatorLifetimeCommissions {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorsLifetimeCommission.Set(ctx, valAddr, record.Lifetime)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range data.CommunityTaxHistory {
+		if err := k.CommunityTaxHistory.Set(ctx, record.Height, record); err != nil {
+			panic(err)
+		}
+	}
+
+	if data.FeeCollectorName != "" {
+		if err := k.FeeCollectorName.Set(ctx, data.FeeCollectorName); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, rebated := range data.RebatedValidators {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(rebated)
+		if err != nil {
+			panic(err)
+		}
+		err = k.RebatedValidators.Set(ctx, valAddr)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
+	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
+	moduleHoldings = moduleHoldings.Add(data.FeePool.CarriedFees...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
 	// check if the module account exists
@@ -175,28 +371,12 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	outstanding := make([]types.ValidatorOutstandingRewardsRecord, 0)
-
-	err = k.ValidatorOutstandingRewards.Walk(ctx, nil, func(addr sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool, err error) {
-		outstanding = append(outstanding, types.ValidatorOutstandingRewardsRecord{
-			ValidatorAddress:   addr.String(),
-			OutstandingRewards: rewards.Rewards,
-		})
-		return false, nil
-	},
-	)
+	outstanding, err := k.exportOutstandingRewards(ctx)
 	if err != nil {
 		panic(err)
 	}
 
-	acc := make([]types.ValidatorAccumulatedCommissionRecord, 0)
-	err = k.ValidatorsAccumulatedCommission.Walk(ctx, nil, func(addr sdk.ValAddress, commission types.ValidatorAccumulatedCommission) (stop bool, err error) {
-		acc = append(acc, types.ValidatorAccumulatedCommissionRecord{
-			ValidatorAddress: addr.String(),
-			Accumulated:      commission,
-		})
-		return false, nil
-	})
+	acc, err := k.exportAccumulatedCommissions(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -262,5 +442,187 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	vcwi := make([]types.ValidatorCommissionWithdrawInfo, 0)
+	err = k.ValidatorsCommissionWithdrawAddress.Walk(ctx, nil, func(key sdk.ValAddress, value sdk.AccAddress) (stop bool, err error) {
+		vcwi = append(vcwi, types.ValidatorCommissionWithdrawInfo{
+			ValidatorAddress: key.String(),
+			WithdrawAddress:  value.String(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	dws := make([]types.DelegatorWithdrawSplitRecord, 0)
+	err = k.DelegatorsWithdrawSplit.Walk(ctx, nil, func(key sdk.AccAddress, value types.WithdrawSplit) (stop bool, err error) {
+		dws = append(dws, types.DelegatorWithdrawSplitRecord{
+			DelegatorAddress: key.String(),
+			Entries:          value.Entries,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	escrowed := make([]types.ValidatorEscrowedRewardsRecord, 0)
+	err = k.ValidatorEscrowedRewards.Walk(ctx, nil, func(key sdk.ValAddress, value types.ValidatorEscrowedRewards) (stop bool, err error) {
+		escrowed = append(escrowed, types.ValidatorEscrowedRewardsRecord{
+			ValidatorAddress: key.String(),
+			Rewards:          value.Rewards,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	caps := make([]types.ValidatorCommissionCapRecord, 0)
+	err = k.ValidatorCommissionCap.Walk(ctx, nil, func(key sdk.ValAddress, value types.ValidatorCommissionCap) (stop bool, err error) {
+		caps = append(caps, types.ValidatorCommissionCapRecord{
+			ValidatorAddress: key.String(),
+			MaxRate:          value.MaxRate,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	multipliers := make([]types.ValidatorRewardMultiplierRecord, 0)
+	err = k.ValidatorRewardMultiplier.Walk(ctx, nil, func(key sdk.ValAddress, value types.ValidatorRewardMultiplier) (stop bool, err error) {
+		multipliers = append(multipliers, types.ValidatorRewardMultiplierRecord{
+			ValidatorAddress: key.String(),
+			Multiplier:       value.Multiplier,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	carried := make([]types.DelegatorCarriedRewardsRecord, 0)
+	err = k.DelegatorCarriedRewards.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], value types.DelegatorCarriedRewards) (stop bool, err error) {
+		carried = append(carried, types.DelegatorCarriedRewardsRecord{
+			DelegatorAddress: key.K1().String(),
+			ValidatorAddress: key.K2().String(),
+			Rewards:          value.Rewards,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	creationHeights := make([]types.ValidatorCreationHeightRecord, 0)
+	err = k.ValidatorCreationHeights.Walk(ctx, nil, func(key sdk.ValAddress, value types.ValidatorCreationHeight) (stop bool, err error) {
+		creationHeights = append(creationHeights, types.ValidatorCreationHeightRecord{
+			ValidatorAddress: key.String(),
+			Height:           value.Height,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	exemptions := make([]types.ValidatorCommissionExemptionRecord, 0)
+	err = k.ValidatorCommissionExemptions.Walk(ctx, nil, func(key sdk.ValAddress, value types.ValidatorCommissionExemption) (stop bool, err error) {
+		exemptions = append(exemptions, types.ValidatorCommissionExemptionRecord{
+			ValidatorAddress: key.String(),
+			Denoms:           value.Denoms,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	frozen := make([]string, 0)
+	err = k.FrozenValidators.Walk(ctx, nil, func(key sdk.ValAddress) (stop bool, err error) {
+		frozen = append(frozen, key.String())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	withdrawals := make([]types.ValidatorCommissionWithdrawalRecord, 0)
+	err = k.CommissionWithdrawals.Walk(ctx, nil, func(key collections.Pair[sdk.ValAddress, uint64], value types.CommissionWithdrawal) (stop bool, err error) {
+		withdrawals = append(withdrawals, types.ValidatorCommissionWithdrawalRecord{
+			ValidatorAddress: key.K1().String(),
+			Withdrawal:       value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	rebated := make([]string, 0)
+	err = k.RebatedValidators.Walk(ctx, nil, func(key sdk.ValAddress) (stop bool, err error) {
+		rebated = append(rebated, key.String())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	feeCollectorName, err := k.FeeCollectorName.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
+	epoch, err := k.AllocationEpoch.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
+	epochPowers := make([]types.AllocationEpochPowerRecord, 0)
+	err = k.AllocationEpochPowers.Walk(ctx, nil, func(key sdk.ConsAddress, value types.AllocationEpochPower) (stop bool, err error) {
+		epochPowers = append(epochPowers, types.AllocationEpochPowerRecord{
+			ConsensusAddress: key.String(),
+			Power:            value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	operatorRewardsAddrs := make([]types.ValidatorOperatorRewardsAddressRecord, 0)
+	err = k.ValidatorsOperatorRewardsAddress.Walk(ctx, nil, func(key sdk.ValAddress, value sdk.AccAddress) (stop bool, err error) {
+		operatorRewardsAddrs = append(operatorRewardsAddrs, types.ValidatorOperatorRewardsAddressRecord{
+			ValidatorAddress: key.String(),
+			RewardsAddress:   value.String(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	lifetimeCommissions := make([]types.ValidatorLifetimeCommissionRecord, 0)
+	err = k.ValidatorsLifetimeCommission.Walk(ctx, nil, func(key sdk.ValAddress, value types.ValidatorLifetimeCommission) (stop bool, err error) {
+		lifetimeCommissions = append(lifetimeCommissions, types.ValidatorLifetimeCommissionRecord{
+			ValidatorAddress: key.String(),
+			Lifetime:         value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	taxHistory := make([]types.CommunityTaxRecord, 0)
+	err = k.CommunityTaxHistory.Walk(ctx, nil, func(_ uint64, value types.CommunityTaxRecord) (stop bool, err error) {
+		taxHistory = append(taxHistory, value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, vcwi, dws, escrowed, caps, carried, creationHeights, exemptions, frozen, withdrawals, rebated, feeCollectorName, multipliers, epoch, epochPowers, operatorRewardsAddrs, lifetimeCommissions, taxHistory)
 }
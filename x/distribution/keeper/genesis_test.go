@@ -0,0 +1,540 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/distribution"
+	"cosmossdk.io/x/distribution/types"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestGenesisDecimalPoolRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	// the decimal pool holds sub-integer amounts only, down to the smallest
+	// representable decimal
+	feePool := types.FeePool{
+		CommunityPool: sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr("10.5")}},
+		DecimalPool: sdk.DecCoins{
+			{Denom: "atom", Amount: math.LegacyNewDecWithPrec(1, math.LegacyPrecision)},
+			{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr("0.999999999999999999")},
+		},
+		CarriedFees: sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(5)}},
+	}
+
+	// the module holdings are truncated, 10.5stake + 0.999999999999999999stake + 5stake
+	holdings := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 16))
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(holdings).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.FeePool = feePool
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	// export to JSON, as done when dumping the state of a chain
+	exported := distrKeeper.ExportGenesis(ctx)
+	bz := cdc.MustMarshalJSON(exported)
+
+	// and re-import into a fresh store
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(bz, &imported)
+	require.NoError(t, types.ValidateGenesis(&imported))
+
+	newCtx, _, newDistrKeeper, newDep := initFixture(t)
+	newDep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	newDep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(holdings).AnyTimes()
+	newDistrKeeper.InitGenesis(newCtx, imported)
+
+	restored, err := newDistrKeeper.FeePool.Get(newCtx)
+	require.NoError(t, err)
+	require.Equal(t, feePool.DecimalPool, restored.DecimalPool)
+	require.Equal(t, cdc.MustMarshal(&feePool), cdc.MustMarshal(&restored))
+
+	// a second export is identical to the first one
+	require.Equal(t, bz, cdc.MustMarshalJSON(newDistrKeeper.ExportGenesis(newCtx)))
+}
+
+func TestGenesisEscrowedRewardsRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	escrowed := []types.ValidatorEscrowedRewardsRecord{
+		{
+			ValidatorAddress: sdk.ValAddress(valConsAddr0).String(),
+			Rewards:          sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr("32.5")}},
+		},
+	}
+
+	// the escrowed rewards are held by the module account
+	holdings := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 32))
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(holdings).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.ValidatorEscrowedRewards = escrowed
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+	require.Equal(t, escrowed[0].Rewards, distrKeeper.GetTotalEscrowedRewards(ctx))
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, escrowed, imported.ValidatorEscrowedRewards)
+
+	// a module account not holding the escrowed rewards is rejected
+	newCtx, _, newDistrKeeper, newDep := initFixture(t)
+	newDep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	newDep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	newDep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+	require.Panics(t, func() { newDistrKeeper.InitGenesis(newCtx, imported) })
+}
+
+func TestGenesisCommissionCapsRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	caps := []types.ValidatorCommissionCapRecord{
+		{
+			ValidatorAddress: sdk.ValAddress(valConsAddr0).String(),
+			MaxRate:          math.LegacyNewDecWithPrec(5, 2),
+		},
+	}
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.ValidatorCommissionCaps = caps
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	commissionCap, err := distrKeeper.ValidatorCommissionCap.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, caps[0].MaxRate, commissionCap.MaxRate)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, caps, imported.ValidatorCommissionCaps)
+
+	// a cap above one is rejected
+	imported.ValidatorCommissionCaps[0].MaxRate = math.LegacyNewDec(2)
+	require.ErrorIs(t, types.ValidateGenesis(&imported), types.ErrInvalidCommissionCap)
+}
+
+func TestGenesisCommissionExemptionsRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	exemptions := []types.ValidatorCommissionExemptionRecord{
+		{
+			ValidatorAddress: sdk.ValAddress(valConsAddr0).String(),
+			Denoms:           []string{"atom", "osmo"},
+		},
+	}
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.ValidatorCommissionExemptions = exemptions
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	exemption, err := distrKeeper.ValidatorCommissionExemptions.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, exemptions[0].Denoms, exemption.Denoms)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, exemptions, imported.ValidatorCommissionExemptions)
+
+	// a duplicate denom is rejected
+	imported.ValidatorCommissionExemptions[0].Denoms = []string{"atom", "atom"}
+	require.ErrorIs(t, types.ValidateGenesis(&imported), types.ErrInvalidCommissionExempt)
+}
+
+func TestGenesisFrozenValidatorsRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	frozen := []string{sdk.ValAddress(valConsAddr0).String()}
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.FrozenValidators = frozen
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	isFrozen, err := distrKeeper.IsValidatorRewardsFrozen(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.True(t, isFrozen)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, frozen, imported.FrozenValidators)
+}
+
+func TestGenesisRebatedValidatorsRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	rebated := []string{sdk.ValAddress(valConsAddr0).String()}
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.RebatedValidators = rebated
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	isRebated, err := distrKeeper.IsValidatorCommissionRebated(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.True(t, isRebated)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, rebated, imported.RebatedValidators)
+}
+
+func TestGenesisFeeCollectorNameRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.FeeCollectorName = "protocol_fees"
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	names, err := distrKeeper.GetFeeCollectorNames(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"protocol_fees"}, names)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, "protocol_fees", imported.FeeCollectorName)
+}
+
+func TestGenesisRewardMultipliersRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	multipliers := []types.ValidatorRewardMultiplierRecord{
+		{ValidatorAddress: sdk.ValAddress(valConsAddr0).String(), Multiplier: math.LegacyNewDecWithPrec(12, 1)},
+	}
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.ValidatorRewardMultipliers = multipliers
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	multiplier, err := distrKeeper.GetValidatorRewardMultiplier(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(12, 1), multiplier)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, multipliers, imported.ValidatorRewardMultipliers)
+
+	// a negative multiplier is rejected
+	genesis.ValidatorRewardMultipliers[0].Multiplier = math.LegacyNewDec(-1)
+	require.ErrorIs(t, types.ValidateGenesis(genesis), types.ErrInvalidRewardMultiplier)
+}
+
+func TestGenesisAllocationEpochRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	epoch := types.AllocationEpoch{Blocks: 2, TotalPower: 100}
+	epochPowers := []types.AllocationEpochPowerRecord{
+		{ConsensusAddress: valConsAddr0.String(), Power: types.AllocationEpochPower{Power: 60, SignedPower: 40}},
+	}
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+	dep.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons")).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.AllocationEpoch = epoch
+	genesis.AllocationEpochPowers = epochPowers
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	power, err := distrKeeper.AllocationEpochPowers.Get(ctx, valConsAddr0)
+	require.NoError(t, err)
+	require.Equal(t, epochPowers[0].Power, power)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, epoch, imported.AllocationEpoch)
+	require.Equal(t, epochPowers, imported.AllocationEpochPowers)
+
+	// a signed power above the power is rejected
+	genesis.AllocationEpochPowers[0].Power.SignedPower = 70
+	require.Error(t, types.ValidateGenesis(genesis))
+}
+
+func TestGenesisCommissionWithdrawalsRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	withdrawals := []types.ValidatorCommissionWithdrawalRecord{
+		{
+			ValidatorAddress: sdk.ValAddress(valConsAddr0).String(),
+			Withdrawal:       types.CommissionWithdrawal{Height: 4, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 12))},
+		},
+		{
+			ValidatorAddress: sdk.ValAddress(valConsAddr0).String(),
+			Withdrawal:       types.CommissionWithdrawal{Height: 9, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3))},
+		},
+	}
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.ValidatorCommissionWithdrawals = withdrawals
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, withdrawals, imported.ValidatorCommissionWithdrawals)
+
+	// invalid amounts are rejected
+	genesis.ValidatorCommissionWithdrawals = []types.ValidatorCommissionWithdrawalRecord{
+		{
+			ValidatorAddress: sdk.ValAddress(valConsAddr0).String(),
+			Withdrawal:       types.CommissionWithdrawal{Height: 4, Amount: sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: math.NewInt(-1)}}},
+		},
+	}
+	require.ErrorContains(t, types.ValidateGenesis(genesis), "invalid commission withdrawal")
+}
+
+func TestGenesisCarriedRewardsRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	carried := []types.DelegatorCarriedRewardsRecord{
+		{
+			DelegatorAddress: sdk.AccAddress(valConsAddr0).String(),
+			Rewards:          sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)),
+			ValidatorAddress: sdk.ValAddress(valConsAddr1).String(),
+		},
+	}
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+
+	// the carried rewards are held by the module account
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(carried[0].Rewards).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.DelegatorCarriedRewards = carried
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	require.Equal(t, carried[0].Rewards, distrKeeper.GetTotalCarriedRewards(ctx))
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, carried, imported.DelegatorCarriedRewards)
+
+	// invalid carried rewards are rejected
+	imported.DelegatorCarriedRewards[0].Rewards = sdk.Coins{{Denom: sdk.DefaultBondDenom, Amount: math.NewInt(-1)}}
+	require.Error(t, types.ValidateGenesis(&imported))
+}
+
+func TestGenesisCreationHeightsRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	creationHeights := []types.ValidatorCreationHeightRecord{
+		{
+			ValidatorAddress: sdk.ValAddress(valConsAddr0).String(),
+			Height:           42,
+		},
+	}
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.ValidatorCreationHeights = creationHeights
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	creation, err := distrKeeper.ValidatorCreationHeights.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), creation.Height)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, creationHeights, imported.ValidatorCreationHeights)
+}
+
+func TestGenesisOperatorRewardsAddressesRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	rewardsAddr := sdk.AccAddress("operations__________")
+	records := []types.ValidatorOperatorRewardsAddressRecord{
+		{
+			ValidatorAddress: sdk.ValAddress(valConsAddr0).String(),
+			RewardsAddress:   rewardsAddr.String(),
+		},
+	}
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.ValidatorOperatorRewardsAddresses = records
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	addr, err := distrKeeper.ValidatorsOperatorRewardsAddress.Get(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, rewardsAddr, addr)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, records, imported.ValidatorOperatorRewardsAddresses)
+}
+
+func TestGenesisLifetimeCommissionsRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	records := []types.ValidatorLifetimeCommissionRecord{
+		{
+			ValidatorAddress: sdk.ValAddress(valConsAddr0).String(),
+			Lifetime:         types.ValidatorLifetimeCommission{Commission: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 42))},
+		},
+	}
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.ValidatorLifetimeCommissions = records
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	lifetime, err := distrKeeper.ValidatorLifetimeCommission(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, records[0].Lifetime.Commission, lifetime)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, records, imported.ValidatorLifetimeCommissions)
+}
+
+func TestGenesisCommunityTaxHistoryRoundTrip(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	records := []types.CommunityTaxRecord{
+		{Height: 0, CommunityTax: math.LegacyNewDecWithPrec(2, 2)},
+		{Height: 21, CommunityTax: math.LegacyNewDecWithPrec(5, 2)},
+	}
+
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc).AnyTimes()
+
+	genesis := types.DefaultGenesisState()
+	genesis.CommunityTaxHistory = records
+	require.NoError(t, types.ValidateGenesis(genesis))
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	tax, err := distrKeeper.CommunityTaxAt(ctx, 20)
+	require.NoError(t, err)
+	require.Equal(t, records[0].CommunityTax, tax)
+
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(distrKeeper.ExportGenesis(ctx)), &imported)
+	require.Equal(t, records, imported.CommunityTaxHistory)
+
+	// a recorded community tax must be valid
+	genesis.CommunityTaxHistory = []types.CommunityTaxRecord{{Height: 3, CommunityTax: math.LegacyNewDec(2)}}
+	require.Error(t, types.ValidateGenesis(genesis))
+}
+
+func TestInitGenesisBurnerPermission(t *testing.T) {
+	genesis := types.DefaultGenesisState()
+	genesis.Params.BurnRate = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, types.ValidateGenesis(genesis))
+
+	// a burn rate is rejected while the distribution module account cannot burn
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	require.PanicsWithError(t, "distribution module account has no burner permission, required by the burn rate", func() {
+		distrKeeper.InitGenesis(ctx, *genesis)
+	})
+
+	burnerAcc := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Burner)
+	ctx, _, distrKeeper, dep = initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(burnerAcc).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), burnerAcc)
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), burnerAcc.GetAddress()).Return(sdk.NewCoins())
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	params, err := distrKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesis.Params.BurnRate, params.BurnRate)
+}
+
+func TestInitGenesisKeepCommunityFundsLocal(t *testing.T) {
+	genesis := types.DefaultGenesisState()
+	genesis.Params.KeepCommunityFundsLocal = false
+	require.NoError(t, types.ValidateGenesis(genesis))
+
+	// the community funds cannot be sent out on a chain without x/protocolpool
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAddress(pooltypes.ModuleName).Return(nil)
+	require.Panics(t, func() {
+		distrKeeper.InitGenesis(ctx, *genesis)
+	})
+
+	ctx, _, distrKeeper, dep = initFixture(t)
+	dep.accountKeeper.EXPECT().GetModuleAddress(pooltypes.ModuleName).Return(authtypes.NewModuleAddress(pooltypes.ModuleName))
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+	dep.accountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc)
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins())
+	distrKeeper.InitGenesis(ctx, *genesis)
+
+	keep, err := distrKeeper.GetKeepCommunityFundsLocal(ctx)
+	require.NoError(t, err)
+	require.False(t, keep)
+}
@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/distribution/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
@@ -35,6 +38,64 @@ func (k Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*ty
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// AllocationParams queries the rates applied by the fee allocation and the vote
+// multiplier they derive
+func (k Querier) AllocationParams(ctx context.Context, req *types.QueryAllocationParamsRequest) (*types.QueryAllocationParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	communityTax, err := k.GetCommunityTax(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	baseProposerReward, err := k.GetBaseProposerReward(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bonusProposerReward, err := k.GetBonusProposerReward(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	burnRate, err := k.GetBurnRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infraFundRate, err := k.GetInfraFundRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infraFundModule, err := k.GetInfraFundModule(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commissionRebateRate, err := k.GetCommissionRebateRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the proposer multiplier of a block signed by all the voting power, as
+	// computed by getProposerMultiplier
+	proposerMultiplier := baseProposerReward.Add(bonusProposerReward)
+
+	return &types.QueryAllocationParamsResponse{
+		CommunityTax:         communityTax,
+		VoteMultiplier:       math.LegacyOneDec().Sub(proposerMultiplier).Sub(communityTax),
+		BaseProposerReward:   baseProposerReward,
+		BonusProposerReward:  bonusProposerReward,
+		BurnRate:             burnRate,
+		InfraFundRate:        infraFundRate,
+		InfraFundModule:      infraFundModule,
+		CommissionRebateRate: commissionRebateRate,
+	}, nil
+}
+
 // ValidatorDistributionInfo query validator's commission and self-delegation rewards
 func (k Querier) ValidatorDistributionInfo(ctx context.Context, req *types.QueryValidatorDistributionInfoRequest) (*types.QueryValidatorDistributionInfoResponse, error) {
 	if req == nil {
@@ -126,6 +187,49 @@ func (k Querier) ValidatorOutstandingRewards(ctx context.Context, req *types.Que
 	return &types.QueryValidatorOutstandingRewardsResponse{Rewards: rewards}, nil
 }
 
+// TotalOutstandingRewards queries the sum of the outstanding rewards of all
+// validators. It iterates over every validator, so its cost grows with the size
+// of the validator set.
+func (k Querier) TotalOutstandingRewards(ctx context.Context, req *types.QueryTotalOutstandingRewardsRequest) (*types.QueryTotalOutstandingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var total sdk.DecCoins
+	err := k.Keeper.ValidatorOutstandingRewards.Walk(ctx, nil, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool, err error) {
+		total = total.Add(rewards.Rewards...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTotalOutstandingRewardsResponse{Rewards: total}, nil
+}
+
+// ValidatorsWithOutstandingRewards queries the validators with non-zero
+// outstanding rewards and their outstanding rewards
+func (k Querier) ValidatorsWithOutstandingRewards(ctx context.Context, req *types.QueryValidatorsWithOutstandingRewardsRequest) (*types.QueryValidatorsWithOutstandingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	records, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Keeper.ValidatorOutstandingRewards, req.Pagination, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (include bool, err error) {
+		return !rewards.Rewards.IsZero(), nil
+	}, func(valAddr sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (types.ValidatorOutstandingRewardsRecord, error) {
+		valAddrStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return types.ValidatorOutstandingRewardsRecord{}, err
+		}
+		return types.ValidatorOutstandingRewardsRecord{ValidatorAddress: valAddrStr, OutstandingRewards: rewards.Rewards}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorsWithOutstandingRewardsResponse{Rewards: records, Pagination: pageRes}, nil
+}
+
 // ValidatorCommission queries accumulated commission for a validator
 func (k Querier) ValidatorCommission(ctx context.Context, req *types.QueryValidatorCommissionRequest) (*types.QueryValidatorCommissionResponse, error) {
 	if req == nil {
@@ -157,6 +261,241 @@ func (k Querier) ValidatorCommission(ctx context.Context, req *types.QueryValida
 	return &types.QueryValidatorCommissionResponse{Commission: commission}, nil
 }
 
+// ValidatorCurrentRewards queries the rewards accumulated by a validator in its current period
+func (k Querier) ValidatorCurrentRewards(ctx context.Context, req *types.QueryValidatorCurrentRewardsRequest) (*types.QueryValidatorCurrentRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.stakingKeeper.Validator(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator == nil {
+		return nil, errors.Wrapf(types.ErrNoValidatorExists, req.ValidatorAddress)
+	}
+
+	rewards, err := k.Keeper.ValidatorCurrentRewards.Get(ctx, valAdr)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	return &types.QueryValidatorCurrentRewardsResponse{Rewards: rewards.Rewards, Period: rewards.Period}, nil
+}
+
+// ValidatorHistoricalRewards queries the historical rewards of a validator at
+// a period. Periods are pruned once no delegation references them anymore, a
+// pruned or never reached period is reported as not found.
+func (k Querier) ValidatorHistoricalRewards(ctx context.Context, req *types.QueryValidatorHistoricalRewardsRequest) (*types.QueryValidatorHistoricalRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := k.Keeper.ValidatorHistoricalRewards.Get(ctx, collections.Join(sdk.ValAddress(valAdr), req.Period))
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no historical rewards for validator %s at period %d", req.ValidatorAddress, req.Period)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorHistoricalRewardsResponse{Rewards: rewards}, nil
+}
+
+// ValidatorEstimatedRewardRate estimates the annual reward rate of a validator
+// from the tokens allocated to it over the recent blocks. The duration of the
+// sampled blocks is extrapolated from the average block time between the
+// first and the last samples, no rate is estimated from less than two samples.
+// The estimate is not authoritative.
+func (k Querier) ValidatorEstimatedRewardRate(ctx context.Context, req *types.QueryValidatorEstimatedRewardRateRequest) (*types.QueryValidatorEstimatedRewardRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	if k.rewardSampleBlocks == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "reward sampling is disabled")
+	}
+
+	if req.LookbackBlocks == 0 || req.LookbackBlocks > k.rewardSampleBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "lookback blocks must be between 1 and %d", k.rewardSampleBlocks)
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.stakingKeeper.Validator(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator == nil {
+		return nil, errors.Wrapf(types.ErrNoValidatorExists, req.ValidatorAddress)
+	}
+
+	// the chain may be younger than the lookback window
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	blocks := req.LookbackBlocks
+	if blocks > height {
+		blocks = height
+	}
+
+	rewards := sdk.DecCoins{}
+	var first, last *types.ValidatorRewardSample
+	var firstHeight, lastHeight uint64
+	rng := collections.NewPrefixedPairRange[sdk.ValAddress, uint64](valAdr).
+		StartInclusive(height - blocks + 1).
+		EndInclusive(height)
+	err = k.Keeper.ValidatorRewardSamples.Walk(ctx, rng, func(key collections.Pair[sdk.ValAddress, uint64], sample types.ValidatorRewardSample) (stop bool, err error) {
+		rewards = rewards.Add(sample.Rewards...)
+		if first == nil {
+			first, firstHeight = &sample, key.K2()
+		}
+		last, lastHeight = &sample, key.K2()
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryValidatorEstimatedRewardRateResponse{
+		Rewards:             rewards,
+		Blocks:              blocks,
+		BondedTokens:        validator.GetBondedTokens(),
+		RewardRate:          sdk.DecCoins{},
+		DelegatorRewardRate: sdk.DecCoins{},
+	}
+
+	if first == nil || firstHeight == lastHeight || !res.BondedTokens.IsPositive() {
+		return res, nil
+	}
+
+	elapsed := last.Time.Sub(first.Time)
+	if elapsed <= 0 {
+		return res, nil
+	}
+
+	// annualize the rewards over the duration of the sampled blocks
+	window := math.LegacyNewDec(elapsed.Nanoseconds()).MulInt64(int64(blocks)).QuoInt64(int64(lastHeight - firstHeight))
+	annualRewards := rewards.MulDec(math.LegacyNewDec(int64(365 * 24 * time.Hour)).Quo(window))
+	res.RewardRate = annualRewards.QuoDec(math.LegacyNewDecFromInt(res.BondedTokens))
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	commissionRate, err := k.effectiveCommissionRate(ctx, params, validator)
+	if err != nil {
+		return nil, err
+	}
+	_, res.DelegatorRewardRate, err = k.splitTokensByCommission(ctx, valAdr, res.RewardRate, commissionRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ValidatorRewardsFrozen queries whether the reward accrual of a validator is
+// frozen
+func (k Querier) ValidatorRewardsFrozen(ctx context.Context, req *types.QueryValidatorRewardsFrozenRequest) (*types.QueryValidatorRewardsFrozenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	frozen, err := k.IsValidatorRewardsFrozen(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorRewardsFrozenResponse{Frozen: frozen}, nil
+}
+
+// ValidatorRewardMultiplier queries the multiplier applied to the rewards of a
+// validator
+func (k Querier) ValidatorRewardMultiplier(ctx context.Context, req *types.QueryValidatorRewardMultiplierRequest) (*types.QueryValidatorRewardMultiplierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	multiplier, err := k.GetValidatorRewardMultiplier(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorRewardMultiplierResponse{Multiplier: multiplier}, nil
+}
+
+// ValidatorCommissionWithdrawals queries the recorded commission withdrawals of
+// a validator
+func (k Querier) ValidatorCommissionWithdrawals(ctx context.Context, req *types.QueryValidatorCommissionWithdrawalsRequest) (*types.QueryValidatorCommissionWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	withdrawals, pageRes, err := query.CollectionPaginate(ctx, k.CommissionWithdrawals, req.Pagination, func(_ collections.Pair[sdk.ValAddress, uint64], value types.CommissionWithdrawal) (types.CommissionWithdrawal, error) {
+		return value, nil
+	},
+		query.WithCollectionPaginationPairPrefix[sdk.ValAddress, uint64](valAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorCommissionWithdrawalsResponse{Withdrawals: withdrawals, Pagination: pageRes}, nil
+}
+
 // ValidatorSlashes queries slash events of a validator
 func (k Querier) ValidatorSlashes(ctx context.Context, req *types.QueryValidatorSlashesRequest) (*types.QueryValidatorSlashesResponse, error) {
 	if req == nil {
@@ -193,6 +532,48 @@ func (k Querier) ValidatorSlashes(ctx context.Context, req *types.QueryValidator
 	return &types.QueryValidatorSlashesResponse{Slashes: events, Pagination: pageRes}, nil
 }
 
+// ValidatorSlashesByHeight queries the slash events of a validator that
+// occurred in a range of block heights, with the height of every event
+func (k Querier) ValidatorSlashesByHeight(ctx context.Context, req *types.QueryValidatorSlashesByHeightRequest) (*types.QueryValidatorSlashesByHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	if req.EndingHeight < req.StartingHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "starting height greater than ending height (%d > %d)", req.StartingHeight, req.EndingHeight)
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	// slash events are keyed by the height they occurred at and the period
+	// they ended
+	events, pageRes, err := query.CollectionFilteredPaginate(ctx, k.ValidatorSlashEvents, req.Pagination, func(key collections.Triple[sdk.ValAddress, uint64, uint64], _ types.ValidatorSlashEvent) (include bool, err error) {
+		height := key.K2()
+		return height >= req.StartingHeight && height <= req.EndingHeight, nil
+	}, func(key collections.Triple[sdk.ValAddress, uint64, uint64], value types.ValidatorSlashEvent) (types.ValidatorSlashEventRecord, error) {
+		return types.ValidatorSlashEventRecord{
+			ValidatorAddress:    req.ValidatorAddress,
+			Height:              key.K2(),
+			Period:              key.K3(),
+			ValidatorSlashEvent: value,
+		}, nil
+	},
+		query.WithCollectionPaginationTriplePrefix[sdk.ValAddress, uint64, uint64](valAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorSlashesByHeightResponse{Slashes: events, Pagination: pageRes}, nil
+}
+
 // DelegationRewards the total rewards accrued by a delegation
 func (k Querier) DelegationRewards(ctx context.Context, req *types.QueryDelegationRewardsRequest) (*types.QueryDelegationRewardsResponse, error) {
 	if req == nil {
@@ -247,6 +628,114 @@ func (k Querier) DelegationRewards(ctx context.Context, req *types.QueryDelegati
 	return &types.QueryDelegationRewardsResponse{Rewards: rewards}, nil
 }
 
+// DelegationRewardsProjection queries an estimate of the rewards accrued by a
+// delegation after a number of blocks, assuming that neither the delegation,
+// the validator nor its rewards change. The rewards allocated to the validator
+// over the sampled blocks are averaged per block, split with the current
+// commission of the validator and shared pro rata of the delegation shares,
+// and the result is extrapolated linearly. The estimate is not authoritative.
+func (k Querier) DelegationRewardsProjection(ctx context.Context, req *types.QueryDelegationRewardsProjectionRequest) (*types.QueryDelegationRewardsProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	if k.rewardSampleBlocks == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "reward sampling is disabled")
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := k.stakingKeeper.Validator(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	if val == nil {
+		return nil, errors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
+	}
+
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	del, err := k.stakingKeeper.Delegation(ctx, delAdr, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	if del == nil {
+		return nil, types.ErrNoDelegationExists
+	}
+
+	endingPeriod, err := k.IncrementValidatorPeriod(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	// the chain may be younger than the sampled blocks
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	blocks := k.rewardSampleBlocks
+	if blocks > height {
+		blocks = height
+	}
+
+	res := &types.QueryDelegationRewardsProjectionResponse{
+		Rewards:                  rewards,
+		EstimatedRewardsPerBlock: sdk.DecCoins{},
+		EstimatedRewards:         rewards,
+		SampledBlocks:            blocks,
+	}
+
+	if blocks == 0 || !val.GetDelegatorShares().IsPositive() {
+		return res, nil
+	}
+
+	valRewards := sdk.DecCoins{}
+	rng := collections.NewPrefixedPairRange[sdk.ValAddress, uint64](valAdr).
+		StartInclusive(height - blocks + 1).
+		EndInclusive(height)
+	err = k.Keeper.ValidatorRewardSamples.Walk(ctx, rng, func(_ collections.Pair[sdk.ValAddress, uint64], sample types.ValidatorRewardSample) (stop bool, err error) {
+		valRewards = valRewards.Add(sample.Rewards...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	commissionRate, err := k.effectiveCommissionRate(ctx, params, val)
+	if err != nil {
+		return nil, err
+	}
+	_, shared, err := k.splitTokensByCommission(ctx, valAdr, valRewards.QuoDec(math.LegacyNewDec(int64(blocks))), commissionRate)
+	if err != nil {
+		return nil, err
+	}
+
+	res.EstimatedRewardsPerBlock = shared.MulDec(del.GetShares().Quo(val.GetDelegatorShares()))
+	res.EstimatedRewards = rewards.Add(res.EstimatedRewardsPerBlock.MulDec(math.LegacyNewDecFromInt(math.NewIntFromUint64(req.Blocks)))...)
+	return res, nil
+}
+
 // DelegationTotalRewards the total rewards accrued by a each validator
 func (k Querier) DelegationTotalRewards(ctx context.Context, req *types.QueryDelegationTotalRewardsRequest) (*types.QueryDelegationTotalRewardsResponse, error) {
 	if req == nil {
@@ -353,13 +842,183 @@ func (k Querier) DelegatorWithdrawAddress(ctx context.Context, req *types.QueryD
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// DecimalPool queries the decimal pool coins
+func (k Querier) DecimalPool(ctx context.Context, req *types.QueryDecimalPoolRequest) (*types.QueryDecimalPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	feePool, err := k.Keeper.FeePool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDecimalPoolResponse{Pool: feePool.DecimalPool}, nil
+}
+
+// FeePoolCommunityPool queries the community pool coins held by the fee pool
+func (k Querier) FeePoolCommunityPool(ctx context.Context, req *types.QueryFeePoolCommunityPoolRequest) (*types.QueryFeePoolCommunityPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	feePool, err := k.Keeper.FeePool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeePoolCommunityPoolResponse{Pool: feePool.CommunityPool}, nil
+}
+
+// LastBlockFeesDistributed queries the fees collected and distributed by the
+// allocation of the last block, which were collected in the block before it
+func (k Querier) LastBlockFeesDistributed(ctx context.Context, req *types.QueryLastBlockFeesDistributedRequest) (*types.QueryLastBlockFeesDistributedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	fees, err := k.Keeper.LastBlockFees.Get(ctx)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	return &types.QueryLastBlockFeesDistributedResponse{Fees: fees}, nil
+}
+
+// SimulateAllocation dry-runs the allocation of the given fees to the given
+// votes, or to the current bonded validators if no vote is given
+func (k Querier) SimulateAllocation(ctx context.Context, req *types.QuerySimulateAllocationRequest) (*types.QuerySimulateAllocationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := req.Fees.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the total power is the one of the current bonded validators, a validator
+	// that does not vote loses its share to the community pool
+	var (
+		totalPower int64
+		votes      []comet.VoteInfo
+		iterErr    error
+	)
+	err := k.stakingKeeper.IterateValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		if !validator.IsBonded() {
+			return false
+		}
+
+		power := validator.GetConsensusPower(sdk.DefaultPowerReduction)
+		totalPower += power
+		if len(req.Votes) > 0 {
+			return false
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		votes = append(votes, comet.VoteInfo{
+			Validator:   comet.Validator{Address: consAddr, Power: power},
+			BlockIDFlag: comet.BlockIDFlagCommit,
+		})
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
+
+	for _, vote := range req.Votes {
+		if vote.Power < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "negative power of validator %s", vote.ValidatorAddress)
+		}
+
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(vote.ValidatorAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		validator, err := k.stakingKeeper.Validator(ctx, valAddr)
+		if err != nil && !errors.IsOf(err, stakingtypes.ErrNoValidatorFound) {
+			return nil, err
+		}
+		if validator == nil {
+			return nil, errors.Wrap(types.ErrNoValidatorExists, vote.ValidatorAddress)
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return nil, err
+		}
+
+		blockIDFlag := comet.BlockIDFlagAbsent
+		if vote.Signed {
+			blockIDFlag = comet.BlockIDFlagCommit
+		}
+		votes = append(votes, comet.VoteInfo{
+			Validator:   comet.Validator{Address: consAddr, Power: vote.Power},
+			BlockIDFlag: blockIDFlag,
+		})
+	}
+
+	result, validators, err := k.Keeper.SimulateAllocation(ctx, req.Fees, totalPower, votes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulateAllocationResponse{
+		Validators:    validators,
+		CommunityPool: result.ToCommunityPool,
+		DecimalPool:   result.Remainder,
+		Burned:        result.Burned,
+		Subsidy:       result.Subsidy,
+	}, nil
+}
+
 // Deprecated: DO NOT USE
 // This method uses deprecated query request. Use CommunityPool from x/protocolpool module instead.
 // CommunityPool queries the community pool coins
 func (k Querier) CommunityPool(ctx context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
+	if k.poolKeeper == nil {
+		return nil, types.ErrCommunityPoolUnavailable
+	}
+
 	pool, err := k.poolKeeper.GetCommunityPool(ctx)
 	if err != nil {
 		return nil, err
 	}
 	return &types.QueryCommunityPoolResponse{Pool: sdk.NewDecCoinsFromCoins(pool...)}, nil
 }
+
+// TotalCommunityFunds queries the community pool coins, the decimal pool coins
+// and their sum
+func (k Querier) TotalCommunityFunds(ctx context.Context, req *types.QueryTotalCommunityFundsRequest) (*types.QueryTotalCommunityFundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if k.poolKeeper == nil {
+		return nil, types.ErrCommunityPoolUnavailable
+	}
+
+	pool, err := k.poolKeeper.GetCommunityPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feePool, err := k.Keeper.FeePool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	communityPool := sdk.NewDecCoinsFromCoins(pool...)
+	return &types.QueryTotalCommunityFundsResponse{
+		CommunityPool: communityPool,
+		DecimalPool:   feePool.DecimalPool,
+		Total:         communityPool.Add(feePool.DecimalPool...),
+	}, nil
+}
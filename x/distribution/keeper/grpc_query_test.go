@@ -1,18 +1,29 @@
 package keeper_test
 
 import (
+	"bytes"
+	"context"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
 	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/distribution/keeper"
 	distrtestutil "cosmossdk.io/x/distribution/testutil"
 	"cosmossdk.io/x/distribution/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func TestQueryParams(t *testing.T) {
@@ -51,46 +62,889 @@ func TestQueryParams(t *testing.T) {
 	}
 }
 
+func TestQueryAllocationParams(t *testing.T) {
+	ctx, _, distrKeeper, _ := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	cases := []struct {
+		name              string
+		preRun            func() types.Params
+		req               *types.QueryAllocationParamsRequest
+		expProposerReward math.LegacyDec
+		errMsg            string
+	}{
+		{
+			name:   "nil request",
+			preRun: types.DefaultParams,
+			errMsg: "invalid request",
+		},
+		{
+			name:              "default params",
+			preRun:            types.DefaultParams,
+			req:               &types.QueryAllocationParamsRequest{},
+			expProposerReward: math.LegacyZeroDec(),
+		},
+		{
+			name: "all rates set",
+			preRun: func() types.Params {
+				params := types.DefaultParams()
+				params.CommunityTax = math.LegacyNewDecWithPrec(10, 2)
+				params.BaseProposerReward = math.LegacyNewDecWithPrec(1, 2)
+				params.BonusProposerReward = math.LegacyNewDecWithPrec(4, 2)
+				params.BurnRate = math.LegacyNewDecWithPrec(5, 2)
+				params.InfraFundRate = math.LegacyNewDecWithPrec(2, 2)
+				params.InfraFundModule = "infra"
+				params.CommissionRebateRate = math.LegacyNewDecWithPrec(50, 2)
+				return params
+			},
+			req:               &types.QueryAllocationParamsRequest{},
+			expProposerReward: math.LegacyNewDecWithPrec(5, 2),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := tc.preRun()
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+			out, err := queryServer.AllocationParams(ctx, tc.req)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				require.Nil(t, out)
+				return
+			}
+			require.NoError(t, err)
+
+			communityTax, err := distrKeeper.GetCommunityTax(ctx)
+			require.NoError(t, err)
+			require.Equal(t, communityTax, out.CommunityTax)
+			require.Equal(t, math.LegacyOneDec().Sub(communityTax).Sub(tc.expProposerReward), out.VoteMultiplier)
+
+			require.Equal(t, params.BaseProposerReward, out.BaseProposerReward)
+			require.Equal(t, params.BonusProposerReward, out.BonusProposerReward)
+			require.Equal(t, params.BurnRate, out.BurnRate)
+			require.Equal(t, params.InfraFundRate, out.InfraFundRate)
+			require.Equal(t, params.InfraFundModule, out.InfraFundModule)
+			require.Equal(t, params.CommissionRebateRate, out.CommissionRebateRate)
+		})
+	}
+}
+
 func TestQueryValidatorDistributionInfo(t *testing.T) {
 	ctx, addrs, distrKeeper, dep := initFixture(t)
 	queryServer := keeper.NewQuerier(distrKeeper)
 
-	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	del := stakingtypes.NewDelegation(addrs[0].String(), val.OperatorAddress, val.DelegatorShares)
+
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).Return(val, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), gomock.Any(), gomock.Any()).Return(del, nil).AnyTimes()
+
+	cases := []struct {
+		name   string
+		req    *types.QueryValidatorDistributionInfoRequest
+		resp   *types.QueryValidatorDistributionInfoResponse
+		errMsg string
+	}{
+		{
+			name: "invalid validator address",
+			req: &types.QueryValidatorDistributionInfoRequest{
+				ValidatorAddress: "invalid address",
+			},
+			resp:   &types.QueryValidatorDistributionInfoResponse{},
+			errMsg: "decoding bech32 failed",
+		},
+		{
+			name: "not a validator",
+			req: &types.QueryValidatorDistributionInfoRequest{
+				ValidatorAddress: addrs[0].String(),
+			},
+			resp:   &types.QueryValidatorDistributionInfoResponse{},
+			errMsg: `expected 'cosmosvaloper' got 'cosmos'`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := queryServer.ValidatorDistributionInfo(ctx, tc.req)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.resp, out)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				require.Nil(t, out)
+			}
+		})
+	}
+}
+
+func TestQueryValidatorOutstandingRewards(t *testing.T) {
+	// TODO https://github.com/cosmos/cosmos-sdk/issues/16757
+	// currently tested in tests/e2e/distribution/grpc_query_suite.go
+}
+
+func TestQueryValidatorCommission(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+	require.NoError(t, err)
+
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr0)).Return(val0, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr1)).Return(val1, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr2)).Return(nil, nil).AnyTimes()
+
+	commission := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(155, 1)}}
+	require.NoError(t, distrKeeper.ValidatorsAccumulatedCommission.Set(ctx, sdk.ValAddress(valConsAddr0), types.ValidatorAccumulatedCommission{Commission: commission}))
+
+	cases := []struct {
+		name   string
+		req    *types.QueryValidatorCommissionRequest
+		resp   *types.QueryValidatorCommissionResponse
+		errMsg string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			errMsg: "invalid request",
+		},
+		{
+			name:   "empty validator address",
+			req:    &types.QueryValidatorCommissionRequest{},
+			errMsg: "empty validator address",
+		},
+		{
+			name: "not a validator",
+			req: &types.QueryValidatorCommissionRequest{
+				ValidatorAddress: addrs[0].String(),
+			},
+			errMsg: `expected 'cosmosvaloper' got 'cosmos'`,
+		},
+		{
+			name: "validator does not exist",
+			req: &types.QueryValidatorCommissionRequest{
+				ValidatorAddress: sdk.ValAddress(valConsAddr2).String(),
+			},
+			errMsg: types.ErrNoValidatorExists.Error(),
+		},
+		{
+			name: "success",
+			req: &types.QueryValidatorCommissionRequest{
+				ValidatorAddress: val0.GetOperator(),
+			},
+			resp: &types.QueryValidatorCommissionResponse{
+				Commission: types.ValidatorAccumulatedCommission{Commission: commission},
+			},
+		},
+		{
+			name: "no commission accrued",
+			req: &types.QueryValidatorCommissionRequest{
+				ValidatorAddress: val1.GetOperator(),
+			},
+			resp: &types.QueryValidatorCommissionResponse{},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := queryServer.ValidatorCommission(ctx, tc.req)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.resp, out)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				require.Nil(t, out)
+			}
+		})
+	}
+}
+
+func TestQueryValidatorCurrentRewards(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+	require.NoError(t, err)
+
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr0)).Return(val0, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr1)).Return(val1, nil).AnyTimes()
+
+	rewards := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(155, 1)}}
+	require.NoError(t, distrKeeper.ValidatorCurrentRewards.Set(ctx, sdk.ValAddress(valConsAddr0), types.NewValidatorCurrentRewards(rewards, 3)))
+
+	cases := []struct {
+		name   string
+		req    *types.QueryValidatorCurrentRewardsRequest
+		resp   *types.QueryValidatorCurrentRewardsResponse
+		errMsg string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			errMsg: "invalid request",
+		},
+		{
+			name:   "empty validator address",
+			req:    &types.QueryValidatorCurrentRewardsRequest{},
+			errMsg: "empty validator address",
+		},
+		{
+			name: "not a validator",
+			req: &types.QueryValidatorCurrentRewardsRequest{
+				ValidatorAddress: addrs[0].String(),
+			},
+			errMsg: `expected 'cosmosvaloper' got 'cosmos'`,
+		},
+		{
+			name: "success",
+			req: &types.QueryValidatorCurrentRewardsRequest{
+				ValidatorAddress: val0.GetOperator(),
+			},
+			resp: &types.QueryValidatorCurrentRewardsResponse{
+				Rewards: rewards,
+				Period:  3,
+			},
+		},
+		{
+			name: "no rewards yet",
+			req: &types.QueryValidatorCurrentRewardsRequest{
+				ValidatorAddress: val1.GetOperator(),
+			},
+			resp: &types.QueryValidatorCurrentRewardsResponse{},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := queryServer.ValidatorCurrentRewards(ctx, tc.req)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.resp, out)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				require.Nil(t, out)
+			}
+		})
+	}
+}
+
+func TestQueryValidatorRewardsFrozen(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	require.NoError(t, distrKeeper.SetValidatorRewardsFrozen(ctx, sdk.ValAddress(valConsAddr0), true))
+
+	cases := []struct {
+		name   string
+		req    *types.QueryValidatorRewardsFrozenRequest
+		resp   *types.QueryValidatorRewardsFrozenResponse
+		errMsg string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			errMsg: "invalid request",
+		},
+		{
+			name:   "empty validator address",
+			req:    &types.QueryValidatorRewardsFrozenRequest{},
+			errMsg: "empty validator address",
+		},
+		{
+			name: "not a validator",
+			req: &types.QueryValidatorRewardsFrozenRequest{
+				ValidatorAddress: addrs[0].String(),
+			},
+			errMsg: `expected 'cosmosvaloper' got 'cosmos'`,
+		},
+		{
+			name: "frozen",
+			req: &types.QueryValidatorRewardsFrozenRequest{
+				ValidatorAddress: sdk.ValAddress(valConsAddr0).String(),
+			},
+			resp: &types.QueryValidatorRewardsFrozenResponse{Frozen: true},
+		},
+		{
+			name: "not frozen",
+			req: &types.QueryValidatorRewardsFrozenRequest{
+				ValidatorAddress: sdk.ValAddress(valConsAddr1).String(),
+			},
+			resp: &types.QueryValidatorRewardsFrozenResponse{Frozen: false},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := queryServer.ValidatorRewardsFrozen(ctx, tc.req)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.resp, out)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				require.Nil(t, out)
+			}
+		})
+	}
+}
+
+func TestQueryValidatorRewardMultiplier(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	bonus := math.LegacyNewDecWithPrec(12, 1)
+	require.NoError(t, distrKeeper.SetValidatorRewardMultiplier(ctx, sdk.ValAddress(valConsAddr0), bonus))
+
+	cases := []struct {
+		name   string
+		req    *types.QueryValidatorRewardMultiplierRequest
+		resp   *types.QueryValidatorRewardMultiplierResponse
+		errMsg string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			errMsg: "invalid request",
+		},
+		{
+			name:   "empty validator address",
+			req:    &types.QueryValidatorRewardMultiplierRequest{},
+			errMsg: "empty validator address",
+		},
+		{
+			name: "not a validator",
+			req: &types.QueryValidatorRewardMultiplierRequest{
+				ValidatorAddress: addrs[0].String(),
+			},
+			errMsg: `expected 'cosmosvaloper' got 'cosmos'`,
+		},
+		{
+			name: "multiplier set",
+			req: &types.QueryValidatorRewardMultiplierRequest{
+				ValidatorAddress: sdk.ValAddress(valConsAddr0).String(),
+			},
+			resp: &types.QueryValidatorRewardMultiplierResponse{Multiplier: bonus},
+		},
+		{
+			name: "default multiplier",
+			req: &types.QueryValidatorRewardMultiplierRequest{
+				ValidatorAddress: sdk.ValAddress(valConsAddr1).String(),
+			},
+			resp: &types.QueryValidatorRewardMultiplierResponse{Multiplier: math.LegacyOneDec()},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := queryServer.ValidatorRewardMultiplier(ctx, tc.req)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.resp, out)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				require.Nil(t, out)
+			}
+		})
+	}
+}
+
+func TestQueryValidatorCommissionWithdrawals(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	valAddr := sdk.ValAddress(valConsAddr0)
+	withdrawals := make([]types.CommissionWithdrawal, 0, 3)
+	for _, height := range []uint64{2, 5, 9} {
+		withdrawal := types.CommissionWithdrawal{Height: height, Amount: sdk.NewCoins(sdk.NewCoin("stake", math.NewIntFromUint64(height)))}
+		require.NoError(t, distrKeeper.CommissionWithdrawals.Set(ctx, collections.Join(valAddr, height), withdrawal))
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	// the withdrawals of another validator are not returned
+	require.NoError(t, distrKeeper.CommissionWithdrawals.Set(ctx, collections.Join(sdk.ValAddress(valConsAddr1), uint64(3)), types.CommissionWithdrawal{Height: 3}))
+
+	cases := []struct {
+		name   string
+		req    *types.QueryValidatorCommissionWithdrawalsRequest
+		resp   []types.CommissionWithdrawal
+		errMsg string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			errMsg: "invalid request",
+		},
+		{
+			name:   "empty validator address",
+			req:    &types.QueryValidatorCommissionWithdrawalsRequest{},
+			errMsg: "empty validator address",
+		},
+		{
+			name: "invalid validator address",
+			req: &types.QueryValidatorCommissionWithdrawalsRequest{
+				ValidatorAddress: addrs[0].String(),
+			},
+			errMsg: "invalid validator address",
+		},
+		{
+			name: "no withdrawals",
+			req: &types.QueryValidatorCommissionWithdrawalsRequest{
+				ValidatorAddress: sdk.ValAddress(valConsAddr2).String(),
+			},
+			resp: nil,
+		},
+		{
+			name: "all withdrawals",
+			req: &types.QueryValidatorCommissionWithdrawalsRequest{
+				ValidatorAddress: valAddr.String(),
+			},
+			resp: withdrawals,
+		},
+		{
+			name: "paginated",
+			req: &types.QueryValidatorCommissionWithdrawalsRequest{
+				ValidatorAddress: valAddr.String(),
+				Pagination:       &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			resp: withdrawals[1:2],
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := queryServer.ValidatorCommissionWithdrawals(ctx, tc.req)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.resp, out.Withdrawals)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				require.Nil(t, out)
+			}
+		})
+	}
+}
+
+func TestQueryValidatorHistoricalRewards(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	valAddr := sdk.ValAddress(valConsAddr0)
+	valAddrStr := val.GetOperator()
+
+	// periods 1 to 3 and 5 are stored, period 2 referenced by two
+	// delegations, the pruned period 4 is missing
+	ratios := make(map[uint64]sdk.DecCoins)
+	for period, referenceCount := range map[uint64]uint32{1: 1, 2: 2, 3: 1, 5: 1} {
+		ratios[period] = sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(int64(period)*25, 1)}}
+		require.NoError(t, distrKeeper.ValidatorHistoricalRewards.Set(ctx, collections.Join(valAddr, period), types.NewValidatorHistoricalRewards(ratios[period], referenceCount)))
+	}
+
+	cases := []struct {
+		name   string
+		req    *types.QueryValidatorHistoricalRewardsRequest
+		resp   *types.QueryValidatorHistoricalRewardsResponse
+		errMsg string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			errMsg: "invalid request",
+		},
+		{
+			name:   "empty validator address",
+			req:    &types.QueryValidatorHistoricalRewardsRequest{Period: 1},
+			errMsg: "empty validator address",
+		},
+		{
+			name: "not a validator",
+			req: &types.QueryValidatorHistoricalRewardsRequest{
+				ValidatorAddress: addrs[0].String(),
+				Period:           1,
+			},
+			errMsg: `expected 'cosmosvaloper' got 'cosmos'`,
+		},
+		{
+			name: "first period",
+			req: &types.QueryValidatorHistoricalRewardsRequest{
+				ValidatorAddress: valAddrStr,
+				Period:           1,
+			},
+			resp: &types.QueryValidatorHistoricalRewardsResponse{
+				Rewards: types.NewValidatorHistoricalRewards(ratios[1], 1),
+			},
+		},
+		{
+			name: "period referenced by several delegations",
+			req: &types.QueryValidatorHistoricalRewardsRequest{
+				ValidatorAddress: valAddrStr,
+				Period:           2,
+			},
+			resp: &types.QueryValidatorHistoricalRewardsResponse{
+				Rewards: types.NewValidatorHistoricalRewards(ratios[2], 2),
+			},
+		},
+		{
+			name: "period after a pruned one",
+			req: &types.QueryValidatorHistoricalRewardsRequest{
+				ValidatorAddress: valAddrStr,
+				Period:           5,
+			},
+			resp: &types.QueryValidatorHistoricalRewardsResponse{
+				Rewards: types.NewValidatorHistoricalRewards(ratios[5], 1),
+			},
+		},
+		{
+			name: "pruned period",
+			req: &types.QueryValidatorHistoricalRewardsRequest{
+				ValidatorAddress: valAddrStr,
+				Period:           4,
+			},
+			errMsg: "no historical rewards for validator",
+		},
+		{
+			name: "period not reached yet",
+			req: &types.QueryValidatorHistoricalRewardsRequest{
+				ValidatorAddress: valAddrStr,
+				Period:           6,
+			},
+			errMsg: "no historical rewards for validator",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := queryServer.ValidatorHistoricalRewards(ctx, tc.req)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.resp, out)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				require.Nil(t, out)
+			}
+		})
+	}
+
+	// pruned periods are reported with the gRPC not found code
+	_, err = queryServer.ValidatorHistoricalRewards(ctx, &types.QueryValidatorHistoricalRewardsRequest{ValidatorAddress: valAddrStr, Period: 4})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestQueryValidatorEstimatedRewardRate(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	disabledQueryServer := keeper.NewQuerier(distrKeeper)
+	distrKeeper.SetRewardSampleBlocks(100)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	// a bonded validator with a 10% commission
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(1000))
+	require.NoError(t, err)
+	val0.Status = stakingtypes.Bonded
+	val0.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyOneDec(), math.LegacyZeroDec())
+	// an unbonded validator
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(1000))
+	require.NoError(t, err)
+
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr0)).Return(val0, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr1)).Return(val1, nil).AnyTimes()
+
+	// synthetic reward history: 10stake per block over the blocks 101 to 110,
+	// one every 5 seconds, and an allocation outside of the lookback window
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stake := func(amount int64) sdk.DecCoins {
+		return sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(amount))}
+	}
+	decStake := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr(amount))}
+	}
+	for _, valAddr := range []sdk.ValAddress{sdk.ValAddress(valConsAddr0), sdk.ValAddress(valConsAddr1)} {
+		require.NoError(t, distrKeeper.ValidatorRewardSamples.Set(ctx, collections.Join(valAddr, uint64(50)), types.ValidatorRewardSample{
+			Rewards: stake(1_000_000),
+			Time:    start.Add(-time.Hour),
+		}))
+		for height := uint64(101); height <= 110; height++ {
+			require.NoError(t, distrKeeper.ValidatorRewardSamples.Set(ctx, collections.Join(valAddr, height), types.ValidatorRewardSample{
+				Rewards: stake(10),
+				Time:    start.Add(time.Duration(height-101) * 5 * time.Second),
+			}))
+		}
+	}
+	ctx = ctx.WithBlockHeight(110)
+
+	cases := []struct {
+		name   string
+		ctx    sdk.Context
+		server types.QueryServer
+		req    *types.QueryValidatorEstimatedRewardRateRequest
+		resp   *types.QueryValidatorEstimatedRewardRateResponse
+		errMsg string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			errMsg: "invalid request",
+		},
+		{
+			name:   "empty validator address",
+			req:    &types.QueryValidatorEstimatedRewardRateRequest{LookbackBlocks: 10},
+			errMsg: "empty validator address",
+		},
+		{
+			name:   "sampling disabled",
+			server: disabledQueryServer,
+			req:    &types.QueryValidatorEstimatedRewardRateRequest{ValidatorAddress: val0.GetOperator(), LookbackBlocks: 10},
+			errMsg: "reward sampling is disabled",
+		},
+		{
+			name:   "no lookback",
+			req:    &types.QueryValidatorEstimatedRewardRateRequest{ValidatorAddress: val0.GetOperator()},
+			errMsg: "lookback blocks must be between 1 and 100",
+		},
+		{
+			name:   "lookback above the sampled blocks",
+			req:    &types.QueryValidatorEstimatedRewardRateRequest{ValidatorAddress: val0.GetOperator(), LookbackBlocks: 101},
+			errMsg: "lookback blocks must be between 1 and 100",
+		},
+		{
+			name:   "not a validator",
+			req:    &types.QueryValidatorEstimatedRewardRateRequest{ValidatorAddress: addrs[0].String(), LookbackBlocks: 10},
+			errMsg: `expected 'cosmosvaloper' got 'cosmos'`,
+		},
+		{
+			// the 10 blocks last 50 seconds, 630720 times in a year
+			name: "success",
+			req:  &types.QueryValidatorEstimatedRewardRateRequest{ValidatorAddress: val0.GetOperator(), LookbackBlocks: 10},
+			resp: &types.QueryValidatorEstimatedRewardRateResponse{
+				Rewards:             stake(100),
+				Blocks:              10,
+				BondedTokens:        math.NewInt(1000),
+				RewardRate:          decStake("63072"),
+				DelegatorRewardRate: decStake("56764.8"),
+			},
+		},
+		{
+			// the blocks without a sample are counted, their rewards are zero
+			name: "lookback longer than the samples",
+			req:  &types.QueryValidatorEstimatedRewardRateRequest{ValidatorAddress: val0.GetOperator(), LookbackBlocks: 20},
+			resp: &types.QueryValidatorEstimatedRewardRateResponse{
+				Rewards:             stake(100),
+				Blocks:              20,
+				BondedTokens:        math.NewInt(1000),
+				RewardRate:          decStake("31536"),
+				DelegatorRewardRate: decStake("28382.4"),
+			},
+		},
+		{
+			name: "chain younger than the lookback",
+			ctx:  ctx.WithBlockHeight(60),
+			req:  &types.QueryValidatorEstimatedRewardRateRequest{ValidatorAddress: val0.GetOperator(), LookbackBlocks: 100},
+			resp: &types.QueryValidatorEstimatedRewardRateResponse{
+				Rewards:             stake(1_000_000),
+				Blocks:              60,
+				BondedTokens:        math.NewInt(1000),
+				RewardRate:          sdk.DecCoins{},
+				DelegatorRewardRate: sdk.DecCoins{},
+			},
+		},
+		{
+			name: "single sample",
+			ctx:  ctx.WithBlockHeight(101),
+			req:  &types.QueryValidatorEstimatedRewardRateRequest{ValidatorAddress: val0.GetOperator(), LookbackBlocks: 1},
+			resp: &types.QueryValidatorEstimatedRewardRateResponse{
+				Rewards:             stake(10),
+				Blocks:              1,
+				BondedTokens:        math.NewInt(1000),
+				RewardRate:          sdk.DecCoins{},
+				DelegatorRewardRate: sdk.DecCoins{},
+			},
+		},
+		{
+			name: "unbonded validator",
+			req:  &types.QueryValidatorEstimatedRewardRateRequest{ValidatorAddress: val1.GetOperator(), LookbackBlocks: 10},
+			resp: &types.QueryValidatorEstimatedRewardRateResponse{
+				Rewards:             stake(100),
+				Blocks:              10,
+				BondedTokens:        math.ZeroInt(),
+				RewardRate:          sdk.DecCoins{},
+				DelegatorRewardRate: sdk.DecCoins{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			queryCtx, server := ctx, types.QueryServer(queryServer)
+			if tc.ctx.Context() != nil {
+				queryCtx = tc.ctx
+			}
+			if tc.server != nil {
+				server = tc.server
+			}
+
+			out, err := server.ValidatorEstimatedRewardRate(queryCtx, tc.req)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.resp, out)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				require.Nil(t, out)
+			}
+		})
+	}
+}
+
+func TestQueryDelegationRewardsProjection(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	disabledQueryServer := keeper.NewQuerier(distrKeeper)
+	distrKeeper.SetRewardSampleBlocks(10)
+	queryServer := keeper.NewQuerier(distrKeeper)
+	delAddr := addrs[0]
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+
+	// a validator with a 10% commission, the delegator owns half of its shares
+	valAddr := sdk.ValAddress(valConsAddr0)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(1000))
 	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyOneDec(), math.LegacyZeroDec())
+	del := stakingtypes.NewDelegation(delAddr.String(), val.GetOperator(), val.DelegatorShares.QuoInt64(2))
 
-	del := stakingtypes.NewDelegation(addrs[0].String(), val.OperatorAddress, val.DelegatorShares)
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, valAddr).Return(del, nil).AnyTimes()
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, delAddr, valAddr))
 
-	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).Return(val, nil).AnyTimes()
-	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), gomock.Any(), gomock.Any()).Return(del, nil).AnyTimes()
+	stake := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr(amount))}
+	}
+
+	// 20stake already allocated to the validator, 18stake after the commission
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, stake("20")))
+
+	// synthetic reward history: 10stake per block over the blocks 101 to 110,
+	// and an allocation outside of the sampled blocks
+	require.NoError(t, distrKeeper.ValidatorRewardSamples.Set(ctx, collections.Join(valAddr, uint64(50)), types.ValidatorRewardSample{
+		Rewards: stake("1000000"),
+	}))
+	for height := uint64(101); height <= 110; height++ {
+		require.NoError(t, distrKeeper.ValidatorRewardSamples.Set(ctx, collections.Join(valAddr, height), types.ValidatorRewardSample{
+			Rewards: stake("10"),
+		}))
+	}
+	ctx = ctx.WithBlockHeight(110)
 
 	cases := []struct {
 		name   string
-		req    *types.QueryValidatorDistributionInfoRequest
-		resp   *types.QueryValidatorDistributionInfoResponse
+		ctx    sdk.Context
+		server types.QueryServer
+		req    *types.QueryDelegationRewardsProjectionRequest
+		resp   *types.QueryDelegationRewardsProjectionResponse
 		errMsg string
 	}{
 		{
-			name: "invalid validator address",
-			req: &types.QueryValidatorDistributionInfoRequest{
-				ValidatorAddress: "invalid address",
+			name:   "nil request",
+			req:    nil,
+			errMsg: "invalid request",
+		},
+		{
+			name:   "empty delegator address",
+			req:    &types.QueryDelegationRewardsProjectionRequest{ValidatorAddress: val.GetOperator(), Blocks: 10},
+			errMsg: "empty delegator address",
+		},
+		{
+			name:   "empty validator address",
+			req:    &types.QueryDelegationRewardsProjectionRequest{DelegatorAddress: delAddr.String(), Blocks: 10},
+			errMsg: "empty validator address",
+		},
+		{
+			name:   "sampling disabled",
+			server: disabledQueryServer,
+			req:    &types.QueryDelegationRewardsProjectionRequest{DelegatorAddress: delAddr.String(), ValidatorAddress: val.GetOperator(), Blocks: 10},
+			errMsg: "reward sampling is disabled",
+		},
+		{
+			// 10stake per block, 9stake after the commission, half of it for
+			// the delegator
+			name: "success",
+			req:  &types.QueryDelegationRewardsProjectionRequest{DelegatorAddress: delAddr.String(), ValidatorAddress: val.GetOperator(), Blocks: 100},
+			resp: &types.QueryDelegationRewardsProjectionResponse{
+				Rewards:                  stake("9"),
+				EstimatedRewardsPerBlock: stake("4.5"),
+				EstimatedRewards:         stake("459"),
+				SampledBlocks:            10,
 			},
-			resp:   &types.QueryValidatorDistributionInfoResponse{},
-			errMsg: "decoding bech32 failed",
 		},
 		{
-			name: "not a validator",
-			req: &types.QueryValidatorDistributionInfoRequest{
-				ValidatorAddress: addrs[0].String(),
+			name: "current block",
+			req:  &types.QueryDelegationRewardsProjectionRequest{DelegatorAddress: delAddr.String(), ValidatorAddress: val.GetOperator()},
+			resp: &types.QueryDelegationRewardsProjectionResponse{
+				Rewards:                  stake("9"),
+				EstimatedRewardsPerBlock: stake("4.5"),
+				EstimatedRewards:         stake("9"),
+				SampledBlocks:            10,
+			},
+		},
+		{
+			// the blocks without a sample are counted, their rewards are zero
+			name: "partially sampled blocks",
+			ctx:  ctx.WithBlockHeight(105),
+			req:  &types.QueryDelegationRewardsProjectionRequest{DelegatorAddress: delAddr.String(), ValidatorAddress: val.GetOperator(), Blocks: 10},
+			resp: &types.QueryDelegationRewardsProjectionResponse{
+				Rewards:                  stake("9"),
+				EstimatedRewardsPerBlock: stake("2.25"),
+				EstimatedRewards:         stake("31.5"),
+				SampledBlocks:            10,
+			},
+		},
+		{
+			// only the 20stake allocation of the block 2 is sampled
+			name: "chain younger than the sampled blocks",
+			ctx:  ctx.WithBlockHeight(5),
+			req:  &types.QueryDelegationRewardsProjectionRequest{DelegatorAddress: delAddr.String(), ValidatorAddress: val.GetOperator(), Blocks: 10},
+			resp: &types.QueryDelegationRewardsProjectionResponse{
+				Rewards:                  stake("9"),
+				EstimatedRewardsPerBlock: stake("1.8"),
+				EstimatedRewards:         stake("27"),
+				SampledBlocks:            5,
 			},
-			resp:   &types.QueryValidatorDistributionInfoResponse{},
-			errMsg: `expected 'cosmosvaloper' got 'cosmos'`,
 		},
 	}
 
 	for _, tc := range cases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			out, err := queryServer.ValidatorDistributionInfo(ctx, tc.req)
+			queryCtx, server := ctx, types.QueryServer(queryServer)
+			if tc.ctx.Context() != nil {
+				queryCtx = tc.ctx
+			}
+			if tc.server != nil {
+				server = tc.server
+			}
+
+			out, err := server.DelegationRewardsProjection(queryCtx, tc.req)
 			if tc.errMsg == "" {
 				require.NoError(t, err)
 				require.Equal(t, tc.resp, out)
@@ -103,16 +957,6 @@ func TestQueryValidatorDistributionInfo(t *testing.T) {
 	}
 }
 
-func TestQueryValidatorOutstandingRewards(t *testing.T) {
-	// TODO https://github.com/cosmos/cosmos-sdk/issues/16757
-	// currently tested in tests/e2e/distribution/grpc_query_suite.go
-}
-
-func TestQueryValidatorCommission(t *testing.T) {
-	// TODO https://github.com/cosmos/cosmos-sdk/issues/16757
-	// currently tested in tests/e2e/distribution/grpc_query_suite.go
-}
-
 func TestQueryValidatorSlashes(t *testing.T) {
 	// TODO https://github.com/cosmos/cosmos-sdk/issues/16757
 	// currently tested in tests/e2e/distribution/grpc_query_suite.go
@@ -124,8 +968,65 @@ func TestQueryDelegationRewards(t *testing.T) {
 }
 
 func TestQueryDelegationTotalRewards(t *testing.T) {
-	// TODO https://github.com/cosmos/cosmos-sdk/issues/16757
-	// currently tested in tests/e2e/distribution/grpc_query_suite.go
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+	delAddr := addrs[0]
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+
+	// delegate to three validators
+	var delegations []stakingtypes.Delegation
+	var validators []stakingtypes.Validator
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2} {
+		valAddr := sdk.ValAddress(pk.Address())
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+
+		del := stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), val.DelegatorShares)
+		dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+		dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, valAddr).Return(del, nil).AnyTimes()
+		require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, delAddr, valAddr))
+
+		delegations = append(delegations, del)
+		validators = append(validators, val)
+	}
+	dep.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), delAddr, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) error {
+			for i, del := range delegations {
+				if fn(int64(i), del) {
+					break
+				}
+			}
+			return nil
+		},
+	).AnyTimes()
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate different rewards to every validator
+	for i, val := range validators {
+		tokens := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(int64(i+1)*105, 1))}
+		require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+	}
+
+	res, err := queryServer.DelegationTotalRewards(ctx, &types.QueryDelegationTotalRewardsRequest{DelegatorAddress: delAddr.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Rewards, len(validators))
+
+	// the total matches the sum of the rewards queried for every validator
+	sum := sdk.DecCoins{}
+	for i, val := range validators {
+		valRes, err := queryServer.DelegationRewards(ctx, &types.QueryDelegationRewardsRequest{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: val.GetOperator(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.NewDelegationDelegatorReward(val.GetOperator(), valRes.Rewards), res.Rewards[i])
+		sum = sum.Add(valRes.Rewards...)
+	}
+	require.Equal(t, sum, res.Total)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(63, 0))}, res.Total)
 }
 
 func TestQueryDelegatorValidators(t *testing.T) {
@@ -177,3 +1078,368 @@ func TestQueryCommunityPool(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryTotalCommunityFunds(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	coins := sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(20)), sdk.NewCoin("stake", math.NewInt(100)))
+	dep.poolKeeper.EXPECT().GetCommunityPool(gomock.Any()).Return(coins, nil).AnyTimes()
+
+	decimalPool := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("photon", math.LegacyNewDecWithPrec(5, 1)),
+		sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(25, 2)),
+	}
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.FeePool{DecimalPool: decimalPool}))
+
+	_, err := queryServer.TotalCommunityFunds(ctx, nil)
+	require.Error(t, err)
+
+	out, err := queryServer.TotalCommunityFunds(ctx, &types.QueryTotalCommunityFundsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(coins...), out.CommunityPool)
+	require.Equal(t, decimalPool, out.DecimalPool)
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoin("atom", math.NewInt(20)),
+		sdk.NewDecCoinFromDec("photon", math.LegacyNewDecWithPrec(5, 1)),
+		sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("100.25")),
+	}, out.Total)
+}
+
+func TestQueryTotalOutstandingRewards(t *testing.T) {
+	ctx, _, distrKeeper, _ := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	_, err := queryServer.TotalOutstandingRewards(ctx, nil)
+	require.Error(t, err)
+
+	// no validator has outstanding rewards yet
+	out, err := queryServer.TotalOutstandingRewards(ctx, &types.QueryTotalOutstandingRewardsRequest{})
+	require.NoError(t, err)
+	require.True(t, out.Rewards.IsZero())
+
+	seeded := []sdk.DecCoins{
+		{sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("10.5"))},
+		{sdk.NewDecCoin("atom", math.NewInt(3)), sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.25"))},
+		{sdk.NewDecCoinFromDec("photon", math.LegacyNewDecWithPrec(1, math.LegacyPrecision))},
+	}
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(valConsAddr0), sdk.ValAddress(valConsAddr1), sdk.ValAddress(valConsAddr2)}
+
+	var expected sdk.DecCoins
+	for i, rewards := range seeded {
+		valAddr := valAddrs[i]
+		require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: rewards}))
+		expected = expected.Add(rewards...)
+	}
+
+	out, err = queryServer.TotalOutstandingRewards(ctx, &types.QueryTotalOutstandingRewardsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, expected, out.Rewards)
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoin("atom", math.NewInt(3)),
+		sdk.NewDecCoinFromDec("photon", math.LegacyNewDecWithPrec(1, math.LegacyPrecision)),
+		sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("10.75")),
+	}, out.Rewards)
+	require.Equal(t, distrKeeper.GetTotalRewards(ctx), out.Rewards)
+}
+
+func TestQueryValidatorsWithOutstandingRewards(t *testing.T) {
+	ctx, _, distrKeeper, _ := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	_, err := queryServer.ValidatorsWithOutstandingRewards(ctx, nil)
+	require.ErrorContains(t, err, "invalid request")
+
+	// no validator has outstanding rewards yet
+	out, err := queryServer.ValidatorsWithOutstandingRewards(ctx, &types.QueryValidatorsWithOutstandingRewardsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, out.Rewards)
+
+	// the validators with zero outstanding rewards are skipped
+	seeded := []sdk.DecCoins{
+		{sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("10.5"))},
+		{},
+		{sdk.NewDecCoin("atom", math.NewInt(3)), sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.25"))},
+		{},
+		{sdk.NewDecCoinFromDec("photon", math.LegacyNewDecWithPrec(1, math.LegacyPrecision))},
+	}
+
+	var expected []types.ValidatorOutstandingRewardsRecord
+	for i, rewards := range seeded {
+		valAddr := sdk.ValAddress(PKS[i].Address())
+		require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: rewards}))
+		if !rewards.IsZero() {
+			expected = append(expected, types.ValidatorOutstandingRewardsRecord{ValidatorAddress: valAddr.String(), OutstandingRewards: rewards})
+		}
+	}
+	// the records are ordered by operator address
+	sort.Slice(expected, func(i, j int) bool {
+		addrI, _ := sdk.ValAddressFromBech32(expected[i].ValidatorAddress)
+		addrJ, _ := sdk.ValAddressFromBech32(expected[j].ValidatorAddress)
+		return bytes.Compare(addrI, addrJ) < 0
+	})
+
+	out, err = queryServer.ValidatorsWithOutstandingRewards(ctx, &types.QueryValidatorsWithOutstandingRewardsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, expected, out.Rewards)
+	require.Equal(t, uint64(3), out.Pagination.Total)
+
+	// the pages hold the validators with non-zero outstanding rewards only
+	out, err = queryServer.ValidatorsWithOutstandingRewards(ctx, &types.QueryValidatorsWithOutstandingRewardsRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected[:2], out.Rewards)
+	require.NotNil(t, out.Pagination.NextKey)
+
+	out, err = queryServer.ValidatorsWithOutstandingRewards(ctx, &types.QueryValidatorsWithOutstandingRewardsRequest{
+		Pagination: &query.PageRequest{Key: out.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected[2:], out.Rewards)
+	require.Nil(t, out.Pagination.NextKey)
+}
+
+func TestQueryDecimalPool(t *testing.T) {
+	ctx, _, distrKeeper, _ := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	decCoins := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(25, 2))}
+
+	cases := []struct {
+		name    string
+		feePool types.FeePool
+		req     *types.QueryDecimalPoolRequest
+		resp    *types.QueryDecimalPoolResponse
+		errMsg  string
+	}{
+		{
+			name:    "nil request",
+			feePool: types.InitialFeePool(),
+			req:     nil,
+			errMsg:  "invalid request",
+		},
+		{
+			name:    "empty decimal pool",
+			feePool: types.InitialFeePool(),
+			req:     &types.QueryDecimalPoolRequest{},
+			resp:    &types.QueryDecimalPoolResponse{},
+		},
+		{
+			name:    "non-empty decimal pool",
+			feePool: types.FeePool{CommunityPool: sdk.DecCoins{}, DecimalPool: decCoins},
+			req:     &types.QueryDecimalPoolRequest{},
+			resp: &types.QueryDecimalPoolResponse{
+				Pool: decCoins,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, tc.feePool))
+
+			out, err := queryServer.DecimalPool(ctx, tc.req)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.resp, out)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				require.Nil(t, out)
+			}
+		})
+	}
+}
+
+func TestQueryFeePoolCommunityPool(t *testing.T) {
+	ctx, _, distrKeeper, _ := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	_, err := queryServer.FeePoolCommunityPool(ctx, nil)
+	require.ErrorContains(t, err, "invalid request")
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+	out, err := queryServer.FeePoolCommunityPool(ctx, &types.QueryFeePoolCommunityPoolRequest{})
+	require.NoError(t, err)
+	require.True(t, out.Pool.IsZero())
+
+	// the decimal pool is not part of the community pool
+	communityPool := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(1025, 2))}
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.FeePool{
+		CommunityPool: communityPool,
+		DecimalPool:   sdk.DecCoins{sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(25, 2))},
+	}))
+	out, err = queryServer.FeePoolCommunityPool(ctx, &types.QueryFeePoolCommunityPoolRequest{})
+	require.NoError(t, err)
+	require.Equal(t, communityPool, out.Pool)
+}
+
+func TestQueryLastBlockFeesDistributed(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+
+	_, err := queryServer.LastBlockFeesDistributed(ctx, nil)
+	require.ErrorContains(t, err, "invalid request")
+
+	// no allocation ran yet
+	out, err := queryServer.LastBlockFeesDistributed(ctx, &types.QueryLastBlockFeesDistributedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.BlockFees{}, out.Fees)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	dep.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val, nil).AnyTimes()
+	votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+
+	// the query reflects the allocation of the last block only
+	for i, amount := range []int64{100, 40} {
+		height := int64(10 + i)
+		ctx = ctx.WithBlockHeight(height)
+
+		fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(amount)))
+		dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+		dep.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", types.ModuleName, fees)
+		require.NoError(t, distrKeeper.AllocateTokens(ctx, 100, votes))
+
+		out, err := queryServer.LastBlockFeesDistributed(ctx, &types.QueryLastBlockFeesDistributedRequest{})
+		require.NoError(t, err)
+		require.Equal(t, types.BlockFees{
+			Height:          height,
+			FeesCollected:   fees,
+			FeesDistributed: sdk.NewDecCoinsFromCoins(fees...),
+		}, out.Fees)
+	}
+}
+
+func TestQuerySimulateAllocation(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+
+	// the hooks expect no call, none is made by a simulated allocation
+	distrKeeper.SetHooks(distrtestutil.NewMockDistributionHooks(gomock.NewController(t)))
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+
+	// two bonded validators with 10% commission and an unbonded one
+	var validators []stakingtypes.ValidatorI
+	for i, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2} {
+		val, err := distrtestutil.CreateValidator(pk, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
+		require.NoError(t, err)
+		val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDec(1), math.LegacyNewDec(0))
+		if i < 2 {
+			val.Status = stakingtypes.Bonded
+		}
+		validators = append(validators, val)
+
+		dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(sdk.GetConsAddress(pk))).Return(val, nil).AnyTimes()
+		dep.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+	}
+	dep.stakingKeeper.EXPECT().IterateValidators(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(int64, stakingtypes.ValidatorI) bool) error {
+			for i, val := range validators {
+				if fn(int64(i), val) {
+					break
+				}
+			}
+			return nil
+		}).AnyTimes()
+
+	unknownAddr := sdk.ValAddress("unknown_validator___")
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), unknownAddr).Return(nil, stakingtypes.ErrNoValidatorFound).AnyTimes()
+
+	valAddr0 := sdk.ValAddress(valConsAddr0).String()
+	valAddr1 := sdk.ValAddress(valConsAddr1).String()
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	cases := []struct {
+		name   string
+		req    *types.QuerySimulateAllocationRequest
+		resp   *types.QuerySimulateAllocationResponse
+		errMsg string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			errMsg: "invalid request",
+		},
+		{
+			name:   "invalid fees",
+			req:    &types.QuerySimulateAllocationRequest{Fees: sdk.Coins{{Denom: sdk.DefaultBondDenom, Amount: math.NewInt(-1)}}},
+			errMsg: "amount is not positive",
+		},
+		{
+			name: "unknown validator",
+			req: &types.QuerySimulateAllocationRequest{
+				Fees:  fees,
+				Votes: []types.SimulatedVote{{ValidatorAddress: unknownAddr.String(), Power: 100, Signed: true}},
+			},
+			errMsg: "validator does not exist",
+		},
+		{
+			name: "every bonded validator votes by default",
+			req:  &types.QuerySimulateAllocationRequest{Fees: fees},
+			resp: &types.QuerySimulateAllocationResponse{
+				Validators: []types.SimulatedValidatorAllocation{
+					{ValidatorAddress: valAddr0, Rewards: decCoins("49"), Commission: decCoins("4.9")},
+					{ValidatorAddress: valAddr1, Rewards: decCoins("49"), Commission: decCoins("4.9")},
+				},
+				CommunityPool: decCoins("2"),
+				DecimalPool:   sdk.DecCoins{},
+				Burned:        sdk.DecCoins{},
+				Subsidy:       sdk.DecCoins{},
+			},
+		},
+		{
+			name: "the share of a validator that does not vote is not allocated",
+			req: &types.QuerySimulateAllocationRequest{
+				Fees:  fees,
+				Votes: []types.SimulatedVote{{ValidatorAddress: valAddr0, Power: 100, Signed: true}},
+			},
+			resp: &types.QuerySimulateAllocationResponse{
+				Validators: []types.SimulatedValidatorAllocation{
+					{ValidatorAddress: valAddr0, Rewards: decCoins("49"), Commission: decCoins("4.9")},
+				},
+				CommunityPool: decCoins("2"),
+				DecimalPool:   decCoins("49"),
+				Burned:        sdk.DecCoins{},
+				Subsidy:       sdk.DecCoins{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := queryServer.SimulateAllocation(ctx, tc.req)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.resp, out)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				require.Nil(t, out)
+			}
+
+			// no state is persisted by the simulation
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.True(t, feePool.CommunityPool.IsZero())
+			require.True(t, feePool.DecimalPool.IsZero())
+
+			has, err := distrKeeper.ValidatorOutstandingRewards.Has(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.False(t, has)
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 
 	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
-	"cosmossdk.io/x/distribution/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -68,15 +67,15 @@ func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, val
 			return err
 		}
 
-		// add to validator account
+		// add to the commission withdraw address of the validator
 		if !coins.IsZero() {
-			accAddr := sdk.AccAddress(valAddr)
-			withdrawAddr, err := h.k.GetDelegatorWithdrawAddr(ctx, accAddr)
-			if err != nil {
+			if err := h.k.sendCommission(ctx, valAddr, coins); err != nil {
 				return err
 			}
-
-			if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins); err != nil {
+			if err := h.k.recordCommissionWithdrawal(ctx, valAddr, coins); err != nil {
+				return err
+			}
+			if err := h.k.recordLifetimeCommission(ctx, valAddr, coins); err != nil {
 				return err
 			}
 		}
@@ -108,6 +107,16 @@ func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, val
 		return err
 	}
 
+	// remove the commission withdraw address and the operator rewards
+	// address, which a validator created anew with the same operator must set
+	// again
+	if err := h.k.ValidatorsCommissionWithdrawAddress.Remove(ctx, valAddr); err != nil {
+		return err
+	}
+	if err := h.k.ValidatorsOperatorRewardsAddress.Remove(ctx, valAddr); err != nil {
+		return err
+	}
+
 	// clear slashes
 	err = h.k.ValidatorSlashEvents.Clear(ctx, collections.NewPrefixedTripleRange[sdk.ValAddress, uint64, uint64](valAddr))
 	if err != nil {
@@ -125,7 +134,24 @@ func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, val
 		return err
 	}
 
-	return nil
+	// clear reward samples
+	err = h.k.ValidatorRewardSamples.Clear(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, uint64](valAddr))
+	if err != nil {
+		return err
+	}
+
+	// forfeit escrowed rewards to the community pool
+	if _, err := h.k.ForfeitEscrowedRewards(ctx, valAddr); err != nil {
+		return err
+	}
+
+	// remove creation height
+	if err := h.k.ValidatorCreationHeights.Remove(ctx, valAddr); err != nil {
+		return err
+	}
+
+	// remove commission exempt denoms
+	return h.k.ValidatorCommissionExemptions.Remove(ctx, valAddr)
 }
 
 // increment period
@@ -151,7 +177,12 @@ func (h Hooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.A
 		return err
 	}
 
-	if _, err := h.k.withdrawDelegationRewards(ctx, val, del); err != nil {
+	send, err := h.k.delegationRewardsSender(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := h.k.withdrawDelegationRewards(ctx, val, del, send); err != nil {
 		return err
 	}
 
@@ -172,16 +203,41 @@ func (h Hooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) erro
 	return nil
 }
 
-func (h Hooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
-	return nil
+// release the rewards escrowed while the validator was jailed
+func (h Hooks) AfterValidatorBonded(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	_, err := h.k.ReleaseEscrowedRewards(ctx, valAddr)
+	return err
 }
 
 func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
-	return nil
+// remove the withdraw address with the last delegation of the delegator,
+// unless it is sticky
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	sticky, err := h.k.GetStickyWithdrawAddress(ctx)
+	if err != nil || sticky {
+		return err
+	}
+
+	valStr, err := h.k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	// the delegation being removed is still stored
+	delegations, err := h.k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+	for _, delegation := range delegations {
+		if delegation.ValidatorAddress != valStr {
+			return nil
+		}
+	}
+
+	return h.k.DelegatorsWithdrawAddress.Remove(ctx, delAddr)
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAfterValidatorRemovedCommission(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	commissionAddr := addrs[1]
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+	params := types.DefaultParams()
+	params.RecordLifetimeCommission = true
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.ValidatorsCommissionWithdrawAddress.Set(ctx, valAddr, commissionAddr))
+	require.NoError(t, distrKeeper.ValidatorsOperatorRewardsAddress.Set(ctx, valAddr, sdk.AccAddress("operations__________")))
+
+	commission := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("10.5")))
+	require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: commission}))
+	require.NoError(t, distrKeeper.ValidatorsAccumulatedCommission.Set(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission}))
+
+	// the remaining commission is paid to the commission withdraw address
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, commissionAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.NoError(t, distrKeeper.Hooks().AfterValidatorRemoved(ctx, nil, valAddr))
+
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.5"))), feePool.CommunityPool)
+
+	// the addresses set for the validator are removed with it
+	has, err := distrKeeper.ValidatorsCommissionWithdrawAddress.Has(ctx, valAddr)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = distrKeeper.ValidatorsOperatorRewardsAddress.Has(ctx, valAddr)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the commission paid counts towards the lifetime commission
+	lifetime, err := distrKeeper.ValidatorLifetimeCommission(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), lifetime)
+}
+
+func TestBeforeDelegationRemovedWithdrawAddress(t *testing.T) {
+	valAddr, otherValAddr := sdk.ValAddress("validator___________"), sdk.ValAddress("other_validator_____")
+
+	testCases := []struct {
+		name   string
+		sticky bool
+		// others are the validators of the other delegations of the delegator
+		others  []sdk.ValAddress
+		expKept bool
+	}{
+		{name: "last delegation", expKept: false},
+		{name: "last delegation, sticky", sticky: true, expKept: true},
+		{name: "other delegation left", others: []sdk.ValAddress{otherValAddr}, expKept: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, addrs, distrKeeper, dep := initFixture(t)
+			delAddr, withdrawAddr := addrs[0], addrs[1]
+
+			params := types.DefaultParams()
+			params.StickyWithdrawAddress = tc.sticky
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, delAddr, withdrawAddr))
+
+			// the delegation being removed is still stored when the hook is called
+			delegations := []stakingtypes.Delegation{stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), math.LegacyOneDec())}
+			for _, other := range tc.others {
+				delegations = append(delegations, stakingtypes.NewDelegation(delAddr.String(), other.String(), math.LegacyOneDec()))
+			}
+			dep.stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), delAddr).Return(delegations, nil).AnyTimes()
+
+			require.NoError(t, distrKeeper.Hooks().BeforeDelegationRemoved(ctx, delAddr, valAddr))
+
+			expAddr := delAddr
+			if tc.expKept {
+				expAddr = withdrawAddr
+			}
+			addr, err := distrKeeper.GetDelegatorWithdrawAddr(ctx, delAddr)
+			require.NoError(t, err)
+			require.Equal(t, expAddr, addr)
+		})
+	}
+}
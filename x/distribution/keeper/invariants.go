@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -20,6 +21,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ReferenceCountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "module-account",
 		ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "reward-conservation",
+		RewardConservationInvariant(k))
 }
 
 // AllInvariants runs all invariants of the distribution module
@@ -37,7 +40,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
-		return ModuleAccountInvariant(k)(ctx)
+		res, stop = ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return RewardConservationInvariant(k)(ctx)
 	}
 }
 
@@ -196,12 +203,29 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 			return sdk.FormatInvariant(types.ModuleName, "module account coins", err.Error()), true
 		}
 
+		err = k.ValidatorEscrowedRewards.Walk(ctx, nil, func(_ sdk.ValAddress, escrowed types.ValidatorEscrowedRewards) (stop bool, err error) {
+			expectedCoins = expectedCoins.Add(escrowed.Rewards...)
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module account coins", err.Error()), true
+		}
+
+		err = k.DelegatorCarriedRewards.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], carried types.DelegatorCarriedRewards) (stop bool, err error) {
+			expectedCoins = expectedCoins.Add(sdk.NewDecCoinsFromCoins(carried.Rewards...)...)
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module account coins", err.Error()), true
+		}
+
 		communityPool, err := k.FeePool.Get(ctx)
 		if err != nil {
 			panic(err)
 		}
 
-		expectedInt, _ := expectedCoins.Add(communityPool.CommunityPool...).TruncateDecimal()
+		expectedInt, _ := expectedCoins.Add(communityPool.CommunityPool...).Add(communityPool.DecimalPool...).
+			Add(communityPool.CarriedFees...).TruncateDecimal()
 
 		macc := k.GetDistributionAccount(ctx)
 		balances := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
@@ -216,3 +240,47 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// RewardConservationInvariant checks that the last allocation of fees conserved
+// tokens: the fees allocated must equal the sum of the changes of the
+// validators outstanding rewards, the community pool, the decimal pool and the
+// carried fees, plus the community pool cut routed to other modules
+func RewardConservationInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		record, err := k.LastAllocationRecord.Get(ctx)
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.FormatInvariant(types.ModuleName, "reward conservation", "no allocation recorded\n"), false
+		}
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "reward conservation", err.Error()), true
+		}
+
+		// compare the totals rather than the deltas, which may be negative
+		// when the decimal pool is flushed to the community pool
+		before := record.Fees.Add(record.OutstandingRewardsBefore...).Add(record.CommunityPoolBefore...).
+			Add(record.DecimalPoolBefore...).Add(record.EscrowedRewardsBefore...).Add(record.CarriedFeesBefore...)
+		after := record.OutstandingRewardsAfter.Add(record.CommunityPoolAfter...).
+			Add(record.DecimalPoolAfter...).Add(record.EscrowedRewardsAfter...).Add(record.Routed...).
+			Add(record.CarriedFeesAfter...)
+
+		broken := !before.Equal(after)
+		return sdk.FormatInvariant(
+			types.ModuleName, "reward conservation",
+			fmt.Sprintf("\tfees allocated:      %s\n"+
+				"\toutstanding rewards: %s -> %s\n"+
+				"\tcommunity pool:      %s -> %s\n"+
+				"\tdecimal pool:        %s -> %s\n"+
+				"\tescrowed rewards:    %s -> %s\n"+
+				"\trouted:              %s\n"+
+				"\tcarried fees:        %s -> %s\n",
+				record.Fees,
+				record.OutstandingRewardsBefore, record.OutstandingRewardsAfter,
+				record.CommunityPoolBefore, record.CommunityPoolAfter,
+				record.DecimalPoolBefore, record.DecimalPoolAfter,
+				record.EscrowedRewardsBefore, record.EscrowedRewardsAfter,
+				record.Routed,
+				record.CarriedFeesBefore, record.CarriedFeesAfter,
+			),
+		), broken
+	}
+}
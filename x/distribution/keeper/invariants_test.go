@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/distribution"
+	"cosmossdk.io/x/distribution/keeper"
+	distrtestutil "cosmossdk.io/x/distribution/testutil"
+	disttypes "cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestRewardConservationInvariant(t *testing.T) {
+	testCases := []struct {
+		name      string
+		leak      bool
+		expBroken bool
+	}{
+		{"allocation conserves tokens", false, false},
+		{"allocation mints rewards", true, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			// a faulty hook deliberately adds a unit of rewards to every
+			// validator out of thin air, breaking the conservation of tokens
+			if tc.leak {
+				hooks := distrtestutil.NewMockDistributionHooks(ctrl)
+				hooks.EXPECT().AfterValidatorRewardsAllocated(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, valAddr sdk.ValAddress, _ sdk.DecCoins) error {
+						outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+						if err != nil {
+							return err
+						}
+						outstanding.Rewards = outstanding.Rewards.Add(sdk.NewDecCoin(sdk.DefaultBondDenom, math.OneInt()))
+						return distrKeeper.ValidatorOutstandingRewards.Set(ctx, valAddr, outstanding)
+					},
+				).AnyTimes()
+				hooks.EXPECT().AfterCommunityPoolFunded(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				distrKeeper.SetHooks(hooks)
+			}
+
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			// no allocation was recorded yet
+			_, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+			require.False(t, broken)
+
+			// three validators with equal power, so that each share is
+			// truncated and a remainder is added to the decimal pool
+			votes := make([]comet.VoteInfo, 0, 3)
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2} {
+				val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+				require.NoError(t, err)
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+				votes = append(votes, comet.VoteInfo{Validator: comet.Validator{Address: pk.Address(), Power: 1}})
+			}
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			require.NoError(t, distrKeeper.AllocateTokens(ctx, 3, votes))
+
+			record, err := distrKeeper.LastAllocationRecord.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDecCoinsFromCoins(fees...), record.Fees)
+
+			msg, broken := keeper.RewardConservationInvariant(distrKeeper)(ctx)
+			require.Equal(t, tc.expBroken, broken, msg)
+		})
+	}
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 
@@ -11,6 +14,7 @@ import (
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/distribution/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -49,8 +53,97 @@ type Keeper struct {
 	PreviousProposer           collections.Item[sdk.ConsAddress]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// ValidatorsCommissionWithdrawAddress key: valAddr | value: withdrawAddr
+	ValidatorsCommissionWithdrawAddress collections.Map[sdk.ValAddress, sdk.AccAddress]
+	// DelegatorsWithdrawSplit key: delAddr | value: WithdrawSplit
+	DelegatorsWithdrawSplit collections.Map[sdk.AccAddress, types.WithdrawSplit]
+	// LastAllocationRecord records the last allocation for the reward conservation invariant
+	LastAllocationRecord collections.Item[types.AllocationRecord]
+	// ValidatorRewardSamples key: valAddr+height | value: ValidatorRewardSample
+	ValidatorRewardSamples collections.Map[collections.Pair[sdk.ValAddress, uint64], types.ValidatorRewardSample]
+	// ValidatorEscrowedRewards key: valAddr | value: ValidatorEscrowedRewards
+	ValidatorEscrowedRewards collections.Map[sdk.ValAddress, types.ValidatorEscrowedRewards]
+	// ValidatorCommissionCap key: valAddr | value: ValidatorCommissionCap
+	ValidatorCommissionCap collections.Map[sdk.ValAddress, types.ValidatorCommissionCap]
+	// DecimalPoolSamples key: height | value: DecimalPoolSample
+	DecimalPoolSamples collections.Map[uint64, types.DecimalPoolSample]
+	// DelegatorBlockWithdrawals key: delAddr | value: DelegatorBlockWithdrawal
+	DelegatorBlockWithdrawals collections.Map[sdk.AccAddress, types.DelegatorBlockWithdrawal]
+	// DelegatorCarriedRewards key: delAddr+valAddr | value: DelegatorCarriedRewards
+	DelegatorCarriedRewards collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], types.DelegatorCarriedRewards]
+	// ValidatorCreationHeights key: valAddr | value: ValidatorCreationHeight
+	ValidatorCreationHeights collections.Map[sdk.ValAddress, types.ValidatorCreationHeight]
+	// ValidatorCommissionExemptions key: valAddr | value: ValidatorCommissionExemption
+	ValidatorCommissionExemptions collections.Map[sdk.ValAddress, types.ValidatorCommissionExemption]
+	// FrozenValidators key: valAddr
+	FrozenValidators collections.KeySet[sdk.ValAddress]
+	// CommissionWithdrawals key: valAddr+height | value: CommissionWithdrawal
+	CommissionWithdrawals collections.Map[collections.Pair[sdk.ValAddress, uint64], types.CommissionWithdrawal]
+	// RebatedValidators key: valAddr
+	RebatedValidators collections.KeySet[sdk.ValAddress]
+	// FeeCollectorName is the name of the fee collector module account set by governance
+	FeeCollectorName collections.Item[string]
+	// ValidatorRewardMultiplier key: valAddr | value: ValidatorRewardMultiplier
+	ValidatorRewardMultiplier collections.Map[sdk.ValAddress, types.ValidatorRewardMultiplier]
+	// AllocationEpochPowers key: consAddr | value: AllocationEpochPower
+	AllocationEpochPowers collections.Map[sdk.ConsAddress, types.AllocationEpochPower]
+	// AllocationEpoch is the progress of the current allocation epoch
+	AllocationEpoch collections.Item[types.AllocationEpoch]
+	// LastBlockFees are the fees allocated by the last allocation
+	LastBlockFees collections.Item[types.BlockFees]
+	// ValidatorsOperatorRewardsAddress key: valAddr | value: rewardsAddr
+	ValidatorsOperatorRewardsAddress collections.Map[sdk.ValAddress, sdk.AccAddress]
+	// ValidatorsLifetimeCommission key: valAddr | value: ValidatorLifetimeCommission
+	ValidatorsLifetimeCommission collections.Map[sdk.ValAddress, types.ValidatorLifetimeCommission]
+	// CommunityTaxHistory key: height | value: CommunityTaxRecord
+	CommunityTaxHistory collections.Map[uint64, types.CommunityTaxRecord]
+	// CarriedRewardsPayoutQueue key: height+delAddr+valAddr
+	CarriedRewardsPayoutQueue collections.KeySet[collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress]]
 
-	feeCollectorName string // name of the FeeCollector ModuleAccount
+	feeCollectorNames []string // names of the FeeCollector ModuleAccounts
+
+	// aggregateRewardEvents replaces the per-validator allocation events with
+	// a single summary event per block
+	aggregateRewardEvents bool
+	// remainderStrategy controls where the truncation remainder of the
+	// validators' share is allocated
+	remainderStrategy types.RemainderStrategy
+	hooks             types.DistributionHooks
+	// votePowerTolerance is the relative deviation allowed between the total
+	// previous power and the sum of the bonded votes' powers, the check is
+	// disabled if it is nil
+	votePowerTolerance math.LegacyDec
+	// votePowerStrict makes a vote power mismatch fail the allocation instead
+	// of only being logged
+	votePowerStrict bool
+	// powerWeightFunc weights the voting powers the validators' shares are
+	// proportional to, the powers are used as is if it is nil
+	powerWeightFunc types.PowerWeightFunc
+	// powerSource supplies the decimal powers the validators' shares are
+	// proportional to instead of the powers of the votes, if it is set
+	powerSource types.PowerSource
+	// rewardSampleBlocks is the number of recent blocks the tokens allocated
+	// to every validator are recorded for, the sampling is disabled if zero
+	rewardSampleBlocks uint64
+	// decimalPoolSampleBlocks is the number of recent blocks the remainders
+	// added to the decimal pool are recorded for, the sampling is disabled if
+	// zero
+	decimalPoolSampleBlocks uint64
+	// tombstoneFunc reports whether a validator is tombstoned, the escrowed
+	// rewards are never forfeited by the allocation if it is nil
+	tombstoneFunc types.TombstoneFunc
+	// uptimeProvider provides the uptime factors the validators' rewards are
+	// scaled by, the rewards are not scaled if it is nil
+	uptimeProvider types.UptimeProvider
+	// rewardListeners are notified of every allocation of tokens to a
+	// validator
+	rewardListeners []types.RewardListener
+	// carriedPayoutsPerBlock is the maximum number of delegators whose
+	// carried rewards are paid out at the beginning of a block
+	carriedPayoutsPerBlock uint64
+	// shareWorkers is the maximum number of goroutines computing the shares
+	// of the validators at once, GOMAXPROCS if zero
+	shareWorkers int
 }
 
 // NewKeeper creates a new distribution Keeper instance
@@ -66,16 +159,16 @@ func NewKeeper(
 
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
-		storeService:     storeService,
-		cdc:              cdc,
-		authKeeper:       ak,
-		bankKeeper:       bk,
-		stakingKeeper:    sk,
-		poolKeeper:       pk,
-		feeCollectorName: feeCollectorName,
-		authority:        authority,
-		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		FeePool:          collections.NewItem(sb, types.FeePoolKey, "fee_pool", codec.CollValue[types.FeePool](cdc)),
+		storeService:      storeService,
+		cdc:               cdc,
+		authKeeper:        ak,
+		bankKeeper:        bk,
+		stakingKeeper:     sk,
+		poolKeeper:        pk,
+		feeCollectorNames: []string{feeCollectorName},
+		authority:         authority,
+		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FeePool:           collections.NewItem(sb, types.FeePoolKey, "fee_pool", codec.CollValue[types.FeePool](cdc)),
 		DelegatorsWithdrawAddress: collections.NewMap(
 			sb,
 			types.DelegatorWithdrawAddrPrefix,
@@ -127,6 +220,140 @@ func NewKeeper(
 			collections.TripleKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.Uint64Key, collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		ValidatorsCommissionWithdrawAddress: collections.NewMap(
+			sb,
+			types.ValidatorCommissionWithdrawAddrPrefix,
+			"validators_commission_withdraw_address",
+			sdk.ValAddressKey,
+			collcodec.KeyToValueCodec(sdk.AccAddressKey),
+		),
+		DelegatorsWithdrawSplit: collections.NewMap(
+			sb,
+			types.DelegatorWithdrawSplitPrefix,
+			"delegators_withdraw_split",
+			sdk.AccAddressKey,
+			codec.CollValue[types.WithdrawSplit](cdc),
+		),
+		LastAllocationRecord: collections.NewItem(sb, types.LastAllocationRecordKey, "last_allocation_record", codec.CollValue[types.AllocationRecord](cdc)),
+		ValidatorRewardSamples: collections.NewMap(
+			sb,
+			types.ValidatorRewardSamplePrefix,
+			"validator_reward_samples",
+			collections.PairKeyCodec(sdk.ValAddressKey, collections.Uint64Key),
+			codec.CollValue[types.ValidatorRewardSample](cdc),
+		),
+		ValidatorEscrowedRewards: collections.NewMap(
+			sb,
+			types.ValidatorEscrowedRewardsPrefix,
+			"validator_escrowed_rewards",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ValidatorEscrowedRewards](cdc),
+		),
+		ValidatorCommissionCap: collections.NewMap(
+			sb,
+			types.ValidatorCommissionCapPrefix,
+			"validator_commission_cap",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ValidatorCommissionCap](cdc),
+		),
+		DecimalPoolSamples: collections.NewMap(
+			sb,
+			types.DecimalPoolSamplePrefix,
+			"decimal_pool_samples",
+			collections.Uint64Key,
+			codec.CollValue[types.DecimalPoolSample](cdc),
+		),
+		DelegatorBlockWithdrawals: collections.NewMap(
+			sb,
+			types.DelegatorBlockWithdrawalPrefix,
+			"delegator_block_withdrawals",
+			sdk.AccAddressKey,
+			codec.CollValue[types.DelegatorBlockWithdrawal](cdc),
+		),
+		DelegatorCarriedRewards: collections.NewMap(
+			sb,
+			types.DelegatorCarriedRewardsPrefix,
+			"delegator_carried_rewards",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey),
+			codec.CollValue[types.DelegatorCarriedRewards](cdc),
+		),
+		ValidatorCreationHeights: collections.NewMap(
+			sb,
+			types.ValidatorCreationHeightPrefix,
+			"validator_creation_heights",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ValidatorCreationHeight](cdc),
+		),
+		ValidatorCommissionExemptions: collections.NewMap(
+			sb,
+			types.ValidatorCommissionExemptionPrefix,
+			"validator_commission_exemptions",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ValidatorCommissionExemption](cdc),
+		),
+		FrozenValidators: collections.NewKeySet(
+			sb,
+			types.FrozenValidatorPrefix,
+			"frozen_validators",
+			sdk.ValAddressKey,
+		),
+		CommissionWithdrawals: collections.NewMap(
+			sb,
+			types.CommissionWithdrawalPrefix,
+			"commission_withdrawals",
+			collections.PairKeyCodec(sdk.ValAddressKey, collections.Uint64Key),
+			codec.CollValue[types.CommissionWithdrawal](cdc),
+		),
+		RebatedValidators: collections.NewKeySet(
+			sb,
+			types.RebatedValidatorPrefix,
+			"rebated_validators",
+			sdk.ValAddressKey,
+		),
+		FeeCollectorName: collections.NewItem(sb, types.FeeCollectorNameKey, "fee_collector_name", collections.StringValue),
+		ValidatorRewardMultiplier: collections.NewMap(
+			sb,
+			types.ValidatorRewardMultiplierPrefix,
+			"validator_reward_multiplier",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ValidatorRewardMultiplier](cdc),
+		),
+		AllocationEpochPowers: collections.NewMap(
+			sb,
+			types.AllocationEpochPowerPrefix,
+			"allocation_epoch_powers",
+			sdk.ConsAddressKey,
+			codec.CollValue[types.AllocationEpochPower](cdc),
+		),
+		AllocationEpoch: collections.NewItem(sb, types.AllocationEpochKey, "allocation_epoch", codec.CollValue[types.AllocationEpoch](cdc)),
+		LastBlockFees:   collections.NewItem(sb, types.LastBlockFeesKey, "last_block_fees", codec.CollValue[types.BlockFees](cdc)),
+		ValidatorsOperatorRewardsAddress: collections.NewMap(
+			sb,
+			types.ValidatorOperatorRewardsAddrPrefix,
+			"validators_operator_rewards_address",
+			sdk.ValAddressKey,
+			collcodec.KeyToValueCodec(sdk.AccAddressKey),
+		),
+		ValidatorsLifetimeCommission: collections.NewMap(
+			sb,
+			types.ValidatorLifetimeCommissionPrefix,
+			"validators_lifetime_commission",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ValidatorLifetimeCommission](cdc),
+		),
+		CommunityTaxHistory: collections.NewMap(
+			sb,
+			types.CommunityTaxHistoryPrefix,
+			"community_tax_history",
+			collections.Uint64Key,
+			codec.CollValue[types.CommunityTaxRecord](cdc),
+		),
+		CarriedRewardsPayoutQueue: collections.NewKeySet(
+			sb,
+			types.CarriedRewardsPayoutQueuePrefix,
+			"carried_rewards_payout_queue",
+			collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.ValAddressKey),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -134,9 +361,215 @@ func NewKeeper(
 		panic(err)
 	}
 	k.Schema = schema
+	k.carriedPayoutsPerBlock = DefaultCarriedPayoutsPerBlock
 	return k
 }
 
+// SetHooks sets the distribution hooks. Since the keeper is passed by value,
+// it must be called before the keeper is passed to the module and its
+// services.
+func (k *Keeper) SetHooks(dh types.DistributionHooks) {
+	if k.hooks != nil {
+		panic("cannot set distribution hooks twice")
+	}
+
+	k.hooks = dh
+}
+
+// SetAggregateRewardEvents configures AllocateTokens to emit a single
+// summarized rewards event at the end of the allocation instead of a
+// commission and a rewards event for every validator. It must be called
+// before the keeper is passed to the module and its services.
+func (k *Keeper) SetAggregateRewardEvents(enabled bool) {
+	k.aggregateRewardEvents = enabled
+}
+
+// SetFeeCollectorNames sets the module accounts whose balances are collected
+// and distributed by AllocateTokens, replacing the fee collector given to
+// NewKeeper. It must be called before the keeper is passed to the module and
+// its services.
+func (k *Keeper) SetFeeCollectorNames(names ...string) {
+	if len(names) == 0 {
+		panic("at least one fee collector must be set")
+	}
+
+	k.feeCollectorNames = names
+}
+
+// SetFeeCollectorName stores the name of the module account whose balance is
+// collected and distributed by AllocateTokens, in place of the fee collector
+// given to NewKeeper. The other fee collectors set with SetFeeCollectorNames
+// are still collected.
+func (k Keeper) SetFeeCollectorName(ctx context.Context, name string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeCollectorName,
+			sdk.NewAttribute(types.AttributeKeyFeeCollector, name),
+		),
+	)
+
+	return k.FeeCollectorName.Set(ctx, name)
+}
+
+// GetFeeCollectorNames returns the names of the module accounts whose balances
+// are collected and distributed by AllocateTokens: the fee collector stored
+// with SetFeeCollectorName, or the one given to NewKeeper if none is stored,
+// followed by the other fee collectors set with SetFeeCollectorNames.
+func (k Keeper) GetFeeCollectorNames(ctx context.Context) ([]string, error) {
+	name, err := k.FeeCollectorName.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return k.feeCollectorNames, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{name}
+	for _, other := range k.feeCollectorNames[1:] {
+		if other != name {
+			names = append(names, other)
+		}
+	}
+	return names, nil
+}
+
+// SetRemainderStrategy configures what AllocateTokens does with the truncation
+// remainder of the validators' share. It defaults to
+// RemainderStrategyDecimalPool and must be called before the keeper is passed
+// to the module and its services.
+func (k *Keeper) SetRemainderStrategy(strategy types.RemainderStrategy) {
+	k.remainderStrategy = strategy
+}
+
+// SetVotePowerCheck enables a consistency check in AllocateTokens between the
+// total previous power and the sum of the bonded votes' powers. A relative
+// deviation above tolerance is logged, or returned as an error if strict is
+// true. It is meant for debugging and must be called before the keeper is
+// passed to the module and its services.
+func (k *Keeper) SetVotePowerCheck(tolerance math.LegacyDec, strict bool) {
+	if tolerance.IsNil() || tolerance.IsNegative() {
+		panic("vote power tolerance must not be nil or negative")
+	}
+
+	k.votePowerTolerance = tolerance
+	k.votePowerStrict = strict
+}
+
+// SetRewardSampleBlocks configures the allocation to record the tokens
+// allocated to every validator over the given number of recent blocks, from
+// which the ValidatorEstimatedRewardRate query estimates the reward rate of a
+// validator. The sampling is disabled by default, it must be called before the
+// keeper is passed to the module and its services.
+func (k *Keeper) SetRewardSampleBlocks(blocks uint64) {
+	k.rewardSampleBlocks = blocks
+}
+
+// SetCarriedPayoutsPerBlock configures the maximum number of delegators whose
+// carried rewards are paid out at the beginning of a block, the others being
+// paid out in the next blocks in the order their rewards were carried over.
+// It defaults to DefaultCarriedPayoutsPerBlock and must be called before the
+// keeper is passed to the module and its services.
+func (k *Keeper) SetCarriedPayoutsPerBlock(payouts uint64) {
+	if payouts == 0 {
+		panic("carried payouts per block must be positive")
+	}
+
+	k.carriedPayoutsPerBlock = payouts
+}
+
+// SetDecimalPoolSampleBlocks configures the allocation to record the
+// remainder added to the decimal pool over the given number of recent blocks,
+// from which EstimateBlocksToDecimalFlush forecasts the growth of the decimal
+// pool. The sampling is disabled by default, it must be called before the
+// keeper is passed to the module and its services.
+func (k *Keeper) SetDecimalPoolSampleBlocks(blocks uint64) {
+	k.decimalPoolSampleBlocks = blocks
+}
+
+// SetPowerWeightFunc configures AllocateTokens to allocate the validators'
+// share proportionally to the weight of their voting power, e.g. the square
+// root of the power to reduce the dominance of large validators, divided by
+// the sum of the weights of the bonded votes. It must be called before the
+// keeper is passed to the module and its services.
+func (k *Keeper) SetPowerWeightFunc(fn types.PowerWeightFunc) {
+	if fn == nil {
+		panic("power weight func must not be nil")
+	}
+
+	k.powerWeightFunc = fn
+}
+
+// SetPowerSource configures AllocateTokens to allocate the validators' share
+// proportionally to the decimal power supplied by source for every bonded vote
+// with a positive power, divided by the sum of these powers, instead of the
+// int64 power of the votes. It takes precedence over the power weight func. It
+// must be called before the keeper is passed to the module and its services.
+func (k *Keeper) SetPowerSource(source types.PowerSource) {
+	if source == nil {
+		panic("power source must not be nil")
+	}
+
+	k.powerSource = source
+}
+
+// SetTombstoneFunc configures the allocation to forfeit to the community pool
+// the escrowed rewards of a jailed validator found in the bonded votes once fn
+// reports it tombstoned, e.g. with the IsTombstoned method of the slashing
+// keeper. It must be called before the keeper is passed to the module and its
+// services.
+func (k *Keeper) SetTombstoneFunc(fn types.TombstoneFunc) {
+	if fn == nil {
+		panic("tombstone func must not be nil")
+	}
+
+	k.tombstoneFunc = fn
+}
+
+// SetUptimeProvider configures AllocateTokens to scale the reward of every
+// validator by its uptime factor, e.g. derived from the signed blocks info of
+// the slashing module, the reduction being added to the community pool. It
+// must be called before the keeper is passed to the module and its services.
+func (k *Keeper) SetUptimeProvider(provider types.UptimeProvider) {
+	if provider == nil {
+		panic("uptime provider must not be nil")
+	}
+
+	k.uptimeProvider = provider
+}
+
+// SetShareWorkers configures the maximum number of goroutines computing the
+// validators' shares of an allocation at once. It defaults to GOMAXPROCS and
+// must be called before the keeper is passed to the module and its services.
+func (k *Keeper) SetShareWorkers(workers int) {
+	if workers <= 0 {
+		panic("share workers must be positive")
+	}
+
+	k.shareWorkers = workers
+}
+
+// getShareWorkers returns the maximum number of goroutines computing the
+// validators' shares at once.
+func (k Keeper) getShareWorkers() int {
+	if k.shareWorkers > 0 {
+		return k.shareWorkers
+	}
+
+	return runtime.GOMAXPROCS(0)
+}
+
+// AddRewardListener registers a listener notified of every allocation of
+// tokens to a validator, after the listeners already registered. It must be
+// called before the keeper is passed to the module and its services.
+func (k *Keeper) AddRewardListener(listener types.RewardListener) {
+	if listener == nil {
+		panic("reward listener must not be nil")
+	}
+
+	k.rewardListeners = append(k.rewardListeners, listener)
+}
+
 // GetAuthority returns the x/distribution module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -174,38 +607,436 @@ func (k Keeper) SetWithdrawAddr(ctx context.Context, delegatorAddr, withdrawAddr
 	return k.DelegatorsWithdrawAddress.Set(ctx, delegatorAddr, withdrawAddr)
 }
 
-// withdraw rewards from a delegation
-func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+// SetValidatorCommissionWithdrawAddr sets a new address that will receive the
+// commission of a validator upon withdrawal
+func (k Keeper) SetValidatorCommissionWithdrawAddr(ctx context.Context, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(withdrawAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
+	}
+
+	withdrawAddrEnabled, err := k.GetWithdrawAddrEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !withdrawAddrEnabled {
+		return types.ErrSetWithdrawAddrDisabled
+	}
+
+	val, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if val == nil {
+		return types.ErrNoValidatorExists
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddr.String()),
+		),
+	)
+
+	return k.ValidatorsCommissionWithdrawAddress.Set(ctx, valAddr, withdrawAddr)
+}
+
+// SetValidatorOperatorRewardsAddr sets the address the rewards of the
+// validator operator's self-delegation are sent to upon withdrawal, instead of
+// the operator's withdraw address. The rewards of the other delegations of the
+// validator are not affected. An empty address removes the routing.
+func (k Keeper) SetValidatorOperatorRewardsAddr(ctx context.Context, valAddr sdk.ValAddress, rewardsAddr sdk.AccAddress) error {
+	if !rewardsAddr.Empty() && k.bankKeeper.BlockedAddr(rewardsAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", rewardsAddr)
+	}
+
+	val, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if val == nil {
+		return types.ErrNoValidatorExists
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetOperatorRewardsAddress,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyRewardsAddress, rewardsAddr.String()),
+		),
+	)
+
+	if rewardsAddr.Empty() {
+		return k.ValidatorsOperatorRewardsAddress.Remove(ctx, valAddr)
+	}
+	return k.ValidatorsOperatorRewardsAddress.Set(ctx, valAddr, rewardsAddr)
+}
+
+// SetValidatorCommissionExemptDenoms sets the denoms no commission is taken
+// on from the rewards of a validator, which are shared in full with its
+// delegators. It replaces the current exempt denoms, an empty list removes
+// them.
+func (k Keeper) SetValidatorCommissionExemptDenoms(ctx context.Context, valAddr sdk.ValAddress, denoms []string) error {
+	if err := types.ValidateCommissionExemptDenoms(denoms); err != nil {
+		return err
+	}
+
 	val, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if val == nil {
+		return types.ErrNoValidatorExists
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionExemption,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDenoms, strings.Join(denoms, ",")),
+		),
+	)
+
+	if len(denoms) == 0 {
+		return k.ValidatorCommissionExemptions.Remove(ctx, valAddr)
+	}
+	return k.ValidatorCommissionExemptions.Set(ctx, valAddr, types.NewValidatorCommissionExemption(denoms...))
+}
+
+// SetWithdrawSplit sets the addresses that will receive the rewards of a
+// delegator upon withdrawal, in place of its withdraw address, proportionally
+// to their weights. An empty split removes the current one.
+func (k Keeper) SetWithdrawSplit(ctx context.Context, delegatorAddr sdk.AccAddress, entries []types.WithdrawSplitEntry) error {
+	withdrawAddrEnabled, err := k.GetWithdrawAddrEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !withdrawAddrEnabled {
+		return types.ErrSetWithdrawAddrDisabled
+	}
+
+	split := types.NewWithdrawSplit(entries...)
+	if len(entries) > 0 {
+		if err := split.Validate(); err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			withdrawAddr, err := k.authKeeper.AddressCodec().StringToBytes(entry.Address)
+			if err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid withdraw address: %s", err)
+			}
+
+			if k.bankKeeper.BlockedAddr(withdrawAddr) {
+				return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", entry.Address)
+			}
+		}
+	}
+
+	shares := make([]string, len(entries))
+	for i, entry := range entries {
+		shares[i] = fmt.Sprintf("%s:%s", entry.Address, entry.Weight)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawSplit,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawSplit, strings.Join(shares, ",")),
+		),
+	)
+
+	if len(entries) == 0 {
+		return k.DelegatorsWithdrawSplit.Remove(ctx, delegatorAddr)
+	}
+	return k.DelegatorsWithdrawSplit.Set(ctx, delegatorAddr, split)
+}
+
+// SetValidatorCommissionCap caps the commission rate the rewards of a
+// validator are split with, a nil max rate removes the cap. The cap is kept
+// when the validator is removed, so it applies again if the operator creates a
+// new validator.
+func (k Keeper) SetValidatorCommissionCap(ctx context.Context, valAddr sdk.ValAddress, maxRate math.LegacyDec) error {
+	if !maxRate.IsNil() {
+		if err := types.ValidateCommissionCap(maxRate); err != nil {
+			return err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionCap,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxRate, maxRate.String()),
+		),
+	)
+
+	if maxRate.IsNil() {
+		return k.ValidatorCommissionCap.Remove(ctx, valAddr)
+	}
+	return k.ValidatorCommissionCap.Set(ctx, valAddr, types.NewValidatorCommissionCap(maxRate))
+}
+
+// SetValidatorRewardsFrozen freezes or unfreezes the reward accrual of a
+// validator. The tokens allocated to a frozen validator are redirected to the
+// community pool, its delegators and commission accruing nothing, until it is
+// unfrozen. Unlike jailing, the validator keeps its voting power. The freeze
+// is kept when the validator is removed.
+func (k Keeper) SetValidatorRewardsFrozen(ctx context.Context, valAddr sdk.ValAddress, frozen bool) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRewardsFrozen,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyFrozen, strconv.FormatBool(frozen)),
+		),
+	)
+
+	if !frozen {
+		return k.FrozenValidators.Remove(ctx, valAddr)
+	}
+	return k.FrozenValidators.Set(ctx, valAddr)
+}
+
+// IsValidatorRewardsFrozen returns whether the reward accrual of a validator
+// is frozen.
+func (k Keeper) IsValidatorRewardsFrozen(ctx context.Context, valAddr sdk.ValAddress) (bool, error) {
+	return k.FrozenValidators.Has(ctx, valAddr)
+}
+
+// SetValidatorCommissionRebated adds a validator to the rebated validators or
+// removes it. On every allocation to a rebated validator, the commission
+// rebate rate of its commission is drawn from the community pool and added to
+// the rewards of its delegators, the validator keeping its commission.
+func (k Keeper) SetValidatorCommissionRebated(ctx context.Context, valAddr sdk.ValAddress, rebated bool) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionRebated,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyRebated, strconv.FormatBool(rebated)),
+		),
+	)
+
+	if !rebated {
+		return k.RebatedValidators.Remove(ctx, valAddr)
+	}
+	return k.RebatedValidators.Set(ctx, valAddr)
+}
+
+// IsValidatorCommissionRebated returns whether the commission of a validator
+// is rebated to its delegators.
+func (k Keeper) IsValidatorCommissionRebated(ctx context.Context, valAddr sdk.ValAddress) (bool, error) {
+	return k.RebatedValidators.Has(ctx, valAddr)
+}
+
+// SetValidatorRewardMultiplier sets the multiplier applied to the rewards
+// allocated to a validator in BeginBlock, a multiplier of one removes it. The
+// difference with the unmultiplied rewards is taken from, or added to, the
+// community pool. The multiplier is kept when the validator is removed.
+func (k Keeper) SetValidatorRewardMultiplier(ctx context.Context, valAddr sdk.ValAddress, multiplier math.LegacyDec) error {
+	if err := types.ValidateRewardMultiplier(multiplier); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRewardMultiplier,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyMultiplier, multiplier.String()),
+		),
+	)
+
+	if multiplier.Equal(math.LegacyOneDec()) {
+		return k.ValidatorRewardMultiplier.Remove(ctx, valAddr)
+	}
+	return k.ValidatorRewardMultiplier.Set(ctx, valAddr, types.NewValidatorRewardMultiplier(multiplier))
+}
+
+// GetValidatorRewardMultiplier returns the multiplier applied to the rewards
+// allocated to a validator, one if none is set.
+func (k Keeper) GetValidatorRewardMultiplier(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	multiplier, err := k.ValidatorRewardMultiplier.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyOneDec(), nil
+	}
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return multiplier.Multiplier, nil
+}
+
+// sendRewards sends the coins withdrawn on behalf of a delegator to the
+// addresses of its withdraw split, if it has one, or to its withdraw address.
+func (k Keeper) sendRewards(ctx context.Context, delAddr sdk.AccAddress, coins sdk.Coins) error {
+	split, err := k.DelegatorsWithdrawSplit.Get(ctx, delAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, share := range split.Split(coins) {
+		if share.IsZero() {
+			continue
+		}
+
+		withdrawAddr, err := k.authKeeper.AddressCodec().StringToBytes(split.Entries[i].Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, share); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// delegationRewardsSender returns the sender of the rewards withdrawn from a
+// delegation. The rewards of a validator operator's self-delegation are sent
+// to its operator rewards address if it is set, the other rewards are sent
+// with sendRewards.
+func (k Keeper) delegationRewardsSender(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (rewardsSender, error) {
+	if !delAddr.Equals(valAddr) {
+		return k.sendRewards, nil
+	}
+
+	rewardsAddr, err := k.ValidatorsOperatorRewardsAddress.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return k.sendRewards, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, _ sdk.AccAddress, coins sdk.Coins) error {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, rewardsAddr, coins)
+	}, nil
+}
+
+// withdraw rewards from a delegation, it fails if the delegator already
+// withdrew the maximum amount of the block
+func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	if err := k.checkWithdrawCap(ctx, delAddr); err != nil {
+		return nil, err
+	}
+
+	send, err := k.delegationRewardsSender(ctx, delAddr, valAddr)
 	if err != nil {
 		return nil, err
 	}
 
+	rewards, _, err := k.withdrawAndReinitializeDelegation(ctx, delAddr, valAddr, send)
+	return rewards, err
+}
+
+// WithdrawDelegationRewardsWithBreakdown withdraws rewards from a delegation
+// like WithdrawDelegationRewards and also returns the breakdown of the
+// withdrawal by period, with the rewards carried over and the dust left
+// unwithdrawn.
+func (k Keeper) WithdrawDelegationRewardsWithBreakdown(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, types.DelegationWithdrawalBreakdown, error) {
+	if err := k.checkWithdrawCap(ctx, delAddr); err != nil {
+		return nil, types.DelegationWithdrawalBreakdown{}, err
+	}
+
+	send, err := k.delegationRewardsSender(ctx, delAddr, valAddr)
+	if err != nil {
+		return nil, types.DelegationWithdrawalBreakdown{}, err
+	}
+
+	return k.withdrawAndReinitializeDelegation(ctx, delAddr, valAddr, send)
+}
+
+// withdrawAndReinitializeDelegation withdraws the rewards of a delegation,
+// paying them with send, and reinitializes it.
+func (k Keeper) withdrawAndReinitializeDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, send rewardsSender) (sdk.Coins, types.DelegationWithdrawalBreakdown, error) {
+	val, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return nil, types.DelegationWithdrawalBreakdown{}, err
+	}
+
 	if val == nil {
-		return nil, types.ErrNoValidatorDistInfo
+		return nil, types.DelegationWithdrawalBreakdown{}, types.ErrNoValidatorDistInfo
 	}
 
 	del, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
 	if del == nil {
-		return nil, types.ErrEmptyDelegationDistInfo
+		return nil, types.DelegationWithdrawalBreakdown{}, types.ErrEmptyDelegationDistInfo
 	}
 
 	// withdraw rewards
-	rewards, err := k.withdrawDelegationRewards(ctx, val, del)
+	rewards, breakdown, err := k.withdrawDelegationRewards(ctx, val, del, send)
 	if err != nil {
-		return nil, err
+		return nil, types.DelegationWithdrawalBreakdown{}, err
 	}
 
 	// reinitialize the delegation
 	err = k.initializeDelegation(ctx, valAddr, delAddr)
+	if err != nil {
+		return nil, types.DelegationWithdrawalBreakdown{}, err
+	}
+	return rewards, breakdown, nil
+}
+
+// WithdrawAllDelegationRewards withdraws the rewards of all the delegations
+// of a delegator, each of which has a DelegatorStartingInfo entry, to the
+// delegator's withdraw address. It returns the total rewards withdrawn. It
+// fails if the delegator already withdrew the maximum amount of the block.
+func (k Keeper) WithdrawAllDelegationRewards(ctx context.Context, delAddr sdk.AccAddress) (sdk.Coins, error) {
+	if err := k.checkWithdrawCap(ctx, delAddr); err != nil {
+		return nil, err
+	}
+
+	delegations, err := k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)
 	if err != nil {
 		return nil, err
 	}
-	return rewards, nil
+
+	total := sdk.NewCoins()
+	for _, delegation := range delegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			return nil, err
+		}
+
+		send, err := k.delegationRewardsSender(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		rewards, _, err := k.withdrawAndReinitializeDelegation(ctx, delAddr, valAddr, send)
+		if err != nil {
+			return nil, err
+		}
+
+		total = total.Add(rewards...)
+	}
+
+	return total, nil
 }
 
 // withdraw validator commission
@@ -237,16 +1068,17 @@ func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.Val
 	}
 
 	if !commission.IsZero() {
-		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, accAddr)
-		if err != nil {
+		if err := k.sendCommission(ctx, valAddr, commission); err != nil {
 			return nil, err
 		}
+	}
 
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
-		if err != nil {
-			return nil, err
-		}
+	if err := k.recordCommissionWithdrawal(ctx, valAddr, commission); err != nil {
+		return nil, err
+	}
+
+	if err := k.recordLifetimeCommission(ctx, valAddr, commission); err != nil {
+		return nil, err
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -260,6 +1092,83 @@ func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.Val
 	return commission, nil
 }
 
+// sendCommission sends the commission withdrawn by a validator to its
+// commission withdraw address. Without one, the commission follows the rewards
+// of the validator operator's delegation.
+func (k Keeper) sendCommission(ctx context.Context, valAddr sdk.ValAddress, commission sdk.Coins) error {
+	withdrawAddr, err := k.ValidatorsCommissionWithdrawAddress.Get(ctx, valAddr)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return k.sendRewards(ctx, sdk.AccAddress(valAddr), commission)
+	case err != nil:
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
+}
+
+// recordCommissionWithdrawal adds the commission withdrawn by a validator to
+// its withdrawal of the current height, if the commission history is recorded.
+// Nothing is recorded for an empty withdrawal.
+func (k Keeper) recordCommissionWithdrawal(ctx context.Context, valAddr sdk.ValAddress, commission sdk.Coins) error {
+	if commission.IsZero() {
+		return nil
+	}
+
+	record, err := k.GetRecordCommissionHistory(ctx)
+	if err != nil || !record {
+		return err
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	key := collections.Join(valAddr, height)
+	withdrawal, err := k.CommissionWithdrawals.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	withdrawal.Height = height
+	withdrawal.Amount = withdrawal.Amount.Add(commission...)
+	return k.CommissionWithdrawals.Set(ctx, key, withdrawal)
+}
+
+// recordLifetimeCommission adds the commission withdrawn by a validator to its
+// lifetime commission, if the lifetime commission is recorded. Nothing is
+// recorded for an empty withdrawal.
+func (k Keeper) recordLifetimeCommission(ctx context.Context, valAddr sdk.ValAddress, commission sdk.Coins) error {
+	if commission.IsZero() {
+		return nil
+	}
+
+	record, err := k.GetRecordLifetimeCommission(ctx)
+	if err != nil || !record {
+		return err
+	}
+
+	lifetime, err := k.ValidatorsLifetimeCommission.Get(ctx, valAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	lifetime.Commission = lifetime.Commission.Add(commission...)
+	return k.ValidatorsLifetimeCommission.Set(ctx, valAddr, lifetime)
+}
+
+// ValidatorLifetimeCommission returns the cumulative commission withdrawn by a
+// validator while the RecordLifetimeCommission parameter was enabled. It is
+// empty for a validator that withdrew no commission in that time.
+func (k Keeper) ValidatorLifetimeCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	lifetime, err := k.ValidatorsLifetimeCommission.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.NewCoins(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return lifetime.Commission, nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx context.Context) (totalRewards sdk.DecCoins) {
 	err := k.ValidatorOutstandingRewards.Walk(ctx, nil, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool, err error) {
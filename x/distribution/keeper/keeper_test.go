@@ -1,12 +1,14 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -15,12 +17,15 @@ import (
 	"cosmossdk.io/x/distribution/keeper"
 	distrtestutil "cosmossdk.io/x/distribution/testutil"
 	"cosmossdk.io/x/distribution/types"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -131,6 +136,310 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	}, remainder)
 }
 
+func TestWithdrawValidatorCommissionHistory(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", addrs[0], gomock.Any()).Return(nil).AnyTimes()
+
+	withdraw := func(ctx sdk.Context, amount int64) {
+		t.Helper()
+		commission := sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(amount)))
+		require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: commission}))
+		require.NoError(t, distrKeeper.ValidatorsAccumulatedCommission.Set(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission}))
+		_, err := distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+		require.NoError(t, err)
+	}
+
+	// nothing is recorded by default
+	withdraw(ctx.WithBlockHeight(3), 7)
+	has, err := distrKeeper.CommissionWithdrawals.Has(ctx, collections.Join(valAddr, uint64(3)))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	params := types.DefaultParams()
+	params.RecordCommissionHistory = true
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// the withdrawals of a same height are summed up
+	withdraw(ctx.WithBlockHeight(5), 10)
+	withdraw(ctx.WithBlockHeight(5), 2)
+	withdraw(ctx.WithBlockHeight(8), 4)
+
+	var withdrawals []types.CommissionWithdrawal
+	err = distrKeeper.CommissionWithdrawals.Walk(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, uint64](valAddr), func(_ collections.Pair[sdk.ValAddress, uint64], withdrawal types.CommissionWithdrawal) (bool, error) {
+		withdrawals = append(withdrawals, withdrawal)
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.CommissionWithdrawal{
+		{Height: 5, Amount: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(12)))},
+		{Height: 8, Amount: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(4)))},
+	}, withdrawals)
+}
+
+func TestValidatorLifetimeCommission(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", addrs[0], gomock.Any()).Return(nil).AnyTimes()
+
+	withdraw := func(commission sdk.DecCoins) {
+		t.Helper()
+		require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: commission}))
+		require.NoError(t, distrKeeper.ValidatorsAccumulatedCommission.Set(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission}))
+		_, err := distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+		require.NoError(t, err)
+	}
+
+	// nothing is recorded by default
+	withdraw(sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(7))))
+	lifetime, err := distrKeeper.ValidatorLifetimeCommission(ctx, valAddr)
+	require.NoError(t, err)
+	require.True(t, lifetime.IsZero())
+	has, err := distrKeeper.ValidatorsLifetimeCommission.Has(ctx, valAddr)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	params := types.DefaultParams()
+	params.RecordLifetimeCommission = true
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// the withdrawals accumulate across denoms, only the whole coins withdrawn
+	// being counted
+	withdraw(sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(10))))
+	withdraw(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("2.5")),
+		sdk.NewDecCoin("atom", math.NewInt(3)),
+	))
+	withdraw(sdk.NewDecCoins(sdk.NewDecCoin("atom", math.NewInt(4))))
+
+	lifetime, err = distrKeeper.ValidatorLifetimeCommission(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 7), sdk.NewInt64Coin("stake", 12)), lifetime)
+
+	// the lifetime commission of another validator is empty
+	lifetime, err = distrKeeper.ValidatorLifetimeCommission(ctx, sdk.ValAddress(addrs[1]))
+	require.NoError(t, err)
+	require.True(t, lifetime.IsZero())
+}
+
+func TestSetValidatorCommissionWithdrawAddr(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	withdrawAddr := addrs[1]
+
+	// the commission withdraw address defaults to the delegation withdraw address
+	addr, err := distrKeeper.GetValidatorCommissionWithdrawAddr(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, addrs[0], addr)
+
+	params := types.DefaultParams()
+	params.WithdrawAddrEnabled = false
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	err = distrKeeper.SetValidatorCommissionWithdrawAddr(ctx, valAddr, withdrawAddr)
+	require.ErrorIs(t, err, types.ErrSetWithdrawAddrDisabled)
+
+	params.WithdrawAddrEnabled = true
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// the validator must exist
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(nil, nil)
+	err = distrKeeper.SetValidatorCommissionWithdrawAddr(ctx, valAddr, withdrawAddr)
+	require.ErrorIs(t, err, types.ErrNoValidatorExists)
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil)
+
+	err = distrKeeper.SetValidatorCommissionWithdrawAddr(ctx, valAddr, withdrawAddr)
+	require.NoError(t, err)
+	addr, err = distrKeeper.GetValidatorCommissionWithdrawAddr(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, withdrawAddr, addr)
+
+	require.Error(t, distrKeeper.SetValidatorCommissionWithdrawAddr(ctx, valAddr, distrAcc.GetAddress()))
+}
+
+func TestWithdrawCommissionAndRewardsToDifferentAddresses(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+
+	// create validator with 50% commission and a self delegation
+	valAddr := sdk.ValAddress(valConsPk0.Address())
+	operatorAddr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	del := stakingtypes.NewDelegation(operatorAddr.String(), valAddr.String(), val.DelegatorShares)
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), operatorAddr, valAddr).Return(del, nil).AnyTimes()
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, operatorAddr, valAddr))
+
+	// the delegation rewards are sent to the withdraw address and the
+	// commission to a separate cold wallet
+	rewardsAddr := addrs[1]
+	commissionAddr := sdk.AccAddress("commission__________")
+	dep.bankKeeper.EXPECT().BlockedAddr(commissionAddr).Return(false).AnyTimes()
+	require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, operatorAddr, rewardsAddr))
+	require.NoError(t, distrKeeper.SetValidatorCommissionWithdrawAddr(ctx, valAddr, commissionAddr))
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(10))}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	expected := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(5)))
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, rewardsAddr, expected).Return(nil)
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, commissionAddr, expected).Return(nil)
+
+	rewards, err := distrKeeper.WithdrawDelegationRewards(ctx, operatorAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, expected, rewards)
+
+	commission, err := distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, expected, commission)
+}
+
+func TestSetWithdrawSplit(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	delegatorAddr := addrs[0]
+	dep.bankKeeper.EXPECT().BlockedAddr(delegatorAddr).Return(false).AnyTimes()
+	entries := []types.WithdrawSplitEntry{
+		types.NewWithdrawSplitEntry(addrs[1].String(), math.LegacyNewDecWithPrec(6, 1)),
+		types.NewWithdrawSplitEntry(delegatorAddr.String(), math.LegacyNewDecWithPrec(4, 1)),
+	}
+
+	params := types.DefaultParams()
+	params.WithdrawAddrEnabled = false
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	err := distrKeeper.SetWithdrawSplit(ctx, delegatorAddr, entries)
+	require.ErrorIs(t, err, types.ErrSetWithdrawAddrDisabled)
+
+	params.WithdrawAddrEnabled = true
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// the weights must sum to one
+	invalid := []types.WithdrawSplitEntry{
+		types.NewWithdrawSplitEntry(addrs[1].String(), math.LegacyNewDecWithPrec(6, 1)),
+		types.NewWithdrawSplitEntry(delegatorAddr.String(), math.LegacyNewDecWithPrec(3, 1)),
+	}
+	err = distrKeeper.SetWithdrawSplit(ctx, delegatorAddr, invalid)
+	require.ErrorIs(t, err, types.ErrInvalidWithdrawSplit)
+
+	// blocked addresses cannot be part of a split
+	blocked := []types.WithdrawSplitEntry{
+		types.NewWithdrawSplitEntry(addrs[1].String(), math.LegacyNewDecWithPrec(6, 1)),
+		types.NewWithdrawSplitEntry(distrAcc.GetAddress().String(), math.LegacyNewDecWithPrec(4, 1)),
+	}
+	err = distrKeeper.SetWithdrawSplit(ctx, delegatorAddr, blocked)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	err = distrKeeper.SetWithdrawSplit(ctx, delegatorAddr, entries)
+	require.NoError(t, err)
+	split, err := distrKeeper.DelegatorsWithdrawSplit.Get(ctx, delegatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, types.NewWithdrawSplit(entries...), split)
+
+	// an empty split removes the current one
+	require.NoError(t, distrKeeper.SetWithdrawSplit(ctx, delegatorAddr, nil))
+	_, err = distrKeeper.DelegatorsWithdrawSplit.Get(ctx, delegatorAddr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestWithdrawRewardsToSplit(t *testing.T) {
+	third := math.LegacyOneDec().QuoInt64(3)
+	splitAddr1 := sdk.AccAddress("split_address_1_____")
+	splitAddr2 := sdk.AccAddress("split_address_2_____")
+	splitAddr3 := sdk.AccAddress("split_address_3_____")
+
+	testCases := []struct {
+		name      string
+		entries   []types.WithdrawSplitEntry
+		expShares map[string]sdk.Coins
+	}{
+		{
+			name: "two-way split",
+			entries: []types.WithdrawSplitEntry{
+				types.NewWithdrawSplitEntry(splitAddr1.String(), math.LegacyNewDecWithPrec(7, 1)),
+				types.NewWithdrawSplitEntry(splitAddr2.String(), math.LegacyNewDecWithPrec(3, 1)),
+			},
+			expShares: map[string]sdk.Coins{
+				splitAddr1.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 35)),
+				splitAddr2.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15)),
+			},
+		},
+		{
+			name: "three-way split, the dust goes to the first address",
+			entries: []types.WithdrawSplitEntry{
+				types.NewWithdrawSplitEntry(splitAddr1.String(), math.LegacyOneDec().Sub(third).Sub(third)),
+				types.NewWithdrawSplitEntry(splitAddr2.String(), third),
+				types.NewWithdrawSplitEntry(splitAddr3.String(), third),
+			},
+			expShares: map[string]sdk.Coins{
+				splitAddr1.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 18)),
+				splitAddr2.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 16)),
+				splitAddr3.String(): sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 16)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _, distrKeeper, dep := initFixture(t)
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+
+			// create validator with 50% commission and a self delegation
+			valAddr := sdk.ValAddress(valConsPk0.Address())
+			operatorAddr := sdk.AccAddress(valAddr)
+			val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+			del := stakingtypes.NewDelegation(operatorAddr.String(), valAddr.String(), val.DelegatorShares)
+			dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+			dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), operatorAddr, valAddr).Return(del, nil).AnyTimes()
+			require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, operatorAddr, valAddr))
+
+			for _, addr := range []sdk.AccAddress{splitAddr1, splitAddr2, splitAddr3} {
+				dep.bankKeeper.EXPECT().BlockedAddr(addr).Return(false).AnyTimes()
+			}
+			require.NoError(t, distrKeeper.SetWithdrawSplit(ctx, operatorAddr, tc.entries))
+
+			// next block
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+			// allocate some rewards, half of them are commission
+			tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(100))}
+			require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+			// both the delegation rewards and the commission follow the split
+			for _, entry := range tc.entries {
+				addr := sdk.MustAccAddressFromBech32(entry.Address)
+				dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addr, tc.expShares[entry.Address]).Return(nil).Times(2)
+			}
+
+			expected := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+			rewards, err := distrKeeper.WithdrawDelegationRewards(ctx, operatorAddr, valAddr)
+			require.NoError(t, err)
+			require.Equal(t, expected, rewards)
+
+			commission, err := distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+			require.NoError(t, err)
+			require.Equal(t, expected, commission)
+		})
+	}
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	ctx, addrs, distrKeeper, _ := initFixture(t)
 
@@ -147,3 +456,556 @@ func TestGetTotalRewards(t *testing.T) {
 
 	require.Equal(t, expectedRewards, totalRewards)
 }
+
+func TestExportRewardSnapshot(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	ctx = ctx.WithBlockHeight(42)
+
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyMustNewDecFromStr(amount)}}
+	}
+
+	feePool := types.InitialFeePool()
+	feePool.CommunityPool = decCoins("10.5")
+	feePool.DecimalPool = decCoins("0.25")
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+	// both validators have outstanding rewards, only the first one has
+	// commission
+	val0, val1 := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+	require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, val0, types.ValidatorOutstandingRewards{Rewards: decCoins("100.5")}))
+	require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, val1, types.ValidatorOutstandingRewards{Rewards: decCoins("7")}))
+	require.NoError(t, distrKeeper.ValidatorsAccumulatedCommission.Set(ctx, val0, types.ValidatorAccumulatedCommission{Commission: decCoins("3.5")}))
+
+	snapshot, err := distrKeeper.ExportRewardSnapshot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), snapshot.Height)
+
+	storedFeePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, storedFeePool, snapshot.FeePool)
+	require.Equal(t, decCoins("0.25"), snapshot.FeePool.DecimalPool)
+
+	require.Len(t, snapshot.OutstandingRewards, 2)
+	for _, record := range snapshot.OutstandingRewards {
+		valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+		require.NoError(t, err)
+		outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, outstanding.Rewards, record.OutstandingRewards)
+	}
+
+	require.Len(t, snapshot.ValidatorAccumulatedCommissions, 1)
+	commission, err := distrKeeper.ValidatorsAccumulatedCommission.Get(ctx, val0)
+	require.NoError(t, err)
+	require.Equal(t, val0.String(), snapshot.ValidatorAccumulatedCommissions[0].ValidatorAddress)
+	require.Equal(t, commission, snapshot.ValidatorAccumulatedCommissions[0].Accumulated)
+
+	// the snapshot is read-only, exporting it twice yields the same snapshot
+	again, err := distrKeeper.ExportRewardSnapshot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, again)
+}
+
+func TestMigrateFundsToPoolWithoutProtocolPool(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+
+	// the protocolpool module account does not exist
+	dep.accountKeeper.EXPECT().GetModuleAddress(pooltypes.ModuleName).Return(nil)
+
+	err := keeper.NewMigrator(distrKeeper).MigrateFundsToPool(ctx)
+	require.ErrorIs(t, err, types.ErrCommunityPoolUnavailable)
+}
+
+func TestMigrate5to6(t *testing.T) {
+	ctx, _, distrKeeper, _ := initFixture(t)
+
+	require.NoError(t, keeper.NewMigrator(distrKeeper).Migrate5to6(ctx))
+
+	name, err := distrKeeper.FeeCollectorName.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "fee_collector", name)
+}
+
+func TestMigrate6to7(t *testing.T) {
+	ctx, _, distrKeeper, _ := initFixture(t)
+
+	// params stored before the keep_community_funds_local parameter was
+	// introduced
+	params := types.DefaultParams()
+	params.KeepCommunityFundsLocal = false
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(distrKeeper).Migrate6to7(ctx))
+
+	keep, err := distrKeeper.GetKeepCommunityFundsLocal(ctx)
+	require.NoError(t, err)
+	require.True(t, keep)
+}
+
+func TestSendDecimalPoolToCommunityPool(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	feePool := types.InitialFeePool()
+	feePool.DecimalPool = sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("3.25")),
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.5")),
+	)
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+	require.NoError(t, distrKeeper.SendDecimalPoolToCommunityPool(ctx))
+
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(3))), feePool.CommunityPool)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.25")),
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.5")),
+	), feePool.DecimalPool)
+}
+
+func TestSendDecimalPoolToCommunityPoolHooks(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	ctrl := gomock.NewController(t)
+	hook0 := distrtestutil.NewMockDistributionHooks(ctrl)
+	hook1 := distrtestutil.NewMockDistributionHooks(ctrl)
+	distrKeeper.SetHooks(types.NewMultiDistributionHooks(hook0, hook1))
+
+	feePool := types.InitialFeePool()
+	feePool.DecimalPool = sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("3.25")))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+	// every hook receives the integer amount moved to the community pool
+	moved := sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(3)))
+	hook0.EXPECT().AfterCommunityPoolFunded(gomock.Any(), moved, types.CommunityPoolSourceDecimalPool).Return(nil)
+	hook1.EXPECT().AfterCommunityPoolFunded(gomock.Any(), moved, types.CommunityPoolSourceDecimalPool).Return(nil)
+	require.NoError(t, distrKeeper.SendDecimalPoolToCommunityPool(ctx))
+
+	// no hook is called when nothing is moved
+	require.NoError(t, distrKeeper.SendDecimalPoolToCommunityPool(ctx))
+
+	// an error returned by a hook is returned to the caller
+	feePool.DecimalPool = sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("2.5")))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+	hook0.EXPECT().AfterCommunityPoolFunded(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("hook failure"))
+	hook1.EXPECT().AfterCommunityPoolFunded(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	require.ErrorContains(t, distrKeeper.SendDecimalPoolToCommunityPool(ctx), "hook failure")
+}
+
+func TestSendDecimalPoolToCommunityPoolSendDisabled(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), "stake").Return(true).AnyTimes()
+	dep.bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), "frozen").Return(false).AnyTimes()
+
+	feePool := types.InitialFeePool()
+	feePool.DecimalPool = sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("3.25")),
+		sdk.NewDecCoinFromDec("frozen", math.LegacyMustNewDecFromStr("7.5")),
+	)
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+	// the send-disabled denom is left in the decimal pool instead of failing
+	// the flush
+	moved, remaining, err := distrKeeper.SendDecimalPoolToCommunityPoolWithResult(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 3)), moved)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("frozen", math.LegacyMustNewDecFromStr("7.5")),
+		sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.25")),
+	), remaining)
+
+	feePool, err = distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(3))), feePool.CommunityPool)
+	require.Equal(t, remaining, feePool.DecimalPool)
+}
+
+func TestSendDecimalPoolToCommunityPoolWithResult(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	feePool := types.InitialFeePool()
+	feePool.CommunityPool = sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(10)))
+	feePool.DecimalPool = sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("3.25")),
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("12.999999999999999999")),
+		sdk.NewDecCoinFromDec("osmo", math.LegacyMustNewDecFromStr("0.5")),
+	)
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+	// the amount sent is the truncated integer part of the decimal pool
+	expSent, expRemaining := feePool.DecimalPool.TruncateDecimal()
+	sent, remaining, err := distrKeeper.SendDecimalPoolToCommunityPoolWithResult(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expSent, sent)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("atom", math.NewInt(12)), sdk.NewCoin("stake", math.NewInt(3))), sent)
+	require.Equal(t, expRemaining, remaining)
+
+	feePool, err = distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, remaining, feePool.DecimalPool)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoin("atom", math.NewInt(12)),
+		sdk.NewDecCoin("stake", math.NewInt(13)),
+	), feePool.CommunityPool)
+
+	// nothing is left to send
+	sent, remaining, err = distrKeeper.SendDecimalPoolToCommunityPoolWithResult(ctx)
+	require.NoError(t, err)
+	require.True(t, sent.IsZero())
+	require.Equal(t, expRemaining, remaining)
+}
+
+func TestSendDecimalPoolToCommunityPoolDenomDestinations(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	dep.bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	params, err := distrKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.CommunityPoolDenomDestinations = []types.CommunityPoolDenomDestination{
+		{Denom: "stake", Module: "treasury"},
+		{Denom: "usdc", Module: "insurance"},
+	}
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	feePool := types.InitialFeePool()
+	feePool.DecimalPool = sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("3.25")),
+		sdk.NewDecCoinFromDec("usdc", math.LegacyMustNewDecFromStr("2.5")),
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("4.75")),
+	)
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+	// the denoms with a destination are sent to their module, the others are
+	// moved to the community pool
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, "treasury", sdk.NewCoins(sdk.NewInt64Coin("stake", 3)))
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, "insurance", sdk.NewCoins(sdk.NewInt64Coin("usdc", 2)))
+	moved, remaining, err := distrKeeper.SendDecimalPoolToCommunityPoolWithResult(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 4)), moved)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.75")),
+		sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.25")),
+		sdk.NewDecCoinFromDec("usdc", math.LegacyMustNewDecFromStr("0.5")),
+	), remaining)
+
+	feePool, err = distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin("atom", math.NewInt(4))), feePool.CommunityPool)
+	require.Equal(t, remaining, feePool.DecimalPool)
+}
+
+func TestSendDecimalPoolToCommunityPoolEventOrder(t *testing.T) {
+	flushEvents := func() []sdk.Event {
+		ctx, _, distrKeeper, dep := initFixture(t)
+		dep.bankKeeper.EXPECT().IsSendEnabledDenom(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+		// the stored decimal pool is not sorted by denom
+		feePool := types.InitialFeePool()
+		feePool.DecimalPool = sdk.DecCoins{
+			sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("3.25")),
+			sdk.NewDecCoinFromDec("osmo", math.LegacyMustNewDecFromStr("0.5")),
+			sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("12.75")),
+			sdk.NewDecCoinFromDec("juno", math.LegacyMustNewDecFromStr("1")),
+		}
+		require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, distrKeeper.SendDecimalPoolToCommunityPool(ctx))
+
+		var events []sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeFlushDecimalPool {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	expEvents := []sdk.Event{
+		sdk.NewEvent(types.EventTypeFlushDecimalPool,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "12atom"),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "1juno"),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "3stake"),
+		),
+	}
+	for i := 0; i < 5; i++ {
+		require.Equal(t, expEvents, flushEvents())
+	}
+}
+
+func TestEstimateBlocksToDecimalFlush(t *testing.T) {
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr(amount)))
+	}
+
+	testCases := []struct {
+		name        string
+		decimalPool sdk.DecCoins
+		// samples holds the remainder and the deficit of every sampled block
+		samples   [][2]sdk.DecCoins
+		expBlocks uint64
+		expErr    bool
+	}{
+		{
+			name:   "no samples",
+			expErr: true,
+		},
+		{
+			name:        "constant remainder",
+			decimalPool: decCoins("0.25"),
+			samples:     [][2]sdk.DecCoins{{decCoins("0.05"), nil}, {decCoins("0.05"), nil}, {decCoins("0.05"), nil}},
+			expBlocks:   15,
+		},
+		{
+			name:        "varying remainder rounded up",
+			decimalPool: decCoins("3.9"),
+			samples:     [][2]sdk.DecCoins{{decCoins("0.01"), nil}, {decCoins("0.03"), nil}, {decCoins("0.02"), nil}, {sdk.DecCoins{}, nil}},
+			expBlocks:   7,
+		},
+		{
+			name:        "integer amount needs a whole unit",
+			decimalPool: decCoins("2"),
+			samples:     [][2]sdk.DecCoins{{decCoins("0.5"), nil}},
+			expBlocks:   2,
+		},
+		{
+			name:        "deficits offset the remainders",
+			decimalPool: decCoins("0.5"),
+			samples:     [][2]sdk.DecCoins{{decCoins("0.2"), nil}, {nil, decCoins("0.1")}},
+			expBlocks:   10,
+		},
+		{
+			name:        "shrinking decimal pool",
+			decimalPool: decCoins("0.5"),
+			samples:     [][2]sdk.DecCoins{{decCoins("0.1"), nil}, {nil, decCoins("0.2")}},
+			expErr:      true,
+		},
+		{
+			name:        "other denom only",
+			decimalPool: decCoins("0.5"),
+			samples:     [][2]sdk.DecCoins{{sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.1"))), nil}},
+			expErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _, distrKeeper, _ := initFixture(t)
+			distrKeeper.SetDecimalPoolSampleBlocks(10)
+
+			feePool := types.InitialFeePool()
+			feePool.DecimalPool = tc.decimalPool
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+			for i, sample := range tc.samples {
+				require.NoError(t, distrKeeper.DecimalPoolSamples.Set(ctx, uint64(i+1), types.DecimalPoolSample{
+					Remainder: sample[0],
+					Deficit:   sample[1],
+				}))
+			}
+
+			blocks, err := distrKeeper.EstimateBlocksToDecimalFlush(ctx, "stake")
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrNoDecimalPoolEstimate)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expBlocks, blocks)
+		})
+	}
+
+	// nothing is estimated while the sampling is disabled
+	ctx, _, distrKeeper, _ := initFixture(t)
+	_, err := distrKeeper.EstimateBlocksToDecimalFlush(ctx, "stake")
+	require.ErrorIs(t, err, types.ErrNoDecimalPoolEstimate)
+}
+
+func TestForfeitEscrowedRewards(t *testing.T) {
+	ctx, _, distrKeeper, _ := initFixture(t)
+
+	valAddr := sdk.ValAddress(valConsAddr0)
+	escrowed := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("32.5")))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+	require.NoError(t, distrKeeper.ValidatorEscrowedRewards.Set(ctx, valAddr, types.ValidatorEscrowedRewards{Rewards: escrowed}))
+
+	forfeited, err := distrKeeper.ForfeitEscrowedRewards(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, escrowed, forfeited)
+
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, escrowed, feePool.CommunityPool)
+	has, err := distrKeeper.ValidatorEscrowedRewards.Has(ctx, valAddr)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// forfeiting an empty escrow is a no-op
+	forfeited, err = distrKeeper.ForfeitEscrowedRewards(ctx, valAddr)
+	require.NoError(t, err)
+	require.True(t, forfeited.IsZero())
+}
+
+func TestFundCommunityPool(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	depositor := addrs[0]
+	amount := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100)))
+
+	// the funds are routed to x/protocolpool
+	dep.poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), amount, depositor).Return(nil)
+	require.NoError(t, distrKeeper.FundCommunityPool(ctx, amount, depositor))
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, sdk.NewEvent(
+		types.EventTypeFundCommunityPool,
+		sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	), events[len(events)-1])
+
+	// the depositor cannot cover the amount, no event is emitted
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	dep.poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), amount, depositor).Return(sdkerrors.ErrInsufficientFunds)
+	require.ErrorIs(t, distrKeeper.FundCommunityPool(ctx, amount, depositor), sdkerrors.ErrInsufficientFunds)
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestValidateCommunityPoolSpend(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+
+	feePool := types.InitialFeePool()
+	feePool.CommunityPool = sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("10.5")))
+	feePool.DecimalPool = sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.75")))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, feePool))
+
+	// 100stake and 20atom in x/protocolpool, 11stake in the fee pool
+	dep.poolKeeper.EXPECT().GetCommunityPool(gomock.Any()).Return(sdk.NewCoins(
+		sdk.NewCoin("stake", math.NewInt(100)),
+		sdk.NewCoin("atom", math.NewInt(20)),
+	), nil).AnyTimes()
+
+	testCases := []struct {
+		name   string
+		amount sdk.Coins
+		errMsg string
+	}{
+		{
+			name:   "sufficient balance",
+			amount: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(111)), sdk.NewCoin("atom", math.NewInt(20))),
+		},
+		{
+			name:   "insufficient balance",
+			amount: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(112)), sdk.NewCoin("atom", math.NewInt(20))),
+			errMsg: "shortfall: 1stake",
+		},
+		{
+			name:   "insufficient balance of several denoms",
+			amount: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(115)), sdk.NewCoin("atom", math.NewInt(25)), sdk.NewCoin("foo", math.NewInt(1))),
+			errMsg: "shortfall: 5atom,1foo,4stake",
+		},
+		{
+			name:   "invalid amount",
+			amount: sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}},
+			errMsg: "invalid coins",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := distrKeeper.ValidateCommunityPoolSpend(ctx, tc.amount)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestPruneOrphanedHistoricalRewards(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	otherValAddr := sdk.ValAddress(addrs[1])
+	rewards := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(1))}
+
+	// periods 1 and 3 were left behind with no reference
+	for period, referenceCount := range []uint32{1, 0, 2, 0} {
+		require.NoError(t, distrKeeper.ValidatorHistoricalRewards.Set(ctx, collections.Join(valAddr, uint64(period)), types.NewValidatorHistoricalRewards(rewards, referenceCount)))
+	}
+	// the orphaned rewards of another validator are left untouched
+	require.NoError(t, distrKeeper.ValidatorHistoricalRewards.Set(ctx, collections.Join(otherValAddr, uint64(1)), types.NewValidatorHistoricalRewards(rewards, 0)))
+
+	pruned, err := distrKeeper.PruneOrphanedHistoricalRewards(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), pruned)
+
+	for period, exists := range []bool{true, false, true, false} {
+		has, err := distrKeeper.ValidatorHistoricalRewards.Has(ctx, collections.Join(valAddr, uint64(period)))
+		require.NoError(t, err)
+		require.Equal(t, exists, has, "period %d", period)
+	}
+
+	has, err := distrKeeper.ValidatorHistoricalRewards.Has(ctx, collections.Join(otherValAddr, uint64(1)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// pruning again is a no-op
+	pruned, err = distrKeeper.PruneOrphanedHistoricalRewards(ctx, valAddr)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+}
+
+func TestResetBlockWithdrawalsPayoutQueue(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	distrKeeper.SetCarriedPayoutsPerBlock(1)
+
+	// a delegator can withdraw 60stake per block, 5stake at least
+	params := types.DefaultParams()
+	params.MaxWithdrawPerBlock = sdk.NewCoins(sdk.NewInt64Coin("stake", 60))
+	params.MinWithdrawAmount = sdk.NewCoins(sdk.NewInt64Coin("stake", 5))
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	stakeCoins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+	}
+
+	// two delegations are queued for a payout at height 2, the rewards of the
+	// third one are left unclaimed below the minimum withdrawal
+	valAddr := sdk.ValAddress("validator")
+	dustAddr := sdk.AccAddress("dust")
+	require.NoError(t, distrKeeper.DelegatorCarriedRewards.Set(ctx, collections.Join(addrs[0], valAddr), types.DelegatorCarriedRewards{Rewards: stakeCoins(100)}))
+	require.NoError(t, distrKeeper.DelegatorCarriedRewards.Set(ctx, collections.Join(addrs[1], valAddr), types.DelegatorCarriedRewards{Rewards: stakeCoins(30)}))
+	require.NoError(t, distrKeeper.DelegatorCarriedRewards.Set(ctx, collections.Join(dustAddr, valAddr), types.DelegatorCarriedRewards{Rewards: stakeCoins(2)}))
+	require.NoError(t, distrKeeper.CarriedRewardsPayoutQueue.Set(ctx, collections.Join3(uint64(2), addrs[0], valAddr)))
+	require.NoError(t, distrKeeper.CarriedRewardsPayoutQueue.Set(ctx, collections.Join3(uint64(2), addrs[1], valAddr)))
+
+	// a single delegation is paid per block, the rest of its rewards being
+	// queued after the delegations already waiting
+	gomock.InOrder(
+		dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addrs[0], stakeCoins(60)),
+		dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addrs[1], stakeCoins(30)),
+		dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addrs[0], stakeCoins(40)),
+	)
+	for height := int64(2); height <= 5; height++ {
+		require.NoError(t, distrKeeper.ResetBlockWithdrawals(ctx.WithBlockHeight(height)))
+	}
+
+	has, err := distrKeeper.DelegatorCarriedRewards.Has(ctx, collections.Join(addrs[0], valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = distrKeeper.DelegatorCarriedRewards.Has(ctx, collections.Join(addrs[1], valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the queue is drained and the dust is left untouched
+	iter, err := distrKeeper.CarriedRewardsPayoutQueue.Iterate(ctx, nil)
+	require.NoError(t, err)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+	unclaimed, err := distrKeeper.GetUnclaimedRewards(ctx, dustAddr)
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(2), unclaimed)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/distribution/migrations/funds"
 	v4 "cosmossdk.io/x/distribution/migrations/v4"
 	"cosmossdk.io/x/distribution/types"
@@ -37,6 +38,11 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 }
 
 func (m Migrator) MigrateFundsToPool(ctx sdk.Context) error {
+	// sending to a module account that does not exist would panic in x/bank
+	if addr := m.keeper.authKeeper.GetModuleAddress(pooltypes.ModuleName); addr == nil {
+		return errorsmod.Wrapf(types.ErrCommunityPoolUnavailable, "%s module account has not been set", pooltypes.ModuleName)
+	}
+
 	macc := m.keeper.GetDistributionAccount(ctx)
 	poolMacc := m.keeper.authKeeper.GetModuleAccount(ctx, pooltypes.ModuleName)
 
@@ -48,6 +54,29 @@ func (m Migrator) MigrateFundsToPool(ctx sdk.Context) error {
 	if err != nil {
 		return err
 	}
-	// return FeePool as empty (since FeePool funds are migrated to pool module account)
-	return m.keeper.FeePool.Set(ctx, types.FeePool{})
+	// return FeePool as empty (since FeePool funds are migrated to pool module account),
+	// the decimal pool stays in the distribution module account
+	return m.keeper.FeePool.Set(ctx, types.FeePool{DecimalPool: feePool.DecimalPool})
+}
+
+// Migrate5to6 migrates the x/distribution module state from the consensus
+// version 5 to version 6. Specifically, it stores the name of the fee
+// collector given to the keeper, which governance can then change with
+// MsgSetFeeCollectorName.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return m.keeper.FeeCollectorName.Set(ctx, m.keeper.feeCollectorNames[0])
+}
+
+// Migrate6to7 migrates the x/distribution module state from the consensus
+// version 6 to version 7. Specifically, it keeps the community pool cut in the
+// fee pool, as before the keep_community_funds_local parameter, which would
+// otherwise be read as unset and send it to x/protocolpool.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.KeepCommunityFundsLocal = true
+	return m.keeper.Params.Set(ctx, params)
 }
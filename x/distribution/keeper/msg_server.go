@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/x/distribution/types"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -56,7 +57,7 @@ func (k msgServer) WithdrawDelegatorReward(ctx context.Context, msg *types.MsgWi
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
 	}
 
-	amount, err := k.WithdrawDelegationRewards(ctx, delegatorAddress, valAddr)
+	amount, breakdown, err := k.WithdrawDelegationRewardsWithBreakdown(ctx, delegatorAddress, valAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +74,62 @@ func (k msgServer) WithdrawDelegatorReward(ctx context.Context, msg *types.MsgWi
 		}
 	}()
 
-	return &types.MsgWithdrawDelegatorRewardResponse{Amount: amount}, nil
+	unclaimed, err := k.GetUnclaimedRewards(ctx, delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawDelegatorRewardResponse{Amount: amount, Unclaimed: unclaimed, Breakdown: breakdown}, nil
+}
+
+func (k msgServer) WithdrawAllDelegatorRewards(ctx context.Context, msg *types.MsgWithdrawAllDelegatorRewards) (*types.MsgWithdrawAllDelegatorRewardsResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	amount, err := k.WithdrawAllDelegationRewards(ctx, delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "withdraw_reward"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	unclaimed, err := k.GetUnclaimedRewards(ctx, delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawAllDelegatorRewardsResponse{Amount: amount, Unclaimed: unclaimed}, nil
+}
+
+func (k msgServer) WithdrawAndCompound(ctx context.Context, msg *types.MsgWithdrawAndCompound) (*types.MsgWithdrawAndCompoundResponse, error) {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	compounded, shares, withdrawn, err := k.Keeper.WithdrawAndCompound(ctx, delegatorAddress, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawAndCompoundResponse{Compounded: compounded, Shares: shares, Withdrawn: withdrawn}, nil
 }
 
 func (k msgServer) WithdrawValidatorCommission(ctx context.Context, msg *types.MsgWithdrawValidatorCommission) (*types.MsgWithdrawValidatorCommissionResponse, error) {
@@ -102,6 +158,171 @@ func (k msgServer) WithdrawValidatorCommission(ctx context.Context, msg *types.M
 	return &types.MsgWithdrawValidatorCommissionResponse{Amount: amount}, nil
 }
 
+func (k msgServer) SetValidatorCommissionWithdrawAddress(ctx context.Context, msg *types.MsgSetValidatorCommissionWithdrawAddress) (*types.MsgSetValidatorCommissionWithdrawAddressResponse, error) {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	withdrawAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.WithdrawAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid withdraw address: %s", err)
+	}
+
+	err = k.SetValidatorCommissionWithdrawAddr(ctx, valAddr, withdrawAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetValidatorCommissionWithdrawAddressResponse{}, nil
+}
+
+func (k msgServer) SetWithdrawSplit(ctx context.Context, msg *types.MsgSetWithdrawSplit) (*types.MsgSetWithdrawSplitResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	err = k.Keeper.SetWithdrawSplit(ctx, delegatorAddress, msg.Entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetWithdrawSplitResponse{}, nil
+}
+
+func (k msgServer) SetValidatorCommissionCap(ctx context.Context, msg *types.MsgSetValidatorCommissionCap) (*types.MsgSetValidatorCommissionCapResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.SetValidatorCommissionCap(ctx, valAddr, msg.MaxRate); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetValidatorCommissionCapResponse{}, nil
+}
+
+func (k msgServer) SetValidatorRewardsFrozen(ctx context.Context, msg *types.MsgSetValidatorRewardsFrozen) (*types.MsgSetValidatorRewardsFrozenResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.SetValidatorRewardsFrozen(ctx, valAddr, msg.Frozen); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetValidatorRewardsFrozenResponse{}, nil
+}
+
+func (k msgServer) SetValidatorCommissionRebated(ctx context.Context, msg *types.MsgSetValidatorCommissionRebated) (*types.MsgSetValidatorCommissionRebatedResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.SetValidatorCommissionRebated(ctx, valAddr, msg.Rebated); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetValidatorCommissionRebatedResponse{}, nil
+}
+
+func (k msgServer) SetFeeCollectorName(ctx context.Context, msg *types.MsgSetFeeCollectorName) (*types.MsgSetFeeCollectorNameResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if msg.FeeCollectorName == "" {
+		return nil, errors.Wrap(types.ErrInvalidFeeCollector, "fee collector name cannot be empty")
+	}
+
+	if msg.FeeCollectorName == types.ModuleName {
+		return nil, errors.Wrapf(types.ErrInvalidFeeCollector, "fee collector cannot be the %s module", types.ModuleName)
+	}
+
+	// the fees are sent from the module account of the fee collector
+	if k.authKeeper.GetModuleAddress(msg.FeeCollectorName) == nil {
+		return nil, errors.Wrapf(types.ErrInvalidFeeCollector, "fee collector has no module account: %s", msg.FeeCollectorName)
+	}
+
+	if err := k.Keeper.SetFeeCollectorName(ctx, msg.FeeCollectorName); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeeCollectorNameResponse{}, nil
+}
+
+func (k msgServer) SetValidatorRewardMultiplier(ctx context.Context, msg *types.MsgSetValidatorRewardMultiplier) (*types.MsgSetValidatorRewardMultiplierResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.SetValidatorRewardMultiplier(ctx, valAddr, msg.Multiplier); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetValidatorRewardMultiplierResponse{}, nil
+}
+
+func (k msgServer) SetValidatorCommissionExemptDenoms(ctx context.Context, msg *types.MsgSetValidatorCommissionExemptDenoms) (*types.MsgSetValidatorCommissionExemptDenomsResponse, error) {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.SetValidatorCommissionExemptDenoms(ctx, valAddr, msg.Denoms); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetValidatorCommissionExemptDenomsResponse{}, nil
+}
+
+func (k msgServer) AllocateValidatorRewards(ctx context.Context, msg *types.MsgAllocateValidatorRewards) (*types.MsgAllocateValidatorRewardsResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := validateAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
+	authority, err := k.authKeeper.AddressCodec().StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.AllocateTokensToValidatorFromAccount(ctx, valAddr, msg.Amount, authority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAllocateValidatorRewardsResponse{}, nil
+}
+
 // Deprecated: DO NOT USE
 // This method uses deprecated message request. Use FundCommunityPool from x/protocolpool module instead.
 func (k msgServer) FundCommunityPool(ctx context.Context, msg *types.MsgFundCommunityPool) (*types.MsgFundCommunityPoolResponse, error) {
@@ -114,7 +335,7 @@ func (k msgServer) FundCommunityPool(ctx context.Context, msg *types.MsgFundComm
 		return nil, err
 	}
 
-	if err := k.poolKeeper.FundCommunityPool(ctx, msg.Amount, depositor); err != nil {
+	if err := k.Keeper.FundCommunityPool(ctx, msg.Amount, depositor); err != nil {
 		return nil, err
 	}
 
@@ -126,12 +347,42 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, err
 	}
 
-	if (!msg.Params.BaseProposerReward.IsNil() && !msg.Params.BaseProposerReward.IsZero()) || //nolint:staticcheck // deprecated but kept for backwards compatibility
-		(!msg.Params.BonusProposerReward.IsNil() && !msg.Params.BonusProposerReward.IsZero()) { //nolint:staticcheck // deprecated but kept for backwards compatibility
-		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot update base or bonus proposer reward because these are deprecated fields")
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, err
 	}
 
-	if err := msg.Params.ValidateBasic(); err != nil {
+	// the community pool cut routed to a module is sent to its module account
+	for _, route := range msg.Params.CommunityPoolRoutes {
+		if route.Module != pooltypes.ModuleName && k.authKeeper.GetModuleAddress(route.Module) == nil {
+			return nil, fmt.Errorf("community pool route module has no module account: %s", route.Module)
+		}
+	}
+	for _, destination := range msg.Params.CommunityPoolDenomDestinations {
+		if destination.Module != pooltypes.ModuleName && k.authKeeper.GetModuleAddress(destination.Module) == nil {
+			return nil, fmt.Errorf("community pool destination module has no module account: %s", destination.Module)
+		}
+	}
+
+	// the community pool cut not kept local is sent to x/protocolpool
+	if err := k.checkCommunityFundsDestination(msg.Params); err != nil {
+		return nil, err
+	}
+
+	// the infra fund cut is sent to the module account of the infra fund module
+	if rate := msg.Params.InfraFundRate; !rate.IsNil() && rate.IsPositive() && k.authKeeper.GetModuleAddress(msg.Params.InfraFundModule) == nil {
+		return nil, fmt.Errorf("infra fund module has no module account: %s", msg.Params.InfraFundModule)
+	}
+
+	// the burned fees are burned from the distribution module account
+	if err := k.checkBurnerPermission(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	current, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.recordCommunityTaxChange(ctx, current, msg.Params); err != nil {
 		return nil, err
 	}
 
@@ -158,6 +409,10 @@ func (k msgServer) CommunityPoolSpend(ctx context.Context, msg *types.MsgCommuni
 		return nil, fmt.Errorf("invalid recipient address: %w", err)
 	}
 
+	if k.poolKeeper == nil {
+		return nil, types.ErrCommunityPoolUnavailable
+	}
+
 	if err := k.poolKeeper.DistributeFromFeePool(ctx, msg.Amount, recipient); err != nil {
 		return nil, err
 	}
@@ -168,6 +423,57 @@ func (k msgServer) CommunityPoolSpend(ctx context.Context, msg *types.MsgCommuni
 	return &types.MsgCommunityPoolSpendResponse{}, nil
 }
 
+func (k msgServer) CommunityPoolSpendBatch(ctx context.Context, msg *types.MsgCommunityPoolSpendBatch) (*types.MsgCommunityPoolSpendBatchResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if len(msg.Spends) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("spend batch cannot be empty")
+	}
+
+	recipients := make([]sdk.AccAddress, len(msg.Spends))
+	total := sdk.NewCoins()
+	for i, spend := range msg.Spends {
+		if err := validateAmount(spend.Amount); err != nil {
+			return nil, err
+		}
+
+		recipient, err := k.authKeeper.AddressCodec().StringToBytes(spend.Recipient)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recipient address: %w", err)
+		}
+
+		recipients[i] = recipient
+		total = total.Add(spend.Amount...)
+	}
+
+	if k.poolKeeper == nil {
+		return nil, types.ErrCommunityPoolUnavailable
+	}
+
+	// check the total up front so that no transfer of the batch is made if
+	// the community pool cannot cover all of them
+	pool, err := k.poolKeeper.GetCommunityPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if shortfall := spendShortfall(pool, total); !shortfall.IsZero() {
+		return nil, errors.Wrapf(types.ErrBadDistribution, "cannot spend %s, shortfall: %s", total, shortfall)
+	}
+
+	logger := k.Logger(ctx)
+	for i, spend := range msg.Spends {
+		if err := k.poolKeeper.DistributeFromFeePool(ctx, spend.Amount, recipients[i]); err != nil {
+			return nil, err
+		}
+
+		logger.Info("transferred from the community pool to recipient", "amount", spend.Amount.String(), "recipient", spend.Recipient)
+	}
+
+	return &types.MsgCommunityPoolSpendBatchResponse{}, nil
+}
+
 func (k msgServer) DepositValidatorRewardsPool(ctx context.Context, msg *types.MsgDepositValidatorRewardsPool) (*types.MsgDepositValidatorRewardsPoolResponse, error) {
 	depositor, err := k.authKeeper.AddressCodec().StringToBytes(msg.Depositor)
 	if err != nil {
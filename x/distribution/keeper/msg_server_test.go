@@ -6,10 +6,14 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/distribution/keeper"
+	distrtestutil "cosmossdk.io/x/distribution/testutil"
 	"cosmossdk.io/x/distribution/types"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -117,6 +121,46 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	}
 }
 
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	dep.stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), addrs[0]).Return(nil, nil).AnyTimes()
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	cases := []struct {
+		name   string
+		msg    *types.MsgWithdrawAllDelegatorRewards
+		errMsg string
+	}{
+		{
+			name: "invalid delegator address",
+			msg: &types.MsgWithdrawAllDelegatorRewards{
+				DelegatorAddress: "invalid",
+			},
+			errMsg: "invalid delegator address",
+		},
+		{
+			name: "no delegations",
+			msg: &types.MsgWithdrawAllDelegatorRewards{
+				DelegatorAddress: addrs[0].String(),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := msgServer.WithdrawAllDelegatorRewards(ctx, tc.msg)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.True(t, res.Amount.IsZero())
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
+
 func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	ctx, addrs, distrKeeper, _ := initFixture(t)
 	msgServer := keeper.NewMsgServerImpl(distrKeeper)
@@ -160,6 +204,592 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	}
 }
 
+func TestMsgSetValidatorCommissionWithdrawAddress(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(stakingtypes.Validator{OperatorAddress: valAddr.String()}, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(addrs[1])).Return(nil, nil).AnyTimes()
+
+	cases := []struct {
+		name   string
+		msg    *types.MsgSetValidatorCommissionWithdrawAddress
+		errMsg string
+	}{
+		{
+			name: "success",
+			msg: &types.MsgSetValidatorCommissionWithdrawAddress{
+				ValidatorAddress: valAddr.String(),
+				WithdrawAddress:  addrs[1].String(),
+			},
+			errMsg: "",
+		},
+		{
+			name: "invalid validator address",
+			msg: &types.MsgSetValidatorCommissionWithdrawAddress{
+				ValidatorAddress: "invalid",
+				WithdrawAddress:  addrs[1].String(),
+			},
+			errMsg: "invalid validator address",
+		},
+		{
+			name: "invalid withdraw address",
+			msg: &types.MsgSetValidatorCommissionWithdrawAddress{
+				ValidatorAddress: valAddr.String(),
+				WithdrawAddress:  "invalid",
+			},
+			errMsg: "invalid withdraw address",
+		},
+		{
+			name: "validator does not exist",
+			msg: &types.MsgSetValidatorCommissionWithdrawAddress{
+				ValidatorAddress: sdk.ValAddress(addrs[1]).String(),
+				WithdrawAddress:  addrs[1].String(),
+			},
+			errMsg: "validator does not exist",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SetValidatorCommissionWithdrawAddress(ctx, tc.msg)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestMsgSetWithdrawSplit(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	entries := []types.WithdrawSplitEntry{types.NewWithdrawSplitEntry(addrs[1].String(), math.LegacyOneDec())}
+
+	cases := []struct {
+		name   string
+		msg    *types.MsgSetWithdrawSplit
+		errMsg string
+	}{
+		{
+			name:   "success",
+			msg:    types.NewMsgSetWithdrawSplit(addrs[0].String(), entries),
+			errMsg: "",
+		},
+		{
+			name:   "remove split",
+			msg:    types.NewMsgSetWithdrawSplit(addrs[0].String(), nil),
+			errMsg: "",
+		},
+		{
+			name:   "invalid delegator address",
+			msg:    types.NewMsgSetWithdrawSplit("invalid", entries),
+			errMsg: "invalid address",
+		},
+		{
+			name: "invalid withdraw address",
+			msg: types.NewMsgSetWithdrawSplit(addrs[0].String(), []types.WithdrawSplitEntry{
+				types.NewWithdrawSplitEntry("invalid", math.LegacyOneDec()),
+			}),
+			errMsg: "invalid address",
+		},
+		{
+			name: "weights not summing to one",
+			msg: types.NewMsgSetWithdrawSplit(addrs[0].String(), []types.WithdrawSplitEntry{
+				types.NewWithdrawSplitEntry(addrs[1].String(), math.LegacyNewDecWithPrec(5, 1)),
+			}),
+			errMsg: "weights must sum to one",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SetWithdrawSplit(ctx, tc.msg)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestMsgSetValidatorCommissionCap(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	authority := authtypes.NewModuleAddress("gov").String()
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	maxRate := math.LegacyNewDecWithPrec(1, 1)
+
+	cases := []struct {
+		name   string
+		msg    *types.MsgSetValidatorCommissionCap
+		expCap *types.ValidatorCommissionCap
+		errMsg string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgSetValidatorCommissionCap("invalid", val.GetOperator(), maxRate),
+			errMsg: "invalid address",
+		},
+		{
+			name:   "incorrect authority",
+			msg:    types.NewMsgSetValidatorCommissionCap(addrs[0].String(), val.GetOperator(), maxRate),
+			errMsg: "expected authority account as only signer for proposal message",
+		},
+		{
+			name:   "invalid validator address",
+			msg:    types.NewMsgSetValidatorCommissionCap(authority, addrs[0].String(), maxRate),
+			errMsg: "invalid validator address",
+		},
+		{
+			name:   "negative max rate",
+			msg:    types.NewMsgSetValidatorCommissionCap(authority, val.GetOperator(), math.LegacyNewDec(-1)),
+			errMsg: "invalid commission cap",
+		},
+		{
+			name:   "max rate above one",
+			msg:    types.NewMsgSetValidatorCommissionCap(authority, val.GetOperator(), math.LegacyNewDec(2)),
+			errMsg: "invalid commission cap",
+		},
+		{
+			name:   "set cap",
+			msg:    types.NewMsgSetValidatorCommissionCap(authority, val.GetOperator(), maxRate),
+			expCap: &types.ValidatorCommissionCap{MaxRate: maxRate},
+		},
+		{
+			name: "remove cap",
+			msg:  types.NewMsgSetValidatorCommissionCap(authority, val.GetOperator(), math.LegacyDec{}),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SetValidatorCommissionCap(ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			commissionCap, err := distrKeeper.ValidatorCommissionCap.Get(ctx, sdk.ValAddress(valConsAddr0))
+			if tc.expCap == nil {
+				require.ErrorIs(t, err, collections.ErrNotFound)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, *tc.expCap, commissionCap)
+			}
+		})
+	}
+}
+
+func TestMsgSetValidatorRewardsFrozen(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	authority := authtypes.NewModuleAddress("gov").String()
+	valAddr := sdk.ValAddress(valConsAddr0).String()
+
+	cases := []struct {
+		name      string
+		msg       *types.MsgSetValidatorRewardsFrozen
+		expFrozen bool
+		errMsg    string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgSetValidatorRewardsFrozen("invalid", valAddr, true),
+			errMsg: "invalid address",
+		},
+		{
+			name:   "incorrect authority",
+			msg:    types.NewMsgSetValidatorRewardsFrozen(addrs[0].String(), valAddr, true),
+			errMsg: "expected authority account as only signer for proposal message",
+		},
+		{
+			name:   "invalid validator address",
+			msg:    types.NewMsgSetValidatorRewardsFrozen(authority, addrs[0].String(), true),
+			errMsg: "invalid validator address",
+		},
+		{
+			name:      "freeze",
+			msg:       types.NewMsgSetValidatorRewardsFrozen(authority, valAddr, true),
+			expFrozen: true,
+		},
+		{
+			name: "unfreeze",
+			msg:  types.NewMsgSetValidatorRewardsFrozen(authority, valAddr, false),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SetValidatorRewardsFrozen(ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			frozen, err := distrKeeper.IsValidatorRewardsFrozen(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, tc.expFrozen, frozen)
+		})
+	}
+}
+
+func TestMsgSetValidatorCommissionRebated(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	authority := authtypes.NewModuleAddress("gov").String()
+	valAddr := sdk.ValAddress(valConsAddr0).String()
+
+	cases := []struct {
+		name       string
+		msg        *types.MsgSetValidatorCommissionRebated
+		expRebated bool
+		errMsg     string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgSetValidatorCommissionRebated("invalid", valAddr, true),
+			errMsg: "invalid address",
+		},
+		{
+			name:   "incorrect authority",
+			msg:    types.NewMsgSetValidatorCommissionRebated(addrs[0].String(), valAddr, true),
+			errMsg: "expected authority account as only signer for proposal message",
+		},
+		{
+			name:   "invalid validator address",
+			msg:    types.NewMsgSetValidatorCommissionRebated(authority, addrs[0].String(), true),
+			errMsg: "invalid validator address",
+		},
+		{
+			name:       "rebate",
+			msg:        types.NewMsgSetValidatorCommissionRebated(authority, valAddr, true),
+			expRebated: true,
+		},
+		{
+			name: "stop rebating",
+			msg:  types.NewMsgSetValidatorCommissionRebated(authority, valAddr, false),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SetValidatorCommissionRebated(ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			rebated, err := distrKeeper.IsValidatorCommissionRebated(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, tc.expRebated, rebated)
+		})
+	}
+}
+
+func TestMsgSetValidatorRewardMultiplier(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	authority := authtypes.NewModuleAddress("gov").String()
+	valAddr := sdk.ValAddress(valConsAddr0).String()
+	bonus := math.LegacyNewDecWithPrec(12, 1)
+
+	cases := []struct {
+		name          string
+		msg           *types.MsgSetValidatorRewardMultiplier
+		expMultiplier math.LegacyDec
+		expStored     bool
+		errMsg        string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgSetValidatorRewardMultiplier("invalid", valAddr, bonus),
+			errMsg: "invalid address",
+		},
+		{
+			name:   "incorrect authority",
+			msg:    types.NewMsgSetValidatorRewardMultiplier(addrs[0].String(), valAddr, bonus),
+			errMsg: "expected authority account as only signer for proposal message",
+		},
+		{
+			name:   "invalid validator address",
+			msg:    types.NewMsgSetValidatorRewardMultiplier(authority, addrs[0].String(), bonus),
+			errMsg: "invalid validator address",
+		},
+		{
+			name:   "negative multiplier",
+			msg:    types.NewMsgSetValidatorRewardMultiplier(authority, valAddr, math.LegacyNewDec(-1)),
+			errMsg: "invalid reward multiplier",
+		},
+		{
+			name:   "nil multiplier",
+			msg:    types.NewMsgSetValidatorRewardMultiplier(authority, valAddr, math.LegacyDec{}),
+			errMsg: "invalid reward multiplier",
+		},
+		{
+			name:          "bonus",
+			msg:           types.NewMsgSetValidatorRewardMultiplier(authority, valAddr, bonus),
+			expMultiplier: bonus,
+			expStored:     true,
+		},
+		{
+			name:          "penalty",
+			msg:           types.NewMsgSetValidatorRewardMultiplier(authority, valAddr, math.LegacyNewDecWithPrec(8, 1)),
+			expMultiplier: math.LegacyNewDecWithPrec(8, 1),
+			expStored:     true,
+		},
+		{
+			name:          "reset",
+			msg:           types.NewMsgSetValidatorRewardMultiplier(authority, valAddr, math.LegacyOneDec()),
+			expMultiplier: math.LegacyOneDec(),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SetValidatorRewardMultiplier(ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			multiplier, err := distrKeeper.GetValidatorRewardMultiplier(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, tc.expMultiplier, multiplier)
+
+			// a multiplier of one is not stored
+			stored, err := distrKeeper.ValidatorRewardMultiplier.Has(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, tc.expStored, stored)
+		})
+	}
+}
+
+func TestMsgSetFeeCollectorName(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	authority := authtypes.NewModuleAddress("gov").String()
+	dep.accountKeeper.EXPECT().GetModuleAddress("protocol_fees").Return(authtypes.NewModuleAddress("protocol_fees")).AnyTimes()
+	dep.accountKeeper.EXPECT().GetModuleAddress("unknown").Return(nil).AnyTimes()
+
+	cases := []struct {
+		name   string
+		msg    *types.MsgSetFeeCollectorName
+		errMsg string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgSetFeeCollectorName("invalid", "protocol_fees"),
+			errMsg: "invalid address",
+		},
+		{
+			name:   "incorrect authority",
+			msg:    types.NewMsgSetFeeCollectorName(addrs[0].String(), "protocol_fees"),
+			errMsg: "expected authority account as only signer for proposal message",
+		},
+		{
+			name:   "empty name",
+			msg:    types.NewMsgSetFeeCollectorName(authority, ""),
+			errMsg: "fee collector name cannot be empty",
+		},
+		{
+			name:   "distribution module",
+			msg:    types.NewMsgSetFeeCollectorName(authority, types.ModuleName),
+			errMsg: "fee collector cannot be the distribution module",
+		},
+		{
+			name:   "no module account",
+			msg:    types.NewMsgSetFeeCollectorName(authority, "unknown"),
+			errMsg: "fee collector has no module account",
+		},
+		{
+			name: "valid",
+			msg:  types.NewMsgSetFeeCollectorName(authority, "protocol_fees"),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SetFeeCollectorName(ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			names, err := distrKeeper.GetFeeCollectorNames(ctx)
+			require.NoError(t, err)
+			require.Equal(t, []string{tc.msg.FeeCollectorName}, names)
+		})
+	}
+}
+
+func TestMsgSetValidatorCommissionExemptDenoms(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr0)).Return(val, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	cases := []struct {
+		name         string
+		msg          *types.MsgSetValidatorCommissionExemptDenoms
+		expExemption *types.ValidatorCommissionExemption
+		errMsg       string
+	}{
+		{
+			name:   "invalid validator address",
+			msg:    types.NewMsgSetValidatorCommissionExemptDenoms(addrs[0].String(), []string{"atom"}),
+			errMsg: "invalid validator address",
+		},
+		{
+			name:   "unknown validator",
+			msg:    types.NewMsgSetValidatorCommissionExemptDenoms(sdk.ValAddress(addrs[0]).String(), []string{"atom"}),
+			errMsg: "validator does not exist",
+		},
+		{
+			name:   "invalid denom",
+			msg:    types.NewMsgSetValidatorCommissionExemptDenoms(val.GetOperator(), []string{"1atom"}),
+			errMsg: "invalid commission exempt denoms",
+		},
+		{
+			name:   "duplicate denom",
+			msg:    types.NewMsgSetValidatorCommissionExemptDenoms(val.GetOperator(), []string{"atom", "atom"}),
+			errMsg: "invalid commission exempt denoms",
+		},
+		{
+			name:         "set exempt denoms",
+			msg:          types.NewMsgSetValidatorCommissionExemptDenoms(val.GetOperator(), []string{"atom", "osmo"}),
+			expExemption: &types.ValidatorCommissionExemption{Denoms: []string{"atom", "osmo"}},
+		},
+		{
+			name: "clear exempt denoms",
+			msg:  types.NewMsgSetValidatorCommissionExemptDenoms(val.GetOperator(), nil),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SetValidatorCommissionExemptDenoms(ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			exemption, err := distrKeeper.ValidatorCommissionExemptions.Get(ctx, sdk.ValAddress(valConsAddr0))
+			if tc.expExemption == nil {
+				require.ErrorIs(t, err, collections.ErrNotFound)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, *tc.expExemption, exemption)
+			}
+		})
+	}
+}
+
+func TestMsgAllocateValidatorRewards(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+
+	authority := authtypes.NewModuleAddress("gov")
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	amount := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100)))
+
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr0)).Return(val, nil).AnyTimes()
+
+	cases := []struct {
+		name   string
+		msg    *types.MsgAllocateValidatorRewards
+		errMsg string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgAllocateValidatorRewards("invalid", val.GetOperator(), amount),
+			errMsg: "invalid address",
+		},
+		{
+			name:   "incorrect authority",
+			msg:    types.NewMsgAllocateValidatorRewards(addrs[0].String(), val.GetOperator(), amount),
+			errMsg: "expected authority account as only signer for proposal message",
+		},
+		{
+			name:   "invalid validator address",
+			msg:    types.NewMsgAllocateValidatorRewards(authority.String(), addrs[0].String(), amount),
+			errMsg: "invalid validator address",
+		},
+		{
+			name:   "nil amount",
+			msg:    types.NewMsgAllocateValidatorRewards(authority.String(), val.GetOperator(), nil),
+			errMsg: "amount cannot be nil",
+		},
+		{
+			name:   "invalid amount",
+			msg:    types.NewMsgAllocateValidatorRewards(authority.String(), val.GetOperator(), sdk.Coins{{Denom: "stake", Amount: math.NewInt(-1)}}),
+			errMsg: "invalid coins",
+		},
+		{
+			name: "allocate rewards",
+			msg:  types.NewMsgAllocateValidatorRewards(authority.String(), val.GetOperator(), amount),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.errMsg == "" {
+				dep.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), authority, types.ModuleName, tc.msg.Amount).Return(nil)
+			}
+
+			_, err := msgServer.AllocateValidatorRewards(ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDecCoinsFromCoins(amount...), outstanding.Rewards)
+		})
+	}
+}
+
 func TestMsgFundCommunityPool(t *testing.T) {
 	ctx, addrs, distrKeeper, dep := initFixture(t)
 	dep.poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -202,9 +832,18 @@ func TestMsgFundCommunityPool(t *testing.T) {
 }
 
 func TestMsgUpdateParams(t *testing.T) {
-	ctx, addrs, distrKeeper, _ := initFixture(t)
+	ctx, addrs, distrKeeper, dep := initFixture(t)
 	msgServer := keeper.NewMsgServerImpl(distrKeeper)
 
+	dep.accountKeeper.EXPECT().GetModuleAddress("treasury").Return(authtypes.NewModuleAddress("treasury")).AnyTimes()
+	dep.accountKeeper.EXPECT().GetModuleAddress("unknown").Return(nil).AnyTimes()
+	routes := func(module string) []types.CommunityPoolRoute {
+		return []types.CommunityPoolRoute{
+			{Module: pooltypes.ModuleName, Weight: math.LegacyNewDecWithPrec(7, 1)},
+			{Module: module, Weight: math.LegacyNewDecWithPrec(3, 1)},
+		}
+	}
+
 	cases := []struct {
 		name   string
 		msg    *types.MsgUpdateParams
@@ -234,6 +873,102 @@ func TestMsgUpdateParams(t *testing.T) {
 			},
 			errMsg: "community tax must be positive",
 		},
+		{
+			name: "invalid proposer rewards",
+			msg: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress("gov").String(),
+				Params: types.Params{
+					CommunityTax:        math.LegacyNewDecWithPrec(2, 2),
+					BaseProposerReward:  math.LegacyNewDecWithPrec(5, 1),
+					BonusProposerReward: math.LegacyNewDecWithPrec(5, 1),
+				},
+			},
+			errMsg: "cannot be greater than one",
+		},
+		{
+			name: "success with proposer rewards",
+			msg: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress("gov").String(),
+				Params: types.Params{
+					CommunityTax:            math.LegacyNewDecWithPrec(2, 2),
+					KeepCommunityFundsLocal: true,
+					BaseProposerReward:      math.LegacyNewDecWithPrec(1, 2),
+					BonusProposerReward:     math.LegacyNewDecWithPrec(4, 2),
+					WithdrawAddrEnabled:     true,
+				},
+			},
+		},
+		{
+			name: "community pool route to a module without account",
+			msg: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress("gov").String(),
+				Params: types.Params{
+					CommunityTax:            math.LegacyNewDecWithPrec(2, 2),
+					KeepCommunityFundsLocal: true,
+					CommunityPoolRoutes:     routes("unknown"),
+				},
+			},
+			errMsg: "has no module account",
+		},
+		{
+			name: "success with community pool routes",
+			msg: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress("gov").String(),
+				Params: types.Params{
+					CommunityTax:            math.LegacyNewDecWithPrec(2, 2),
+					KeepCommunityFundsLocal: true,
+					CommunityPoolRoutes:     routes("treasury"),
+				},
+			},
+		},
+		{
+			name: "community pool denom destination to a module without account",
+			msg: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress("gov").String(),
+				Params: types.Params{
+					CommunityTax:                   math.LegacyNewDecWithPrec(2, 2),
+					KeepCommunityFundsLocal:        true,
+					CommunityPoolDenomDestinations: []types.CommunityPoolDenomDestination{{Denom: "usdc", Module: "unknown"}},
+				},
+			},
+			errMsg: "community pool destination module has no module account",
+		},
+		{
+			name: "success with community pool denom destinations",
+			msg: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress("gov").String(),
+				Params: types.Params{
+					CommunityTax:                   math.LegacyNewDecWithPrec(2, 2),
+					KeepCommunityFundsLocal:        true,
+					CommunityPoolDenomDestinations: []types.CommunityPoolDenomDestination{{Denom: "usdc", Module: "treasury"}},
+				},
+			},
+		},
+		{
+			name: "infra fund module without account",
+			msg: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress("gov").String(),
+				Params: types.Params{
+					CommunityTax:            math.LegacyNewDecWithPrec(2, 2),
+					KeepCommunityFundsLocal: true,
+					InfraFundRate:           math.LegacyNewDecWithPrec(1, 1),
+					InfraFundModule:         "unknown",
+				},
+			},
+			errMsg: "infra fund module has no module account",
+		},
+		{
+			name: "success with infra fund",
+			msg: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress("gov").String(),
+				Params: types.Params{
+					CommunityTax:            math.LegacyNewDecWithPrec(2, 2),
+					KeepCommunityFundsLocal: true,
+					InfraFundRate:           math.LegacyNewDecWithPrec(1, 1),
+					InfraFundModule:         "treasury",
+				},
+			},
+		},
 		{
 			name: "success",
 			msg: &types.MsgUpdateParams{
@@ -257,6 +992,96 @@ func TestMsgUpdateParams(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateParamsBurnerPermission(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	params := types.DefaultParams()
+	params.BurnRate = math.LegacyNewDecWithPrec(1, 1)
+	msg := &types.MsgUpdateParams{Authority: authtypes.NewModuleAddress("gov").String(), Params: params}
+
+	// a burn rate is rejected while the distribution module account cannot burn
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(authtypes.NewEmptyModuleAccount(types.ModuleName))
+	_, err := msgServer.UpdateParams(ctx, msg)
+	require.ErrorContains(t, err, "module account has no burner permission")
+
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Burner))
+	_, err = msgServer.UpdateParams(ctx, msg)
+	require.NoError(t, err)
+
+	stored, err := distrKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.BurnRate, stored.BurnRate)
+}
+
+func TestMsgUpdateParamsKeepCommunityFundsLocal(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	params := types.DefaultParams()
+	params.KeepCommunityFundsLocal = false
+	msg := &types.MsgUpdateParams{Authority: authtypes.NewModuleAddress("gov").String(), Params: params}
+
+	// the community funds cannot be sent out on a chain without x/protocolpool
+	dep.accountKeeper.EXPECT().GetModuleAddress(pooltypes.ModuleName).Return(nil)
+	_, err := msgServer.UpdateParams(ctx, msg)
+	require.ErrorIs(t, err, types.ErrCommunityPoolUnavailable)
+
+	dep.accountKeeper.EXPECT().GetModuleAddress(pooltypes.ModuleName).Return(authtypes.NewModuleAddress(pooltypes.ModuleName))
+	_, err = msgServer.UpdateParams(ctx, msg)
+	require.NoError(t, err)
+
+	keep, err := distrKeeper.GetKeepCommunityFundsLocal(ctx)
+	require.NoError(t, err)
+	require.False(t, keep)
+}
+
+func TestMsgUpdateParamsCommunityTaxHistory(t *testing.T) {
+	ctx, _, distrKeeper, _ := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	params := types.DefaultParams()
+	params.CommunityTaxHistoryLength = 2
+	updateTax := func(height int64, tax math.LegacyDec) {
+		t.Helper()
+		params.CommunityTax = tax
+		_, err := msgServer.UpdateParams(ctx.WithBlockHeight(height), &types.MsgUpdateParams{
+			Authority: authtypes.NewModuleAddress("gov").String(),
+			Params:    params,
+		})
+		require.NoError(t, err)
+	}
+	requireTaxAt := func(height int64, expTax math.LegacyDec) {
+		t.Helper()
+		tax, err := distrKeeper.CommunityTaxAt(ctx, height)
+		require.NoError(t, err)
+		require.Equal(t, expTax, tax)
+	}
+
+	// the current rate applies while no change is recorded
+	updateTax(10, math.LegacyNewDecWithPrec(2, 2))
+	requireTaxAt(5, math.LegacyNewDecWithPrec(2, 2))
+
+	// a change applies from the next block
+	updateTax(20, math.LegacyNewDecWithPrec(5, 2))
+	requireTaxAt(5, math.LegacyNewDecWithPrec(2, 2))
+	requireTaxAt(20, math.LegacyNewDecWithPrec(2, 2))
+	requireTaxAt(21, math.LegacyNewDecWithPrec(5, 2))
+	requireTaxAt(100, math.LegacyNewDecWithPrec(5, 2))
+
+	// the oldest records beyond the history length are pruned
+	updateTax(30, math.LegacyNewDecWithPrec(1, 1))
+	requireTaxAt(25, math.LegacyNewDecWithPrec(5, 2))
+	requireTaxAt(31, math.LegacyNewDecWithPrec(1, 1))
+	_, err := distrKeeper.CommunityTaxAt(ctx, 20)
+	require.ErrorIs(t, err, types.ErrCommunityTaxNotRecorded)
+
+	// nothing is recorded with a zero history length
+	params.CommunityTaxHistoryLength = 0
+	updateTax(40, math.LegacyNewDecWithPrec(3, 2))
+	requireTaxAt(41, math.LegacyNewDecWithPrec(1, 1))
+}
+
 func TestMsgCommunityPoolSpend(t *testing.T) {
 	ctx, addrs, distrKeeper, dep := initFixture(t)
 	dep.poolKeeper.EXPECT().DistributeFromFeePool(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -324,6 +1149,97 @@ func TestMsgCommunityPoolSpend(t *testing.T) {
 	}
 }
 
+func TestMsgCommunityPoolSpendBatch(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	authority := authtypes.NewModuleAddress("gov").String()
+
+	// the community pool holds 1500stake
+	dep.poolKeeper.EXPECT().GetCommunityPool(gomock.Any()).Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 1500)), nil).AnyTimes()
+
+	stakeCoins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+	}
+
+	cases := []struct {
+		name   string
+		msg    *types.MsgCommunityPoolSpendBatch
+		preRun func()
+		errMsg string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgCommunityPoolSpendBatch("invalid", []types.CommunityPoolSpendEntry{{Recipient: addrs[0].String(), Amount: stakeCoins(100)}}),
+			errMsg: "invalid address",
+		},
+		{
+			name:   "incorrect authority",
+			msg:    types.NewMsgCommunityPoolSpendBatch(addrs[0].String(), []types.CommunityPoolSpendEntry{{Recipient: addrs[0].String(), Amount: stakeCoins(100)}}),
+			errMsg: "expected authority account as only signer for proposal message",
+		},
+		{
+			name:   "empty batch",
+			msg:    types.NewMsgCommunityPoolSpendBatch(authority, nil),
+			errMsg: "spend batch cannot be empty",
+		},
+		{
+			name: "invalid recipient address",
+			msg: types.NewMsgCommunityPoolSpendBatch(authority, []types.CommunityPoolSpendEntry{
+				{Recipient: addrs[0].String(), Amount: stakeCoins(100)},
+				{Recipient: "invalid", Amount: stakeCoins(100)},
+			}),
+			errMsg: "invalid recipient address",
+		},
+		{
+			name: "invalid amount",
+			msg: types.NewMsgCommunityPoolSpendBatch(authority, []types.CommunityPoolSpendEntry{
+				{Recipient: addrs[0].String(), Amount: stakeCoins(100)},
+				{Recipient: addrs[1].String()},
+			}),
+			errMsg: "invalid coins",
+		},
+		{
+			name: "over budget batch makes no transfer",
+			msg: types.NewMsgCommunityPoolSpendBatch(authority, []types.CommunityPoolSpendEntry{
+				{Recipient: addrs[0].String(), Amount: stakeCoins(1000)},
+				{Recipient: addrs[1].String(), Amount: stakeCoins(501)},
+			}),
+			errMsg: "shortfall: 1stake",
+		},
+		{
+			name: "success",
+			msg: types.NewMsgCommunityPoolSpendBatch(authority, []types.CommunityPoolSpendEntry{
+				{Recipient: addrs[0].String(), Amount: stakeCoins(1000)},
+				{Recipient: addrs[1].String(), Amount: stakeCoins(500)},
+			}),
+			preRun: func() {
+				gomock.InOrder(
+					dep.poolKeeper.EXPECT().DistributeFromFeePool(gomock.Any(), stakeCoins(1000), addrs[0]).Return(nil),
+					dep.poolKeeper.EXPECT().DistributeFromFeePool(gomock.Any(), stakeCoins(500), addrs[1]).Return(nil),
+				)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// no transfer is expected unless set by the case
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+
+			_, err := msgServer.CommunityPoolSpendBatch(ctx, tc.msg)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
+
 func TestMsgDepositValidatorRewardsPool(t *testing.T) {
 	ctx, _, distrKeeper, _ := initFixture(t)
 	msgServer := keeper.NewMsgServerImpl(distrKeeper)
@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GetCommunityTax returns the current distribution community tax.
@@ -16,6 +19,301 @@ func (k Keeper) GetCommunityTax(ctx context.Context) (math.LegacyDec, error) {
 	return params.CommunityTax, nil
 }
 
+// GetBaseProposerReward returns the current distribution base proposer reward
+// rate. An unset rate is returned as zero.
+func (k Keeper) GetBaseProposerReward(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if params.BaseProposerReward.IsNil() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return params.BaseProposerReward, nil
+}
+
+// GetBonusProposerReward returns the current distribution bonus proposer
+// reward rate. An unset rate is returned as zero.
+func (k Keeper) GetBonusProposerReward(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if params.BonusProposerReward.IsNil() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return params.BonusProposerReward, nil
+}
+
+// GetDistributionPaused returns the current distribution paused parameter.
+func (k Keeper) GetDistributionPaused(ctx context.Context) (paused bool, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return params.DistributionPaused, nil
+}
+
+// GetWithholdJailedRewards returns the current distribution withhold jailed
+// rewards parameter.
+func (k Keeper) GetWithholdJailedRewards(ctx context.Context) (withhold bool, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return params.WithholdJailedRewards, nil
+}
+
+// GetEscrowJailedRewards returns the current distribution escrow jailed
+// rewards parameter.
+func (k Keeper) GetEscrowJailedRewards(ctx context.Context) (escrow bool, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return params.EscrowJailedRewards, nil
+}
+
+// GetMinCommissionRate returns the current distribution minimum commission
+// rate. An unset rate is returned as zero.
+func (k Keeper) GetMinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if params.MinCommissionRate.IsNil() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return params.MinCommissionRate, nil
+}
+
+// GetBurnRate returns the current distribution burn rate. An unset rate is
+// returned as zero.
+func (k Keeper) GetBurnRate(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if params.BurnRate.IsNil() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return params.BurnRate, nil
+}
+
+// GetInfraFundRate returns the current distribution infra fund rate. An
+// unset rate is returned as zero.
+func (k Keeper) GetInfraFundRate(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if params.InfraFundRate.IsNil() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return params.InfraFundRate, nil
+}
+
+// GetCommissionRebateRate returns the current distribution commission rebate
+// rate. An unset rate is returned as zero.
+func (k Keeper) GetCommissionRebateRate(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if params.CommissionRebateRate.IsNil() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return params.CommissionRebateRate, nil
+}
+
+// GetInfraFundModule returns the current distribution name of the module
+// receiving the infra fund cut of the collected fees.
+func (k Keeper) GetInfraFundModule(ctx context.Context) (string, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return params.InfraFundModule, nil
+}
+
+// GetMinSelfBondForRewards returns the current distribution minimum
+// self-delegation of a rewarded validator. An unset minimum is returned as
+// zero.
+func (k Keeper) GetMinSelfBondForRewards(ctx context.Context) (math.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if params.MinSelfBondForRewards.IsNil() {
+		return math.ZeroInt(), nil
+	}
+
+	return params.MinSelfBondForRewards, nil
+}
+
+// GetSubsidyFloor returns the current distribution subsidy floor.
+func (k Keeper) GetSubsidyFloor(ctx context.Context) (sdk.Coins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return params.SubsidyFloor, nil
+}
+
+// GetDistributableDenoms returns the current distribution distributable
+// denoms.
+func (k Keeper) GetDistributableDenoms(ctx context.Context) ([]string, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return params.DistributableDenoms, nil
+}
+
+// GetRoundingMode returns the current distribution rounding mode of the
+// validators' shares.
+func (k Keeper) GetRoundingMode(ctx context.Context) (types.RoundingMode, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.RoundingModeTruncate, err
+	}
+
+	return params.RoundingMode, nil
+}
+
+// GetMaxWithdrawPerBlock returns the current distribution maximum amount of
+// delegation rewards a delegator can withdraw per block.
+func (k Keeper) GetMaxWithdrawPerBlock(ctx context.Context) (sdk.Coins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return params.MaxWithdrawPerBlock, nil
+}
+
+// GetMinWithdrawAmount returns the current distribution minimum amount of
+// delegation rewards paid by a withdrawal.
+func (k Keeper) GetMinWithdrawAmount(ctx context.Context) (sdk.Coins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return params.MinWithdrawAmount, nil
+}
+
+// GetMaxRewardedValidators returns the current distribution maximum number of
+// validators rewarded in a block. Zero rewards every validator.
+func (k Keeper) GetMaxRewardedValidators(ctx context.Context) (uint64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return params.MaxRewardedValidators, nil
+}
+
+// GetAllocationEpochBlocks returns the current distribution number of blocks
+// of an allocation epoch. Zero or one allocates the fees every block.
+func (k Keeper) GetAllocationEpochBlocks(ctx context.Context) (uint64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return params.AllocationEpochBlocks, nil
+}
+
+// GetCommissionGracePeriodBlocks returns the current distribution number of
+// blocks after its creation during which a validator earns no commission.
+func (k Keeper) GetCommissionGracePeriodBlocks(ctx context.Context) (uint64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return params.CommissionGracePeriodBlocks, nil
+}
+
+// GetDecimalPoolFlushInterval returns the current distribution number of
+// blocks between two flushes of the decimal pool to the community pool.
+func (k Keeper) GetDecimalPoolFlushInterval(ctx context.Context) (uint64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return params.DecimalPoolFlushInterval, nil
+}
+
+// GetRecordCommissionHistory returns the current distribution record
+// commission history parameter.
+func (k Keeper) GetRecordCommissionHistory(ctx context.Context) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return params.RecordCommissionHistory, nil
+}
+
+// GetRecordLifetimeCommission returns the current distribution record
+// lifetime commission parameter.
+func (k Keeper) GetRecordLifetimeCommission(ctx context.Context) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return params.RecordLifetimeCommission, nil
+}
+
+// GetCommunityPoolRoutes returns the current distribution routes splitting
+// the community pool cut of the collected fees between modules.
+func (k Keeper) GetCommunityPoolRoutes(ctx context.Context) ([]types.CommunityPoolRoute, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return params.CommunityPoolRoutes, nil
+}
+
+// GetCommunityPoolDenomDestinations returns the current distribution modules
+// the community pool funds of specific denoms are sent to, keyed by denom.
+func (k Keeper) GetCommunityPoolDenomDestinations(ctx context.Context) (map[string]string, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	destinations := make(map[string]string, len(params.CommunityPoolDenomDestinations))
+	for _, destination := range params.CommunityPoolDenomDestinations {
+		destinations[destination.Denom] = destination.Module
+	}
+	return destinations, nil
+}
+
 // GetWithdrawAddrEnabled returns the current distribution withdraw address
 // enabled parameter.
 func (k Keeper) GetWithdrawAddrEnabled(ctx context.Context) (enabled bool, err error) {
@@ -26,3 +324,36 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx context.Context) (enabled bool, err e
 
 	return params.WithdrawAddrEnabled, nil
 }
+
+// GetZeroPowerStrategy returns the current distribution strategy allocating
+// the fees collected in a block with no bonded power.
+func (k Keeper) GetZeroPowerStrategy(ctx context.Context) (types.ZeroPowerStrategy, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.ZeroPowerStrategyCommunityPool, err
+	}
+
+	return params.ZeroPowerStrategy, nil
+}
+
+// GetKeepCommunityFundsLocal returns the current distribution keep community
+// funds local parameter.
+func (k Keeper) GetKeepCommunityFundsLocal(ctx context.Context) (keep bool, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return params.KeepCommunityFundsLocal, nil
+}
+
+// GetStickyWithdrawAddress returns the current distribution sticky withdraw
+// address parameter.
+func (k Keeper) GetStickyWithdrawAddress(ctx context.Context) (sticky bool, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return params.StickyWithdrawAddress, nil
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExportRewardSnapshot returns the outstanding rewards and accumulated
+// commission of every validator and the fee pool, holding the community and
+// decimal pools, at the current height. Unlike ExportGenesis, it is a
+// lightweight read of the state off-chain tooling reconciles against the
+// module balance.
+func (k Keeper) ExportRewardSnapshot(ctx context.Context) (types.RewardSnapshot, error) {
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return types.RewardSnapshot{}, err
+	}
+
+	outstanding, err := k.exportOutstandingRewards(ctx)
+	if err != nil {
+		return types.RewardSnapshot{}, err
+	}
+
+	commissions, err := k.exportAccumulatedCommissions(ctx)
+	if err != nil {
+		return types.RewardSnapshot{}, err
+	}
+
+	return types.RewardSnapshot{
+		Height:                          uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+		FeePool:                         feePool,
+		OutstandingRewards:              outstanding,
+		ValidatorAccumulatedCommissions: commissions,
+	}, nil
+}
+
+// exportOutstandingRewards returns the outstanding rewards of every validator.
+func (k Keeper) exportOutstandingRewards(ctx context.Context) ([]types.ValidatorOutstandingRewardsRecord, error) {
+	outstanding := make([]types.ValidatorOutstandingRewardsRecord, 0)
+	err := k.ValidatorOutstandingRewards.Walk(ctx, nil, func(addr sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool, err error) {
+		outstanding = append(outstanding, types.ValidatorOutstandingRewardsRecord{
+			ValidatorAddress:   addr.String(),
+			OutstandingRewards: rewards.Rewards,
+		})
+		return false, nil
+	})
+
+	return outstanding, err
+}
+
+// exportAccumulatedCommissions returns the accumulated commission of every
+// validator.
+func (k Keeper) exportAccumulatedCommissions(ctx context.Context) ([]types.ValidatorAccumulatedCommissionRecord, error) {
+	acc := make([]types.ValidatorAccumulatedCommissionRecord, 0)
+	err := k.ValidatorsAccumulatedCommission.Walk(ctx, nil, func(addr sdk.ValAddress, commission types.ValidatorAccumulatedCommission) (stop bool, err error) {
+		acc = append(acc, types.ValidatorAccumulatedCommissionRecord{
+			ValidatorAddress: addr.String(),
+			Accumulated:      commission,
+		})
+		return false, nil
+	})
+
+	return acc, err
+}
@@ -20,6 +20,16 @@ func (k Keeper) GetDelegatorWithdrawAddr(ctx context.Context, delAddr sdk.AccAdd
 	return addr, err
 }
 
+// get the validator commission withdraw address, defaulting to the withdraw
+// address of the validator operator's delegation rewards
+func (k Keeper) GetValidatorCommissionWithdrawAddr(ctx context.Context, valAddr sdk.ValAddress) (sdk.AccAddress, error) {
+	addr, err := k.ValidatorsCommissionWithdrawAddress.Get(ctx, valAddr)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return k.GetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valAddr))
+	}
+	return addr, err
+}
+
 // iterate over slash events between heights, inclusive
 func (k Keeper) IterateValidatorSlashEventsBetween(ctx context.Context, val sdk.ValAddress, startingHeight, endingHeight uint64,
 	handler func(height uint64, event types.ValidatorSlashEvent) (stop bool),
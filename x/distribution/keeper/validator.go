@@ -40,7 +40,13 @@ func (k Keeper) initializeValidator(ctx context.Context, val stakingtypes.Valida
 
 	// set outstanding rewards
 	err = k.ValidatorOutstandingRewards.Set(ctx, valBz, types.ValidatorOutstandingRewards{Rewards: sdk.DecCoins{}})
-	return err
+	if err != nil {
+		return err
+	}
+
+	// set creation height, starting the commission grace period
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return k.ValidatorCreationHeights.Set(ctx, valBz, types.ValidatorCreationHeight{Height: height})
 }
 
 // increment validator period, returning the period just ended
@@ -119,6 +125,84 @@ func (k Keeper) IncrementValidatorPeriod(ctx context.Context, val stakingtypes.V
 	return rewards.Period, nil
 }
 
+// GetValidatorCurrentPeriodRewardRatio returns the cumulative reward ratio per
+// share of a validator, including the rewards of its current period. It
+// returns the ratio IncrementValidatorPeriod would record if it was called, but
+// does not mutate state.
+func (k Keeper) GetValidatorCurrentPeriodRewardRatio(ctx context.Context, valAddr sdk.ValAddress) (sdk.DecCoins, error) {
+	val, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := k.ValidatorCurrentRewards.Get(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	historical, err := k.ValidatorHistoricalRewards.Get(ctx, collections.Join(valAddr, rewards.Period-1))
+	if err != nil {
+		return nil, err
+	}
+
+	// the rewards of a zero-token validator go to the community pool on the
+	// next increment and do not add to the ratio
+	if val.GetTokens().IsZero() {
+		return historical.CumulativeRewardRatio, nil
+	}
+
+	current := rewards.Rewards.QuoDecTruncate(math.LegacyNewDecFromInt(val.GetTokens()))
+	return historical.CumulativeRewardRatio.Add(current...), nil
+}
+
+// recordRewardSample adds the tokens allocated to a validator to its reward
+// sample of the current block and prunes its samples older than the sampled
+// blocks. Nothing is recorded if the reward sampling is disabled.
+func (k Keeper) recordRewardSample(ctx context.Context, valAddr sdk.ValAddress, tokens sdk.DecCoins) error {
+	if k.rewardSampleBlocks == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+	sample, err := k.ValidatorRewardSamples.Get(ctx, collections.Join(valAddr, height))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	sample.Rewards = sample.Rewards.Add(tokens...)
+	sample.Time = sdkCtx.HeaderInfo().Time
+	err = k.ValidatorRewardSamples.Set(ctx, collections.Join(valAddr, height), sample)
+	if err != nil {
+		return err
+	}
+
+	if height < k.rewardSampleBlocks {
+		return nil
+	}
+
+	return k.ValidatorRewardSamples.Clear(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, uint64](valAddr).EndInclusive(height-k.rewardSampleBlocks))
+}
+
+// getSelfBond returns the tokens self-delegated by the operator of a
+// validator, zero if the operator has no delegation to it.
+func (k Keeper) getSelfBond(ctx context.Context, val stakingtypes.ValidatorI) (math.Int, error) {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	del, err := k.stakingKeeper.Delegation(ctx, sdk.AccAddress(valAddr), valAddr)
+	if errors.Is(err, collections.ErrNotFound) || errors.Is(err, stakingtypes.ErrNoDelegation) || (err == nil && del == nil) {
+		return math.ZeroInt(), nil
+	}
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return val.TokensFromShares(del.GetShares()).TruncateInt(), nil
+}
+
 // increment the reference count for a historical rewards value
 func (k Keeper) incrementReferenceCount(ctx context.Context, valAddr sdk.ValAddress, period uint64) error {
 	historical, err := k.ValidatorHistoricalRewards.Get(ctx, collections.Join(valAddr, period))
@@ -150,6 +234,40 @@ func (k Keeper) decrementReferenceCount(ctx context.Context, valAddr sdk.ValAddr
 	return k.ValidatorHistoricalRewards.Set(ctx, collections.Join(valAddr, period), historical)
 }
 
+// PruneOrphanedHistoricalRewards removes the historical rewards of a validator
+// that have no reference left. Such entries are removed as soon as their
+// reference count reaches zero, but may have been left in state by past bugs.
+// It returns the number of historical rewards removed and is meant to be
+// called from an upgrade handler.
+func (k Keeper) PruneOrphanedHistoricalRewards(ctx context.Context, valAddr sdk.ValAddress) (uint64, error) {
+	var orphaned []uint64
+	err := k.ValidatorHistoricalRewards.Walk(
+		ctx,
+		collections.NewPrefixedPairRange[sdk.ValAddress, uint64](valAddr),
+		func(key collections.Pair[sdk.ValAddress, uint64], rewards types.ValidatorHistoricalRewards) (stop bool, err error) {
+			if rewards.ReferenceCount == 0 {
+				orphaned = append(orphaned, key.K2())
+			}
+			return false, nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, period := range orphaned {
+		if err := k.ValidatorHistoricalRewards.Remove(ctx, collections.Join(valAddr, period)); err != nil {
+			return 0, err
+		}
+	}
+
+	if len(orphaned) > 0 {
+		k.Logger(ctx).Info("pruned orphaned historical rewards", "validator", valAddr.String(), "count", len(orphaned))
+	}
+
+	return uint64(len(orphaned)), nil
+}
+
 func (k Keeper) updateValidatorSlashFraction(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error {
 	if fraction.GT(math.LegacyOneDec()) || fraction.IsNegative() {
 		panic(fmt.Sprintf("fraction must be >=0 and <=1, current fraction: %v", fraction))
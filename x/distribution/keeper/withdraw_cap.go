@@ -0,0 +1,316 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultCarriedPayoutsPerBlock is the default maximum number of delegations
+// whose carried rewards are paid out at the beginning of a block.
+const DefaultCarriedPayoutsPerBlock = 1000
+
+// GetTotalCarriedRewards returns the sum of the rewards carried over by all
+// delegators.
+func (k Keeper) GetTotalCarriedRewards(ctx context.Context) (totalCarried sdk.Coins) {
+	err := k.DelegatorCarriedRewards.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], carried types.DelegatorCarriedRewards) (stop bool, err error) {
+		totalCarried = totalCarried.Add(carried.Rewards...)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return totalCarried
+}
+
+// checkWithdrawCap returns an error if the delegator already withdrew the
+// maximum amount of every capped denom in the current block.
+func (k Keeper) checkWithdrawCap(ctx context.Context, delAddr sdk.AccAddress) error {
+	maxWithdraw, err := k.GetMaxWithdrawPerBlock(ctx)
+	if err != nil || maxWithdraw.IsZero() {
+		return err
+	}
+
+	withdrawal, err := k.DelegatorBlockWithdrawals.Get(ctx, delAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if withdrawal.Amount.IsAllGTE(maxWithdraw) {
+		return errorsmod.Wrapf(types.ErrWithdrawCapReached, "%s withdrew %s", delAddr, withdrawal.Amount)
+	}
+
+	return nil
+}
+
+// GetUnclaimedRewards returns the rewards carried over by the delegations of
+// the delegator, i.e. withdrawn but not paid yet, either above the maximum
+// withdrawal of the block or below the minimum withdrawal.
+func (k Keeper) GetUnclaimedRewards(ctx context.Context, delAddr sdk.AccAddress) (sdk.Coins, error) {
+	unclaimed := sdk.NewCoins()
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAddr)
+	err := k.DelegatorCarriedRewards.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], carried types.DelegatorCarriedRewards) (stop bool, err error) {
+		unclaimed = unclaimed.Add(carried.Rewards...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return unclaimed, nil
+}
+
+// getDelegationCarriedRewards returns the rewards carried over by a single
+// delegation.
+func (k Keeper) getDelegationCarriedRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	carried, err := k.DelegatorCarriedRewards.Get(ctx, collections.Join(delAddr, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.NewCoins(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return carried.Rewards, nil
+}
+
+// capWithdrawal adds the rewards carried over by the delegation to the rewards
+// withdrawn from it and returns the part that can be paid in the current
+// block. The rest is carried over to the next blocks. The rewards of each
+// delegation are carried over separately, so that they are paid out to the
+// destination of the delegation, while the maximum withdrawal of the block
+// applies to the delegator as a whole.
+func (k Keeper) capWithdrawal(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) (sdk.Coins, error) {
+	maxWithdraw, err := k.GetMaxWithdrawPerBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	minWithdraw, err := k.GetMinWithdrawAmount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	carried, err := k.DelegatorCarriedRewards.Get(ctx, collections.Join(delAddr, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		// nothing to cap, to leave unclaimed nor to carry over
+		if maxWithdraw.IsZero() && minWithdraw.IsZero() {
+			return rewards, nil
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return k.payWithinCap(ctx, delAddr, valAddr, rewards.Add(carried.Rewards...))
+}
+
+// payWithinCap returns the part of the rewards of a delegation the delegator
+// can be paid within the maximum withdrawal of the current block and above
+// the minimum withdrawal, counting it as withdrawn, and carries over the rest.
+func (k Keeper) payWithinCap(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) (sdk.Coins, error) {
+	maxWithdraw, err := k.GetMaxWithdrawPerBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	minWithdraw, err := k.GetMinWithdrawAmount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawal, err := k.DelegatorBlockWithdrawals.Get(ctx, delAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	// a denom without cap is paid entirely
+	paid := sdk.NewCoins()
+	for _, coin := range rewards {
+		limit := maxWithdraw.AmountOf(coin.Denom)
+		if limit.IsZero() {
+			paid = paid.Add(coin)
+			continue
+		}
+
+		allowance := limit.Sub(withdrawal.Amount.AmountOf(coin.Denom))
+		if allowance.IsPositive() {
+			paid = paid.Add(sdk.NewCoin(coin.Denom, math.MinInt(coin.Amount, allowance)))
+		}
+	}
+
+	// the rewards below the minimum withdrawal are left unclaimed
+	paid = aboveMinWithdraw(paid, minWithdraw)
+
+	carried := rewards.Sub(paid...)
+	if carried.IsZero() {
+		err = k.removeCarriedRewards(ctx, delAddr, valAddr)
+	} else {
+		err = k.carryRewards(ctx, delAddr, valAddr, carried, minWithdraw)
+		k.emitCarryRewardsEvent(ctx, delAddr, valAddr, carried)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if maxWithdraw.IsZero() || paid.IsZero() {
+		return paid, nil
+	}
+
+	withdrawal.Amount = withdrawal.Amount.Add(paid...)
+	if err := k.DelegatorBlockWithdrawals.Set(ctx, delAddr, withdrawal); err != nil {
+		return nil, err
+	}
+
+	return paid, nil
+}
+
+// aboveMinWithdraw returns the rewards whose amount reaches the minimum
+// withdrawal of their denom.
+func aboveMinWithdraw(rewards, minWithdraw sdk.Coins) sdk.Coins {
+	withdrawable := sdk.NewCoins()
+	for _, coin := range rewards {
+		if coin.Amount.GTE(minWithdraw.AmountOf(coin.Denom)) {
+			withdrawable = withdrawable.Add(coin)
+		}
+	}
+
+	return withdrawable
+}
+
+// removeCarriedRewards deletes the rewards carried over by a delegation, if
+// any.
+func (k Keeper) removeCarriedRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	key := collections.Join(delAddr, valAddr)
+	has, err := k.DelegatorCarriedRewards.Has(ctx, key)
+	if err != nil || !has {
+		return err
+	}
+
+	return k.DelegatorCarriedRewards.Remove(ctx, key)
+}
+
+// carryRewards carries over the rewards of a delegation and queues their
+// payout at the beginning of the next block, unless they are below the minimum
+// withdrawal and wait for the next withdrawal from the delegation.
+func (k Keeper) carryRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, carried, minWithdraw sdk.Coins) error {
+	if err := k.DelegatorCarriedRewards.Set(ctx, collections.Join(delAddr, valAddr), types.DelegatorCarriedRewards{Rewards: carried}); err != nil {
+		return err
+	}
+
+	if aboveMinWithdraw(carried, minWithdraw).IsZero() {
+		return nil
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) + 1
+	return k.CarriedRewardsPayoutQueue.Set(ctx, collections.Join3(height, delAddr, valAddr))
+}
+
+// ResetBlockWithdrawals clears the amounts withdrawn by the delegators in the
+// previous block and pays out the rewards carried over within the maximum
+// withdrawal of the new block and above the minimum withdrawal. It is called
+// at the beginning of every block. Only the delegations queued for a payout
+// are visited, at most carriedPayoutsPerBlock of them, the others waiting for
+// the next blocks. The rewards of each delegation are paid out to its own
+// destination.
+func (k Keeper) ResetBlockWithdrawals(ctx context.Context) error {
+	if err := k.DelegatorBlockWithdrawals.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	due, err := k.dueCarriedPayouts(ctx)
+	if err != nil {
+		return err
+	}
+
+	minWithdraw, err := k.GetMinWithdrawAmount(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if err := k.CarriedRewardsPayoutQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		delAddr, valAddr := key.K2(), key.K3()
+		carried, err := k.DelegatorCarriedRewards.Get(ctx, collections.Join(delAddr, valAddr))
+		if errors.Is(err, collections.ErrNotFound) {
+			// paid out by a withdrawal from the delegation in the meantime
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// the rewards left unclaimed below the minimum withdrawal wait for
+		// the next withdrawal from the delegation
+		if aboveMinWithdraw(carried.Rewards, minWithdraw).IsZero() {
+			continue
+		}
+
+		paid, err := k.payWithinCap(ctx, delAddr, valAddr, carried.Rewards)
+		if err != nil {
+			return err
+		}
+
+		if paid.IsZero() {
+			continue
+		}
+
+		// the rewards carried over by the self-delegation of a validator
+		// operator follow the rewards of the self-delegation
+		send, err := k.delegationRewardsSender(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+		if err := send(ctx, delAddr, paid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dueCarriedPayouts returns the oldest entries of the carried rewards payout
+// queue due by the current height, at most carriedPayoutsPerBlock of them.
+func (k Keeper) dueCarriedPayouts(ctx context.Context) ([]collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress], error) {
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	iter, err := k.CarriedRewardsPayoutQueue.Iterate(ctx, collections.NewPrefixUntilTripleRange[uint64, sdk.AccAddress, sdk.ValAddress](height))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var due []collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress]
+	for ; iter.Valid() && uint64(len(due)) < k.carriedPayoutsPerBlock; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		due = append(due, key)
+	}
+
+	return due, nil
+}
+
+func (k Keeper) emitCarryRewardsEvent(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coins) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCarryRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+}
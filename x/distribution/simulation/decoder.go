@@ -34,6 +34,10 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 		case bytes.Equal(kvA.Key[:1], types.DelegatorWithdrawAddrPrefix):
 			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorCommissionWithdrawAddrPrefix),
+			bytes.Equal(kvA.Key[:1], types.ValidatorOperatorRewardsAddrPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
 		case bytes.Equal(kvA.Key[:1], types.DelegatorStartingInfoPrefix):
 			var infoA, infoB types.DelegatorStartingInfo
 			cdc.MustUnmarshal(kvA.Value, &infoA)
@@ -64,6 +68,122 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.DelegatorWithdrawSplitPrefix):
+			var splitA, splitB types.WithdrawSplit
+			cdc.MustUnmarshal(kvA.Value, &splitA)
+			cdc.MustUnmarshal(kvB.Value, &splitB)
+			return fmt.Sprintf("%v\n%v", splitA, splitB)
+
+		case bytes.Equal(kvA.Key[:1], types.LastAllocationRecordKey):
+			var recordA, recordB types.AllocationRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorRewardSamplePrefix):
+			var sampleA, sampleB types.ValidatorRewardSample
+			cdc.MustUnmarshal(kvA.Value, &sampleA)
+			cdc.MustUnmarshal(kvB.Value, &sampleB)
+			return fmt.Sprintf("%v\n%v", sampleA, sampleB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorEscrowedRewardsPrefix):
+			var escrowedA, escrowedB types.ValidatorEscrowedRewards
+			cdc.MustUnmarshal(kvA.Value, &escrowedA)
+			cdc.MustUnmarshal(kvB.Value, &escrowedB)
+			return fmt.Sprintf("%v\n%v", escrowedA, escrowedB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorCommissionCapPrefix):
+			var capA, capB types.ValidatorCommissionCap
+			cdc.MustUnmarshal(kvA.Value, &capA)
+			cdc.MustUnmarshal(kvB.Value, &capB)
+			return fmt.Sprintf("%v\n%v", capA, capB)
+
+		case bytes.Equal(kvA.Key[:1], types.DecimalPoolSamplePrefix):
+			var sampleA, sampleB types.DecimalPoolSample
+			cdc.MustUnmarshal(kvA.Value, &sampleA)
+			cdc.MustUnmarshal(kvB.Value, &sampleB)
+			return fmt.Sprintf("%v\n%v", sampleA, sampleB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegatorBlockWithdrawalPrefix):
+			var withdrawalA, withdrawalB types.DelegatorBlockWithdrawal
+			cdc.MustUnmarshal(kvA.Value, &withdrawalA)
+			cdc.MustUnmarshal(kvB.Value, &withdrawalB)
+			return fmt.Sprintf("%v\n%v", withdrawalA, withdrawalB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegatorCarriedRewardsPrefix):
+			var carriedA, carriedB types.DelegatorCarriedRewards
+			cdc.MustUnmarshal(kvA.Value, &carriedA)
+			cdc.MustUnmarshal(kvB.Value, &carriedB)
+			return fmt.Sprintf("%v\n%v", carriedA, carriedB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorCreationHeightPrefix):
+			var creationA, creationB types.ValidatorCreationHeight
+			cdc.MustUnmarshal(kvA.Value, &creationA)
+			cdc.MustUnmarshal(kvB.Value, &creationB)
+			return fmt.Sprintf("%v\n%v", creationA, creationB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorCommissionExemptionPrefix):
+			var exemptionA, exemptionB types.ValidatorCommissionExemption
+			cdc.MustUnmarshal(kvA.Value, &exemptionA)
+			cdc.MustUnmarshal(kvB.Value, &exemptionB)
+			return fmt.Sprintf("%v\n%v", exemptionA, exemptionB)
+
+		case bytes.Equal(kvA.Key[:1], types.FrozenValidatorPrefix), bytes.Equal(kvA.Key[:1], types.RebatedValidatorPrefix):
+			_, valAddrA, err := sdk.ValAddressKey.Decode(kvA.Key[1:])
+			if err != nil {
+				panic(err)
+			}
+			_, valAddrB, err := sdk.ValAddressKey.Decode(kvB.Key[1:])
+			if err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", valAddrA, valAddrB)
+
+		case bytes.Equal(kvA.Key[:1], types.CommissionWithdrawalPrefix):
+			var withdrawalA, withdrawalB types.CommissionWithdrawal
+			cdc.MustUnmarshal(kvA.Value, &withdrawalA)
+			cdc.MustUnmarshal(kvB.Value, &withdrawalB)
+			return fmt.Sprintf("%v\n%v", withdrawalA, withdrawalB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorRewardMultiplierPrefix):
+			var multiplierA, multiplierB types.ValidatorRewardMultiplier
+			cdc.MustUnmarshal(kvA.Value, &multiplierA)
+			cdc.MustUnmarshal(kvB.Value, &multiplierB)
+			return fmt.Sprintf("%v\n%v", multiplierA, multiplierB)
+
+		case bytes.Equal(kvA.Key[:1], types.AllocationEpochPowerPrefix):
+			var powerA, powerB types.AllocationEpochPower
+			cdc.MustUnmarshal(kvA.Value, &powerA)
+			cdc.MustUnmarshal(kvB.Value, &powerB)
+			return fmt.Sprintf("%v\n%v", powerA, powerB)
+
+		case bytes.Equal(kvA.Key[:1], types.AllocationEpochKey):
+			var epochA, epochB types.AllocationEpoch
+			cdc.MustUnmarshal(kvA.Value, &epochA)
+			cdc.MustUnmarshal(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA, epochB)
+
+		case bytes.Equal(kvA.Key[:1], types.LastBlockFeesKey):
+			var feesA, feesB types.BlockFees
+			cdc.MustUnmarshal(kvA.Value, &feesA)
+			cdc.MustUnmarshal(kvB.Value, &feesB)
+			return fmt.Sprintf("%v\n%v", feesA, feesB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorLifetimeCommissionPrefix):
+			var lifetimeA, lifetimeB types.ValidatorLifetimeCommission
+			cdc.MustUnmarshal(kvA.Value, &lifetimeA)
+			cdc.MustUnmarshal(kvB.Value, &lifetimeB)
+			return fmt.Sprintf("%v\n%v", lifetimeA, lifetimeB)
+
+		case bytes.Equal(kvA.Key[:1], types.CommunityTaxHistoryPrefix):
+			var recordA, recordB types.CommunityTaxRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.FeeCollectorNameKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
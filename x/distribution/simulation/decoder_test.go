@@ -33,12 +33,40 @@ func TestDecodeDistributionStore(t *testing.T) {
 	feePool := types.InitialFeePool()
 	feePool.CommunityPool = decCoins
 	slashEvent := types.NewValidatorSlashEvent(10, math.LegacyOneDec())
+	withdrawSplit := types.NewWithdrawSplit(types.NewWithdrawSplitEntry(sdk.AccAddress(delPk1.Address()).String(), math.LegacyOneDec()))
+	commissionCap := types.NewValidatorCommissionCap(math.LegacyNewDecWithPrec(5, 2))
+	carriedRewards := types.DelegatorCarriedRewards{Rewards: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40))}
+	creationHeight := types.ValidatorCreationHeight{Height: 7}
+	exemption := types.NewValidatorCommissionExemption("atom")
+	commissionWithdrawal := types.CommissionWithdrawal{Height: 9, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 12))}
+	multiplier := types.NewValidatorRewardMultiplier(math.LegacyNewDecWithPrec(12, 1))
+	epochPower := types.AllocationEpochPower{Power: 30, SignedPower: 20}
+	epoch := types.AllocationEpoch{Blocks: 3, TotalPower: 60}
+	blockFees := types.BlockFees{Height: 7, FeesCollected: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), FeesDistributed: decCoins}
+	taxRecord := types.CommunityTaxRecord{Height: 11, CommunityTax: math.LegacyNewDecWithPrec(3, 2)}
+	lifetimeCommission := types.ValidatorLifetimeCommission{Commission: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 42))}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.FeePoolKey, Value: cdc.MustMarshal(&feePool)},
 			{Key: types.ProposerKey, Value: consAddr1.Bytes()},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshal(&slashEvent)},
+			{Key: append(types.DelegatorWithdrawSplitPrefix.Bytes(), delPk1.Address()...), Value: cdc.MustMarshal(&withdrawSplit)},
+			{Key: append(types.ValidatorCommissionCapPrefix.Bytes(), valAddr1...), Value: cdc.MustMarshal(&commissionCap)},
+			{Key: append(types.DelegatorCarriedRewardsPrefix.Bytes(), delPk1.Address()...), Value: cdc.MustMarshal(&carriedRewards)},
+			{Key: append(types.ValidatorCreationHeightPrefix.Bytes(), valAddr1...), Value: cdc.MustMarshal(&creationHeight)},
+			{Key: append(types.ValidatorCommissionExemptionPrefix.Bytes(), valAddr1...), Value: cdc.MustMarshal(&exemption)},
+			{Key: append(types.FrozenValidatorPrefix.Bytes(), valAddr1...), Value: []byte{}},
+			{Key: append(types.CommissionWithdrawalPrefix.Bytes(), valAddr1...), Value: cdc.MustMarshal(&commissionWithdrawal)},
+			{Key: append(types.RebatedValidatorPrefix.Bytes(), valAddr1...), Value: []byte{}},
+			{Key: types.FeeCollectorNameKey, Value: []byte("fee_collector")},
+			{Key: append(types.ValidatorRewardMultiplierPrefix.Bytes(), valAddr1...), Value: cdc.MustMarshal(&multiplier)},
+			{Key: append(types.AllocationEpochPowerPrefix.Bytes(), consAddr1...), Value: cdc.MustMarshal(&epochPower)},
+			{Key: types.AllocationEpochKey, Value: cdc.MustMarshal(&epoch)},
+			{Key: types.LastBlockFeesKey, Value: cdc.MustMarshal(&blockFees)},
+			{Key: append(types.ValidatorOperatorRewardsAddrPrefix.Bytes(), valAddr1...), Value: delPk1.Address().Bytes()},
+			{Key: append(types.ValidatorLifetimeCommissionPrefix.Bytes(), valAddr1...), Value: cdc.MustMarshal(&lifetimeCommission)},
+			{Key: append(types.CommunityTaxHistoryPrefix.Bytes(), sdk.Uint64ToBigEndian(11)...), Value: cdc.MustMarshal(&taxRecord)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -50,6 +78,22 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"FeePool", fmt.Sprintf("%v\n%v", feePool, feePool)},
 		{"Proposer", fmt.Sprintf("%v\n%v", consAddr1, consAddr1)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"DelegatorWithdrawSplit", fmt.Sprintf("%v\n%v", withdrawSplit, withdrawSplit)},
+		{"ValidatorCommissionCap", fmt.Sprintf("%v\n%v", commissionCap, commissionCap)},
+		{"DelegatorCarriedRewards", fmt.Sprintf("%v\n%v", carriedRewards, carriedRewards)},
+		{"ValidatorCreationHeight", fmt.Sprintf("%v\n%v", creationHeight, creationHeight)},
+		{"ValidatorCommissionExemption", fmt.Sprintf("%v\n%v", exemption, exemption)},
+		{"FrozenValidator", fmt.Sprintf("%v\n%v", valAddr1, valAddr1)},
+		{"CommissionWithdrawal", fmt.Sprintf("%v\n%v", commissionWithdrawal, commissionWithdrawal)},
+		{"RebatedValidator", fmt.Sprintf("%v\n%v", valAddr1, valAddr1)},
+		{"FeeCollectorName", "fee_collector\nfee_collector"},
+		{"ValidatorRewardMultiplier", fmt.Sprintf("%v\n%v", multiplier, multiplier)},
+		{"AllocationEpochPower", fmt.Sprintf("%v\n%v", epochPower, epochPower)},
+		{"AllocationEpoch", fmt.Sprintf("%v\n%v", epoch, epoch)},
+		{"LastBlockFees", fmt.Sprintf("%v\n%v", blockFees, blockFees)},
+		{"ValidatorOperatorRewardsAddress", fmt.Sprintf("%v\n%v", sdk.AccAddress(delPk1.Address()), sdk.AccAddress(delPk1.Address()))},
+		{"ValidatorLifetimeCommission", fmt.Sprintf("%v\n%v", lifetimeCommission, lifetimeCommission)},
+		{"CommunityTaxHistory", fmt.Sprintf("%v\n%v", taxRecord, taxRecord)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -38,8 +38,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:              communityTax,
+			WithdrawAddrEnabled:       withdrawEnabled,
+			DecimalPoolFlushThreshold: math.ZeroInt(),
+			MinCommissionRate:         math.LegacyZeroDec(),
+			BurnRate:                  math.LegacyZeroDec(),
+			MinSelfBondForRewards:     math.ZeroInt(),
+			KeepCommunityFundsLocal:   true,
 		},
 	}
 
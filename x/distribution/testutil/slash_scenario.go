@@ -0,0 +1,218 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/distribution/keeper"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// SlashScenario describes the delegations, reward allocations and slashes of a
+// single validator, block after block. It is run by RunSlashScenario.
+type SlashScenario struct {
+	// Commission is the commission rate of the validator.
+	Commission math.LegacyDec
+	// Delegations are the delegations to the validator. The first one is the
+	// self-delegation made when the validator is created and its block is
+	// ignored.
+	Delegations []ScenarioDelegation
+	// Blocks are the successive blocks of the scenario.
+	Blocks []ScenarioBlock
+	// Tolerance is the maximum difference allowed between the calculated and
+	// the expected rewards of a delegator, to account for the truncations of
+	// the F1 calculation, none if nil.
+	Tolerance math.LegacyDec
+}
+
+// ScenarioDelegation is a delegation made at the beginning of a block of a
+// SlashScenario.
+type ScenarioDelegation struct {
+	// Block is the index of the block the delegation is made in.
+	Block int
+	// Amount is the amount of delegated tokens.
+	Amount math.Int
+}
+
+// ScenarioBlock is a block of a SlashScenario. The rewards are allocated to the
+// validator before it is slashed.
+type ScenarioBlock struct {
+	// Rewards is the amount of bond denom rewards allocated to the validator,
+	// none if nil.
+	Rewards math.Int
+	// Slashes are the slash fractions successively applied to the validator.
+	Slashes []math.LegacyDec
+}
+
+// RunSlashScenario runs the scenario against a distribution keeper backed by a
+// mocked staking keeper, and asserts that the rewards calculated by
+// CalculateDelegationRewards for each delegation match the F1 expected value:
+// every allocation is split between the delegations pro rata of their stake,
+// each slash reducing the stake of all the delegations made before it by the
+// slashed fraction. It returns the calculated rewards of the delegations.
+func RunSlashScenario(t *testing.T, scenario SlashScenario) []sdk.DecCoins {
+	t.Helper()
+	require.NotEmpty(t, scenario.Delegations, "a scenario needs a self-delegation")
+	if scenario.Tolerance.IsNil() {
+		scenario.Tolerance = math.LegacyZeroDec()
+	}
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := sdktestutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	accountKeeper := NewMockAccountKeeper(ctrl)
+	stakingKeeper := NewMockStakingKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		moduletestutil.MakeTestEncodingConfig().Codec,
+		runtime.NewKVStoreService(key),
+		accountKeeper,
+		NewMockBankKeeper(ctrl),
+		stakingKeeper,
+		NewMockPoolKeeper(ctrl),
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, types.DefaultParams()))
+
+	// the staking keeper returns the validator and delegations as updated by the scenario
+	val, err := CreateValidator(ed25519.GenPrivKey().PubKey(), scenario.Delegations[0].Amount)
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(scenario.Commission, scenario.Commission, math.LegacyZeroDec())
+	valAddr, err := stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+	require.NoError(t, err)
+
+	delAddrs := simtestutil.CreateIncrementalAccounts(len(scenario.Delegations))
+	delAddrs[0] = sdk.AccAddress(valAddr)
+	dels := make(map[string]stakingtypes.Delegation, len(scenario.Delegations))
+	dels[delAddrs[0].String()] = stakingtypes.NewDelegation(delAddrs[0].String(), val.GetOperator(), val.DelegatorShares)
+
+	stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valAddr)).DoAndReturn(
+		func(context.Context, sdk.ValAddress) (stakingtypes.ValidatorI, error) { return val, nil },
+	).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), gomock.Any(), sdk.ValAddress(valAddr)).DoAndReturn(
+		func(_ context.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) (stakingtypes.DelegationI, error) {
+			return dels[delAddr.String()], nil
+		},
+	).AnyTimes()
+
+	require.NoError(t, CallCreateValidatorHooks(ctx, distrKeeper, delAddrs[0], valAddr))
+
+	// the expected stakes of the delegations, nil until delegated
+	stakes := make([]*math.LegacyDec, len(scenario.Delegations))
+	selfStake := math.LegacyNewDecFromInt(scenario.Delegations[0].Amount)
+	stakes[0] = &selfStake
+
+	expected := make([]math.LegacyDec, len(scenario.Delegations))
+	for i := range expected {
+		expected[i] = math.LegacyZeroDec()
+	}
+
+	for b, block := range scenario.Blocks {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+		for i := 1; i < len(scenario.Delegations); i++ {
+			delegation := scenario.Delegations[i]
+			if delegation.Block != b {
+				continue
+			}
+
+			_, del, err := Delegate(ctx, distrKeeper, delAddrs[i], &val, delegation.Amount, nil, stakingKeeper)
+			require.NoError(t, err)
+			dels[delAddrs[i].String()] = del
+			require.NoError(t, distrKeeper.Hooks().AfterDelegationModified(ctx, delAddrs[i], valAddr))
+
+			stake := math.LegacyNewDecFromInt(delegation.Amount)
+			stakes[i] = &stake
+		}
+
+		if !block.Rewards.IsNil() && block.Rewards.IsPositive() {
+			rewards := math.LegacyNewDecFromInt(block.Rewards)
+			tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: rewards}}
+			require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+			shared := rewards.Mul(math.LegacyOneDec().Sub(scenario.Commission))
+			total := math.LegacyZeroDec()
+			for _, stake := range stakes {
+				if stake != nil {
+					total = total.Add(*stake)
+				}
+			}
+			for i, stake := range stakes {
+				if stake != nil {
+					expected[i] = expected[i].Add(shared.Mul(*stake).Quo(total))
+				}
+			}
+		}
+
+		for _, fraction := range block.Slashes {
+			burned := slashValidator(t, ctx, &distrKeeper, &val, valAddr, fraction)
+			remaining := math.LegacyOneDec().Sub(math.LegacyNewDecFromInt(burned).Quo(math.LegacyNewDecFromInt(val.Tokens.Add(burned))))
+			for _, stake := range stakes {
+				if stake != nil {
+					*stake = stake.Mul(remaining)
+				}
+			}
+		}
+	}
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	endingPeriod, err := distrKeeper.IncrementValidatorPeriod(ctx, val)
+	require.NoError(t, err)
+
+	calculated := make([]sdk.DecCoins, len(scenario.Delegations))
+	for i, delAddr := range delAddrs {
+		if stakes[i] == nil {
+			continue
+		}
+
+		calculated[i], err = distrKeeper.CalculateDelegationRewards(ctx, val, dels[delAddr.String()], endingPeriod)
+		require.NoError(t, err)
+
+		diff := calculated[i].AmountOf(sdk.DefaultBondDenom).Sub(expected[i]).Abs()
+		require.True(t, diff.LTE(scenario.Tolerance),
+			"rewards of delegation %d: calculated %s, expected %s", i, calculated[i], expected[i])
+	}
+
+	return calculated
+}
+
+// slashValidator slashes the given fraction of the validator tokens, calling
+// the distribution hooks as x/staking does. Unlike SlashValidator it honours
+// the slash fraction. It returns the burned tokens.
+func slashValidator(t *testing.T, ctx sdk.Context, distrKeeper *keeper.Keeper, val *stakingtypes.Validator, valAddr sdk.ValAddress, fraction math.LegacyDec) math.Int {
+	t.Helper()
+
+	require.NoError(t, distrKeeper.Hooks().BeforeValidatorModified(ctx, valAddr))
+
+	burned := math.MinInt(math.LegacyNewDecFromInt(val.Tokens).Mul(fraction).TruncateInt(), val.Tokens)
+	if val.Tokens.IsPositive() {
+		effectiveFraction := math.LegacyNewDecFromInt(burned).QuoRoundUp(math.LegacyNewDecFromInt(val.Tokens))
+		require.NoError(t, distrKeeper.Hooks().BeforeValidatorSlashed(ctx, valAddr, effectiveFraction))
+	}
+
+	val.Tokens = val.Tokens.Sub(burned)
+	return burned
+}
@@ -0,0 +1,188 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RemainderStrategy defines what happens to the truncation remainder of the
+// validators' share of an allocation.
+type RemainderStrategy int32
+
+const (
+	// RemainderStrategyDecimalPool keeps the remainder in the decimal pool.
+	RemainderStrategyDecimalPool RemainderStrategy = iota
+	// RemainderStrategyProportional redistributes the remainder to the
+	// rewarded validators proportionally to their power.
+	RemainderStrategyProportional
+	// RemainderStrategyProposer allocates the remainder to the proposer of the
+	// previous block.
+	RemainderStrategyProposer
+)
+
+// String implements the fmt.Stringer interface.
+func (s RemainderStrategy) String() string {
+	switch s {
+	case RemainderStrategyDecimalPool:
+		return "decimal_pool"
+	case RemainderStrategyProportional:
+		return "proportional"
+	case RemainderStrategyProposer:
+		return "proposer"
+	default:
+		return fmt.Sprintf("RemainderStrategy(%d)", int32(s))
+	}
+}
+
+// PowerWeightFunc returns the weight of a validator's voting power used to
+// compute its share of an allocation. It must return a non-negative weight.
+type PowerWeightFunc func(power int64) math.LegacyDec
+
+// PowerSource supplies the decimal voting power of the validators, e.g. on a
+// chain using a power of a higher resolution than the int64 power of the
+// bonded votes.
+type PowerSource interface {
+	// GetValidatorPower returns the decimal power of the validator with the
+	// given consensus address. It must return a non-negative power.
+	GetValidatorPower(ctx context.Context, consAddr sdk.ConsAddress) (math.LegacyDec, error)
+}
+
+// TombstoneFunc reports whether the validator with the given consensus address
+// is tombstoned.
+type TombstoneFunc func(ctx context.Context, consAddr sdk.ConsAddress) bool
+
+// UptimeProvider provides the uptime of the validators the rewards allocated
+// to them are scaled by, e.g. derived from the signed blocks info of
+// x/slashing, without the distribution module depending on it.
+type UptimeProvider interface {
+	// GetUptimeFactors returns the uptime factor, in [0, 1], of the validators
+	// keyed by the bech32 string of their consensus address. A validator
+	// missing from the map has a full uptime.
+	GetUptimeFactors(ctx context.Context) (map[string]math.LegacyDec, error)
+}
+
+// RewardListener is notified of every allocation of tokens to a validator, e.g.
+// to stream the rewards to an indexer without parsing the events.
+//
+// The listeners are called synchronously while the block is processed, in the
+// deterministic order of the allocation. They must not mutate the state nor
+// block, and cannot fail the allocation: anything they do must be invisible to
+// the state machine, or the nodes registering different listeners would
+// diverge.
+type RewardListener interface {
+	// OnValidatorRewardsAllocated is called once the tokens allocated to the
+	// validator have been split into its commission and the rewards shared
+	// with its delegators, and written to the state.
+	OnValidatorRewardsAllocated(ctx context.Context, valAddr sdk.ValAddress, commission, shared sdk.DecCoins)
+}
+
+// AllocationResult holds the totals of a single fee allocation performed by
+// the keeper at the beginning of a block.
+type AllocationResult struct {
+	// TotalFees is the amount collected by the fee collector and allocated,
+	// net of the burned fees and of the infra fund cut.
+	TotalFees sdk.DecCoins
+	// Burned is the amount of the collected fees burned before the allocation.
+	Burned sdk.DecCoins
+	// ToInfraFund is the amount of the collected fees sent to the infra fund
+	// module account before the allocation.
+	ToInfraFund sdk.DecCoins
+	// Subsidy is the amount taken from the community pool and allocated with
+	// the fees to top them up to the subsidy floor.
+	Subsidy sdk.DecCoins
+	// ToValidators is the amount allocated to validators, including their
+	// commission and the proposer reward.
+	ToValidators sdk.DecCoins
+	// ToProposer is the proposer reward allocated to the proposer of the
+	// previous block.
+	ToProposer sdk.DecCoins
+	// Escrowed is the amount allocated to jailed validators and escrowed until
+	// they are bonded again.
+	Escrowed sdk.DecCoins
+	// RoundingDeficit is the amount by which the rounded shares of the
+	// validators exceed their share of the fees. It is drawn from the decimal
+	// pool and only arises in the round rounding mode.
+	RoundingDeficit sdk.DecCoins
+	// CommunityTax is the community tax charged on the fees, added with the
+	// other unallocated rewards to the community pool.
+	CommunityTax sdk.DecCoins
+	// ToCommunityPool is the amount added to the community pool.
+	ToCommunityPool sdk.DecCoins
+	// Routed is the community pool cut to send to the module accounts of the
+	// community pool routes, in the order of the routes.
+	Routed []RoutedCommunityFunds
+	// Remainder is the truncation dust left over after allocating the
+	// validators' share proportionally to their power. It is added to the
+	// decimal pool, unless the remainder strategy allocates it to validators.
+	// While the distribution is paused, it holds all the collected fees.
+	Remainder sdk.DecCoins
+	// Carried is the amount carried over to the next block with bonded power,
+	// the fees of a block with no bonded power under the carry zero power
+	// strategy.
+	Carried sdk.DecCoins
+	// Released is the amount carried over from the blocks with no bonded power
+	// and allocated with the fees. It is not counted as collected.
+	Released sdk.DecCoins
+	// ValidatorsRewarded is the number of validators that received a reward.
+	ValidatorsRewarded int
+}
+
+// RoutedCommunityFunds is the share of the community pool cut of an allocation
+// sent to the module account of a community pool route.
+type RoutedCommunityFunds struct {
+	// Module is the name of the module of the route.
+	Module string
+	// Amount is the amount sent to the module account.
+	Amount sdk.Coins
+}
+
+// SplitTokensByCommission splits the tokens allocated to a validator between
+// its commission, at the given commission rate, and the rewards shared with
+// its delegators. The commission is rounded per denom and the shared rewards
+// are the difference, so that both always add up to tokens exactly.
+func SplitTokensByCommission(tokens sdk.DecCoins, commissionRate math.LegacyDec) (commission, shared sdk.DecCoins) {
+	commission = tokens.MulDec(commissionRate)
+	shared = tokens.Sub(commission)
+	return commission, shared
+}
+
+// SplitTokensByCommissionExempt splits the tokens allocated to a validator as
+// SplitTokensByCommission does, except for the tokens in the exempt denoms,
+// which are shared in full with its delegators.
+func SplitTokensByCommissionExempt(tokens sdk.DecCoins, commissionRate math.LegacyDec, exempt []string) (commission, shared sdk.DecCoins) {
+	if len(exempt) == 0 {
+		return SplitTokensByCommission(tokens, commissionRate)
+	}
+
+	exempted := sdk.DecCoins{}
+	for _, denom := range exempt {
+		if amount := tokens.AmountOf(denom); amount.IsPositive() {
+			exempted = exempted.Add(sdk.NewDecCoinFromDec(denom, amount))
+		}
+	}
+
+	commission, shared = SplitTokensByCommission(tokens.Sub(exempted), commissionRate)
+	return commission, shared.Add(exempted...)
+}
+
+// SanitizeFees returns the fees without their zero amounts, which would make
+// the coins derived from them invalid. A negative amount is rejected with
+// ErrInvalidFees.
+func SanitizeFees(fees sdk.Coins) (sdk.Coins, error) {
+	sanitized := make(sdk.Coins, 0, len(fees))
+	for _, fee := range fees {
+		switch {
+		case fee.Amount.IsNil() || fee.Amount.IsZero():
+			continue
+		case fee.Amount.IsNegative():
+			return nil, ErrInvalidFees.Wrapf("negative amount %s", fee)
+		}
+		sanitized = append(sanitized, fee)
+	}
+
+	return sanitized, nil
+}
@@ -0,0 +1,157 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSplitTokensByCommission(t *testing.T) {
+	decCoins := func(amount string) sdk.DecCoins {
+		return sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr(amount))}
+	}
+
+	testCases := []struct {
+		name          string
+		tokens        sdk.DecCoins
+		rate          math.LegacyDec
+		expCommission sdk.DecCoins
+		expShared     sdk.DecCoins
+	}{
+		{"no commission", decCoins("10"), math.LegacyZeroDec(), sdk.DecCoins{}, decCoins("10")},
+		{"full commission", decCoins("10"), math.LegacyOneDec(), decCoins("10"), sdk.DecCoins{}},
+		{"half commission", decCoins("10"), math.LegacyNewDecWithPrec(5, 1), decCoins("5"), decCoins("5")},
+		{
+			// 1.5e-18 is rounded half to even
+			"rounded commission",
+			decCoins("0.000000000000000003"), math.LegacyNewDecWithPrec(5, 1),
+			decCoins("0.000000000000000002"), decCoins("0.000000000000000001"),
+		},
+		{"no tokens", sdk.DecCoins{}, math.LegacyNewDecWithPrec(5, 1), sdk.DecCoins{}, sdk.DecCoins{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			commission, shared := types.SplitTokensByCommission(tc.tokens, tc.rate)
+			require.True(t, tc.expCommission.Equal(commission), "commission: %s", commission)
+			require.True(t, tc.expShared.Equal(shared), "shared: %s", shared)
+		})
+	}
+}
+
+func TestSplitTokensByCommissionExempt(t *testing.T) {
+	tokens := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(10)),
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(10)),
+	)
+	rate := math.LegacyNewDecWithPrec(2, 1)
+
+	testCases := []struct {
+		name          string
+		exempt        []string
+		expCommission sdk.DecCoins
+		expShared     sdk.DecCoins
+	}{
+		{
+			"no exempt denoms",
+			nil,
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(2)), sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(2))),
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(8)), sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(8))),
+		},
+		{
+			"one exempt denom",
+			[]string{"atom"},
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(2))),
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(10)), sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(8))),
+		},
+		{
+			"all denoms exempt",
+			[]string{"atom", sdk.DefaultBondDenom},
+			sdk.DecCoins{},
+			tokens,
+		},
+		{
+			"exempt denom not allocated",
+			[]string{"osmo"},
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(2)), sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(2))),
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(8)), sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(8))),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			commission, shared := types.SplitTokensByCommissionExempt(tokens, rate, tc.exempt)
+			require.True(t, tc.expCommission.Equal(commission), "commission: %s", commission)
+			require.True(t, tc.expShared.Equal(shared), "shared: %s", shared)
+			require.True(t, tokens.Equal(commission.Add(shared...)))
+		})
+	}
+}
+
+func TestSplitTokensByCommissionProperties(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		denoms := rapid.SliceOfNDistinct(rapid.StringMatching(`[a-z]{3,10}`), 0, 5, rapid.ID[string]).Draw(t, "denoms")
+		tokens := sdk.DecCoins{}
+		for _, denom := range denoms {
+			amount := math.LegacyNewDecWithPrec(rapid.Int64Min(1).Draw(t, "amount"), rapid.Int64Range(0, math.LegacyPrecision).Draw(t, "precision"))
+			tokens = tokens.Add(sdk.NewDecCoinFromDec(denom, amount))
+		}
+		rate := math.LegacyNewDecWithPrec(rapid.Int64Range(0, 1e18).Draw(t, "rate"), math.LegacyPrecision)
+
+		commission, shared := types.SplitTokensByCommission(tokens, rate)
+
+		// commission and shared add up to tokens exactly
+		require.True(t, tokens.Equal(commission.Add(shared...)), "%s != %s + %s", tokens, commission, shared)
+		require.False(t, commission.IsAnyNegative())
+		require.False(t, shared.IsAnyNegative())
+	})
+}
+
+func TestSanitizeFees(t *testing.T) {
+	testCases := []struct {
+		name   string
+		fees   sdk.Coins
+		exp    sdk.Coins
+		expErr bool
+	}{
+		{"no fees", nil, sdk.Coins{}, false},
+		{
+			"valid fees",
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			false,
+		},
+		{
+			"zero amount",
+			sdk.Coins{sdk.NewInt64Coin("atom", 0), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)},
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			false,
+		},
+		{"nil amount", sdk.Coins{{Denom: "atom"}}, sdk.Coins{}, false},
+		{
+			"negative amount",
+			sdk.Coins{sdk.NewInt64Coin("atom", 5), {Denom: sdk.DefaultBondDenom, Amount: math.NewInt(-1)}},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fees, err := types.SanitizeFees(tc.fees)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidFees)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, fees)
+			require.True(t, fees.IsValid())
+		})
+	}
+}
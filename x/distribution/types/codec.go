@@ -17,6 +17,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgDepositValidatorRewardsPool{}, "cosmos-sdk/distr/MsgDepositValRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawAllDelegatorRewards{}, "cosmos-sdk/distr/MsgWithdrawAllRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgSetValidatorCommissionWithdrawAddress{}, "cosmos-sdk/distr/MsgSetCommissionAddr")
+	legacy.RegisterAminoMsg(cdc, &MsgSetWithdrawSplit{}, "cosmos-sdk/distr/MsgSetWithdrawSplit")
+	legacy.RegisterAminoMsg(cdc, &MsgSetValidatorCommissionCap{}, "cosmos-sdk/distr/MsgSetCommissionCap")
+	legacy.RegisterAminoMsg(cdc, &MsgSetValidatorCommissionExemptDenoms{}, "cosmos-sdk/distr/MsgSetCommissionExempt")
+	legacy.RegisterAminoMsg(cdc, &MsgSetValidatorRewardsFrozen{}, "cosmos-sdk/distr/MsgSetValRewardsFrozen")
+	legacy.RegisterAminoMsg(cdc, &MsgSetValidatorCommissionRebated{}, "cosmos-sdk/distr/MsgSetValCommRebated")
+	legacy.RegisterAminoMsg(cdc, &MsgSetFeeCollectorName{}, "cosmos-sdk/distr/MsgSetFeeCollectorName")
+	legacy.RegisterAminoMsg(cdc, &MsgSetValidatorRewardMultiplier{}, "cosmos-sdk/distr/MsgSetValRewardMult")
+	legacy.RegisterAminoMsg(cdc, &MsgCommunityPoolSpendBatch{}, "cosmos-sdk/distr/MsgCommPoolSpendBatch")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawAndCompound{}, "cosmos-sdk/distr/MsgWithdrawAndCompound")
+	legacy.RegisterAminoMsg(cdc, &MsgAllocateValidatorRewards{}, "cosmos-sdk/distr/MsgAllocateValRewards")
 
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/distribution/Params", nil)
 }
@@ -29,6 +41,18 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSetWithdrawAddress{},
 		&MsgUpdateParams{},
 		&MsgDepositValidatorRewardsPool{},
+		&MsgWithdrawAllDelegatorRewards{},
+		&MsgSetValidatorCommissionWithdrawAddress{},
+		&MsgSetWithdrawSplit{},
+		&MsgSetValidatorCommissionCap{},
+		&MsgSetValidatorCommissionExemptDenoms{},
+		&MsgSetValidatorRewardsFrozen{},
+		&MsgSetValidatorCommissionRebated{},
+		&MsgSetFeeCollectorName{},
+		&MsgSetValidatorRewardMultiplier{},
+		&MsgCommunityPoolSpendBatch{},
+		&MsgWithdrawAndCompound{},
+		&MsgAllocateValidatorRewards{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
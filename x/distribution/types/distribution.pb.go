@@ -12,15 +12,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,16 +32,202 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RoundingMode defines how the validators' shares of the collected fees are
+// rounded to the decimal precision.
+type RoundingMode int32
+
+const (
+	// ROUNDING_MODE_TRUNCATE truncates the shares, the truncation remainder is
+	// left in the decimal pool.
+	RoundingModeTruncate RoundingMode = 0
+	// ROUNDING_MODE_ROUND rounds the shares half to even, the over-allocation
+	// of the rounded shares is drawn from the decimal pool.
+	RoundingModeRound RoundingMode = 1
+)
+
+var RoundingMode_name = map[int32]string{
+	0: "ROUNDING_MODE_TRUNCATE",
+	1: "ROUNDING_MODE_ROUND",
+}
+
+var RoundingMode_value = map[string]int32{
+	"ROUNDING_MODE_TRUNCATE": 0,
+	"ROUNDING_MODE_ROUND":    1,
+}
+
+func (x RoundingMode) String() string {
+	return proto.EnumName(RoundingMode_name, int32(x))
+}
+
+func (RoundingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{0}
+}
+
+// ZeroPowerStrategy defines where the fees collected in a block with no bonded
+// power, which cannot be allocated to the validators, are allocated.
+type ZeroPowerStrategy int32
+
+const (
+	// ZERO_POWER_STRATEGY_COMMUNITY_POOL allocates the fees to the community
+	// pool, split between the community pool routes.
+	ZeroPowerStrategyCommunityPool ZeroPowerStrategy = 0
+	// ZERO_POWER_STRATEGY_DECIMAL_POOL adds the fees to the decimal pool.
+	ZeroPowerStrategyDecimalPool ZeroPowerStrategy = 1
+	// ZERO_POWER_STRATEGY_CARRY holds the fees in the fee pool and allocates
+	// them with the fees of the next block with bonded power.
+	ZeroPowerStrategyCarry ZeroPowerStrategy = 2
+)
+
+var ZeroPowerStrategy_name = map[int32]string{
+	0: "ZERO_POWER_STRATEGY_COMMUNITY_POOL",
+	1: "ZERO_POWER_STRATEGY_DECIMAL_POOL",
+	2: "ZERO_POWER_STRATEGY_CARRY",
+}
+
+var ZeroPowerStrategy_value = map[string]int32{
+	"ZERO_POWER_STRATEGY_COMMUNITY_POOL": 0,
+	"ZERO_POWER_STRATEGY_DECIMAL_POOL":   1,
+	"ZERO_POWER_STRATEGY_CARRY":          2,
+}
+
+func (x ZeroPowerStrategy) String() string {
+	return proto.EnumName(ZeroPowerStrategy_name, int32(x))
+}
+
+func (ZeroPowerStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{1}
+}
+
 // Params defines the set of params for the distribution module.
 type Params struct {
 	CommunityTax cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_tax"`
-	// Deprecated: The base_proposer_reward field is deprecated and is no longer used
-	// in the x/distribution module's reward mechanism.
-	BaseProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_proposer_reward"` // Deprecated: Do not use.
-	// Deprecated: The bonus_proposer_reward field is deprecated and is no longer used
-	// in the x/distribution module's reward mechanism.
-	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
+	// base_proposer_reward defines the fraction of the collected fees allocated
+	// to the proposer of the previous block. Zero disables the proposer reward.
+	BaseProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_proposer_reward"`
+	// bonus_proposer_reward defines the additional fraction of the collected fees
+	// allocated to the proposer of the previous block, scaled by the fraction of
+	// the voting power that signed that block.
+	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// decimal_pool_flush_threshold defines the integer amount of a denom above
+	// which the decimal pool is moved to the community pool at the end of the fee
+	// allocation. Zero disables the automatic flush.
+	DecimalPoolFlushThreshold cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=decimal_pool_flush_threshold,json=decimalPoolFlushThreshold,proto3,customtype=cosmossdk.io/math.Int" json:"decimal_pool_flush_threshold"`
+	// distribution_paused defines whether the allocation of the collected fees
+	// to the validators and the community pool is paused. While it is true, the
+	// collected fees are held in the decimal pool.
+	DistributionPaused bool `protobuf:"varint,30,opt,name=distribution_paused,json=distributionPaused,proto3" json:"distribution_paused,omitempty"`
+	// withhold_jailed_rewards defines whether the reward share of a jailed
+	// validator found in the bonded votes is added to the community pool instead
+	// of being credited to the validator.
+	WithholdJailedRewards bool `protobuf:"varint,7,opt,name=withhold_jailed_rewards,json=withholdJailedRewards,proto3" json:"withhold_jailed_rewards,omitempty"`
+	// min_commission_rate defines the minimum commission rate applied when
+	// splitting the rewards of a validator, regardless of a lower rate set by the
+	// validator. Zero applies the validators' rates as is.
+	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate"`
+	// burn_rate defines the fraction of the collected fees burned before they are
+	// allocated. Zero disables the burn.
+	BurnRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=burn_rate,json=burnRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_rate"`
+	// min_self_bond_for_rewards defines the amount of tokens a validator must
+	// self-delegate to be credited its reward share. The reward share of a
+	// validator whose self-delegation is lower is added to the community pool.
+	// Zero rewards every validator regardless of its self-delegation.
+	MinSelfBondForRewards cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=min_self_bond_for_rewards,json=minSelfBondForRewards,proto3,customtype=cosmossdk.io/math.Int" json:"min_self_bond_for_rewards"`
+	// subsidy_floor defines, per denom, the amount of fees below which the
+	// allocation tops up the collected fees from the community pool, as far as
+	// the community pool holds the difference. An empty floor disables the
+	// subsidy.
+	SubsidyFloor github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=subsidy_floor,json=subsidyFloor,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"subsidy_floor"`
+	// escrow_jailed_rewards defines whether the reward share of a jailed
+	// validator found in the bonded votes is escrowed until the validator is
+	// bonded again instead of being credited to the validator. The escrow of a
+	// tombstoned validator is forfeited to the community pool.
+	EscrowJailedRewards bool `protobuf:"varint,12,opt,name=escrow_jailed_rewards,json=escrowJailedRewards,proto3" json:"escrow_jailed_rewards,omitempty"`
+	// distributable_denoms defines the denoms of the collected fees that are
+	// allocated. The fees in other denoms are left in the fee collector. An empty
+	// list allocates the fees in every denom.
+	DistributableDenoms []string `protobuf:"bytes,13,rep,name=distributable_denoms,json=distributableDenoms,proto3" json:"distributable_denoms,omitempty"`
+	// rounding_mode defines how the validators' shares of the collected fees are
+	// rounded.
+	RoundingMode RoundingMode `protobuf:"varint,14,opt,name=rounding_mode,json=roundingMode,proto3,enum=cosmos.distribution.v1beta1.RoundingMode" json:"rounding_mode,omitempty"`
+	// max_withdraw_per_block defines, per denom, the amount of delegation
+	// rewards a delegator can withdraw in a block. The rewards above it are
+	// carried over to the next blocks. An empty cap disables it.
+	MaxWithdrawPerBlock github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=max_withdraw_per_block,json=maxWithdrawPerBlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_withdraw_per_block"`
+	// commission_grace_period_blocks defines the number of blocks after its
+	// creation during which a validator earns no commission, all its rewards
+	// going to its delegators. Zero disables the grace period.
+	CommissionGracePeriodBlocks uint64 `protobuf:"varint,16,opt,name=commission_grace_period_blocks,json=commissionGracePeriodBlocks,proto3" json:"commission_grace_period_blocks,omitempty"`
+	// community_pool_routes defines how the community pool cut of the collected
+	// fees is split between modules. The share routed to x/protocolpool is added
+	// to the community pool, the share routed to another module is sent to its
+	// module account. The dust is allocated to the first route. An empty list
+	// adds the whole cut to the community pool.
+	CommunityPoolRoutes []CommunityPoolRoute `protobuf:"bytes,17,rep,name=community_pool_routes,json=communityPoolRoutes,proto3" json:"community_pool_routes"`
+	// zero_power_strategy defines where the fees collected in a block with no
+	// bonded power are allocated.
+	ZeroPowerStrategy ZeroPowerStrategy `protobuf:"varint,18,opt,name=zero_power_strategy,json=zeroPowerStrategy,proto3,enum=cosmos.distribution.v1beta1.ZeroPowerStrategy" json:"zero_power_strategy,omitempty"`
+	// decimal_pool_flush_interval defines the number of blocks between two
+	// flushes of the decimal pool to the community pool, in the end blocker.
+	// Zero disables the periodic flush.
+	DecimalPoolFlushInterval uint64 `protobuf:"varint,19,opt,name=decimal_pool_flush_interval,json=decimalPoolFlushInterval,proto3" json:"decimal_pool_flush_interval,omitempty"`
+	// record_commission_history defines whether the commission withdrawals of
+	// the validators are recorded, to be queried with the
+	// ValidatorCommissionWithdrawals rpc.
+	RecordCommissionHistory bool `protobuf:"varint,20,opt,name=record_commission_history,json=recordCommissionHistory,proto3" json:"record_commission_history,omitempty"`
+	// infra_fund_rate defines the fraction of the collected fees, net of the
+	// burned fees, sent to the module account of infra_fund_module before the
+	// community tax and the validators' share are computed. Zero disables the
+	// infra fund.
+	InfraFundRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,21,opt,name=infra_fund_rate,json=infraFundRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"infra_fund_rate"`
+	// infra_fund_module defines the name of the module whose account receives
+	// the infra fund cut of the collected fees.
+	InfraFundModule string `protobuf:"bytes,22,opt,name=infra_fund_module,json=infraFundModule,proto3" json:"infra_fund_module,omitempty"`
+	// commission_rebate_rate defines the fraction of the commission of a rebated
+	// validator, charged on every allocation to it, that is drawn from the
+	// community pool and added to the rewards of its delegators. Zero disables
+	// the rebates.
+	CommissionRebateRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,23,opt,name=commission_rebate_rate,json=commissionRebateRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rebate_rate"`
+	// min_withdraw_amount defines, per denom, the minimum amount of delegation
+	// rewards paid by a withdrawal. The rewards below it are left unclaimed,
+	// accruing until a later withdrawal reaches it. An empty minimum disables
+	// it.
+	MinWithdrawAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,24,rep,name=min_withdraw_amount,json=minWithdrawAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_withdraw_amount"`
+	// max_rewarded_validators defines the maximum number of validators of the
+	// bonded votes rewarded in a block, those with the most power. The share of
+	// the other validators is added to the community pool. Zero rewards every
+	// validator.
+	MaxRewardedValidators uint64 `protobuf:"varint,25,opt,name=max_rewarded_validators,json=maxRewardedValidators,proto3" json:"max_rewarded_validators,omitempty"`
+	// allocation_epoch_blocks defines the number of blocks of an allocation
+	// epoch. The fees collected during an epoch are held in the carried fees of
+	// the fee pool and allocated at its last block to the validators
+	// proportionally to their power averaged over the epoch. Zero or one
+	// allocates the fees every block.
+	AllocationEpochBlocks uint64 `protobuf:"varint,26,opt,name=allocation_epoch_blocks,json=allocationEpochBlocks,proto3" json:"allocation_epoch_blocks,omitempty"`
+	// community_pool_denom_destinations defines the modules the community pool
+	// funds of specific denoms are sent to instead of x/protocolpool, both for
+	// the community pool cut of the collected fees and for the flushes of the
+	// decimal pool. The denoms without a destination go to x/protocolpool.
+	CommunityPoolDenomDestinations []CommunityPoolDenomDestination `protobuf:"bytes,27,rep,name=community_pool_denom_destinations,json=communityPoolDenomDestinations,proto3" json:"community_pool_denom_destinations"`
+	// record_lifetime_commission defines whether the cumulative commission
+	// withdrawn by every validator over its lifetime is recorded, to be
+	// returned by the keeper's ValidatorLifetimeCommission.
+	RecordLifetimeCommission bool `protobuf:"varint,28,opt,name=record_lifetime_commission,json=recordLifetimeCommission,proto3" json:"record_lifetime_commission,omitempty"`
+	// community_tax_history_length defines the number of community tax changes
+	// kept in the community tax history, to be read with the keeper's
+	// CommunityTaxAt. Zero disables the recording.
+	CommunityTaxHistoryLength uint64 `protobuf:"varint,29,opt,name=community_tax_history_length,json=communityTaxHistoryLength,proto3" json:"community_tax_history_length,omitempty"`
+	// keep_community_funds_local defines whether the share of the community
+	// pool cut of the collected fees routed to x/protocolpool is credited to
+	// the community pool held in the fee pool, in the distribution module
+	// account. When false, its whole coins are sent to the x/protocolpool
+	// module account and only its sub-unit dust is kept in the fee pool.
+	KeepCommunityFundsLocal bool `protobuf:"varint,31,opt,name=keep_community_funds_local,json=keepCommunityFundsLocal,proto3" json:"keep_community_funds_local,omitempty"`
+	// sticky_withdraw_address defines whether the withdraw address of a
+	// delegator is kept when the delegator removes its last delegation, to be
+	// reused by its later delegations. When false, it is removed with the last
+	// delegation.
+	StickyWithdrawAddress bool `protobuf:"varint,32,opt,name=sticky_withdraw_address,json=stickyWithdrawAddress,proto3" json:"sticky_withdraw_address,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +270,259 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDistributionPaused() bool {
+	if m != nil {
+		return m.DistributionPaused
+	}
+	return false
+}
+
+func (m *Params) GetWithholdJailedRewards() bool {
+	if m != nil {
+		return m.WithholdJailedRewards
+	}
+	return false
+}
+
+func (m *Params) GetSubsidyFloor() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SubsidyFloor
+	}
+	return nil
+}
+
+func (m *Params) GetEscrowJailedRewards() bool {
+	if m != nil {
+		return m.EscrowJailedRewards
+	}
+	return false
+}
+
+func (m *Params) GetDistributableDenoms() []string {
+	if m != nil {
+		return m.DistributableDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetRoundingMode() RoundingMode {
+	if m != nil {
+		return m.RoundingMode
+	}
+	return RoundingModeTruncate
+}
+
+func (m *Params) GetMaxWithdrawPerBlock() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxWithdrawPerBlock
+	}
+	return nil
+}
+
+func (m *Params) GetCommissionGracePeriodBlocks() uint64 {
+	if m != nil {
+		return m.CommissionGracePeriodBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetCommunityPoolRoutes() []CommunityPoolRoute {
+	if m != nil {
+		return m.CommunityPoolRoutes
+	}
+	return nil
+}
+
+func (m *Params) GetZeroPowerStrategy() ZeroPowerStrategy {
+	if m != nil {
+		return m.ZeroPowerStrategy
+	}
+	return ZeroPowerStrategyCommunityPool
+}
+
+func (m *Params) GetDecimalPoolFlushInterval() uint64 {
+	if m != nil {
+		return m.DecimalPoolFlushInterval
+	}
+	return 0
+}
+
+func (m *Params) GetRecordCommissionHistory() bool {
+	if m != nil {
+		return m.RecordCommissionHistory
+	}
+	return false
+}
+
+func (m *Params) GetInfraFundModule() string {
+	if m != nil {
+		return m.InfraFundModule
+	}
+	return ""
+}
+
+func (m *Params) GetMinWithdrawAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinWithdrawAmount
+	}
+	return nil
+}
+
+func (m *Params) GetMaxRewardedValidators() uint64 {
+	if m != nil {
+		return m.MaxRewardedValidators
+	}
+	return 0
+}
+
+func (m *Params) GetAllocationEpochBlocks() uint64 {
+	if m != nil {
+		return m.AllocationEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetCommunityPoolDenomDestinations() []CommunityPoolDenomDestination {
+	if m != nil {
+		return m.CommunityPoolDenomDestinations
+	}
+	return nil
+}
+
+func (m *Params) GetRecordLifetimeCommission() bool {
+	if m != nil {
+		return m.RecordLifetimeCommission
+	}
+	return false
+}
+
+func (m *Params) GetCommunityTaxHistoryLength() uint64 {
+	if m != nil {
+		return m.CommunityTaxHistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetKeepCommunityFundsLocal() bool {
+	if m != nil {
+		return m.KeepCommunityFundsLocal
+	}
+	return false
+}
+
+func (m *Params) GetStickyWithdrawAddress() bool {
+	if m != nil {
+		return m.StickyWithdrawAddress
+	}
+	return false
+}
+
+// CommunityPoolDenomDestination defines the module the community pool funds of
+// a denom are sent to.
+type CommunityPoolDenomDestination struct {
+	// denom defines the denom of the funds.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// module defines the name of the module the funds are sent to.
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+}
+
+func (m *CommunityPoolDenomDestination) Reset()         { *m = CommunityPoolDenomDestination{} }
+func (m *CommunityPoolDenomDestination) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolDenomDestination) ProtoMessage()    {}
+func (*CommunityPoolDenomDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{1}
+}
+func (m *CommunityPoolDenomDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolDenomDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolDenomDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolDenomDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolDenomDestination.Merge(m, src)
+}
+func (m *CommunityPoolDenomDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolDenomDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolDenomDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolDenomDestination proto.InternalMessageInfo
+
+func (m *CommunityPoolDenomDestination) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *CommunityPoolDenomDestination) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+// CommunityPoolRoute defines the share of the community pool cut of the
+// collected fees allocated to a module.
+type CommunityPoolRoute struct {
+	// module defines the name of the module the share is allocated to.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// weight defines the share of the community pool cut allocated to the
+	// module.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *CommunityPoolRoute) Reset()         { *m = CommunityPoolRoute{} }
+func (m *CommunityPoolRoute) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolRoute) ProtoMessage()    {}
+func (*CommunityPoolRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{2}
+}
+func (m *CommunityPoolRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolRoute.Merge(m, src)
+}
+func (m *CommunityPoolRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolRoute proto.InternalMessageInfo
+
+func (m *CommunityPoolRoute) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -102,7 +545,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{1}
+	return fileDescriptor_cd78a31ea281a992, []int{3}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +600,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{2}
+	return fileDescriptor_cd78a31ea281a992, []int{4}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +653,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{3}
+	return fileDescriptor_cd78a31ea281a992, []int{5}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +699,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{4}
+	return fileDescriptor_cd78a31ea281a992, []int{6}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +748,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{5}
+	return fileDescriptor_cd78a31ea281a992, []int{7}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +793,7 @@ func (m *ValidatorSlashEvents) Reset()         { *m = ValidatorSlashEvents{} }
 func (m *ValidatorSlashEvents) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvents) ProtoMessage()    {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{6}
+	return fileDescriptor_cd78a31ea281a992, []int{8}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,13 +832,19 @@ func (m *ValidatorSlashEvents) GetValidatorSlashEvents() []ValidatorSlashEvent {
 // FeePool is the global fee pool for distribution.
 type FeePool struct {
 	CommunityPool github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"community_pool"`
+	// decimal_pool holds the sub-unit remainder left over from truncating the
+	// validators' share of the fees during allocation.
+	DecimalPool github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=decimal_pool,json=decimalPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"decimal_pool"`
+	// carried_fees holds the fees collected in blocks with no bonded power,
+	// carried over to the next block with bonded power.
+	CarriedFees github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=carried_fees,json=carriedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"carried_fees"`
 }
 
 func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{7}
+	return fileDescriptor_cd78a31ea281a992, []int{9}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,6 +880,20 @@ func (m *FeePool) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.DecCoins
 	return nil
 }
 
+func (m *FeePool) GetDecimalPool() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.DecimalPool
+	}
+	return nil
+}
+
+func (m *FeePool) GetCarriedFees() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CarriedFees
+	}
+	return nil
+}
+
 // CommunityPoolSpendProposal details a proposal for use of community funds,
 // together with how many coins are proposed to be spent, and to which
 // recipient account.
@@ -452,7 +915,7 @@ func (m *CommunityPoolSpendProposal) Reset()         { *m = CommunityPoolSpendPr
 func (m *CommunityPoolSpendProposal) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposal) ProtoMessage()    {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{8}
+	return fileDescriptor_cd78a31ea281a992, []int{10}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -497,7 +960,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,7 +1014,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,32 +1043,27 @@ func (m *DelegationDelegatorReward) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegationDelegatorReward proto.InternalMessageInfo
 
-// CommunityPoolSpendProposalWithDeposit defines a CommunityPoolSpendProposal
-// with a deposit
-//
-// Deprecated: Do not use.
-//
-// Deprecated: Do not use.
-type CommunityPoolSpendProposalWithDeposit struct {
-	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Recipient   string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Deposit     string `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+// WithdrawSplitEntry defines an address receiving a share of the rewards of a
+// delegator upon withdrawal.
+type WithdrawSplitEntry struct {
+	// address defines the address receiving the share.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight defines the share of the rewards, the weights of a split sum to one.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = CommunityPoolSpendProposalWithDeposit{} }
-func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
-func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
-func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+func (m *WithdrawSplitEntry) Reset()         { *m = WithdrawSplitEntry{} }
+func (m *WithdrawSplitEntry) String() string { return proto.CompactTextString(m) }
+func (*WithdrawSplitEntry) ProtoMessage()    {}
+func (*WithdrawSplitEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
 }
-func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+func (m *WithdrawSplitEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommunityPoolSpendProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WithdrawSplitEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommunityPoolSpendProposalWithDeposit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WithdrawSplitEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -615,1217 +1073,9132 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *CommunityPoolSpendProposalWithDeposit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommunityPoolSpendProposalWithDeposit.Merge(m, src)
+func (m *WithdrawSplitEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawSplitEntry.Merge(m, src)
 }
-func (m *CommunityPoolSpendProposalWithDeposit) XXX_Size() int {
+func (m *WithdrawSplitEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommunityPoolSpendProposalWithDeposit.DiscardUnknown(m)
+func (m *WithdrawSplitEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawSplitEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
+var xxx_messageInfo_WithdrawSplitEntry proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
-	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
-	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
-	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
-	proto.RegisterType((*ValidatorOutstandingRewards)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewards")
-	proto.RegisterType((*ValidatorSlashEvent)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvent")
-	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
-	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
-	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
-	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
-	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
-	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+func (m *WithdrawSplitEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("cosmos/distribution/v1beta1/distribution.proto", fileDescriptor_cd78a31ea281a992)
+// WithdrawSplit defines the addresses the rewards of a delegator are split
+// between upon withdrawal, in place of its withdraw address.
+type WithdrawSplit struct {
+	Entries []WithdrawSplitEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xc1, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf6, 0x34, 0x89, 0xd3, 0x4c, 0xdb, 0xe4, 0xd7, 0x89, 0x93, 0x3a, 0x6e, 0x7f, 0xb6, 0xbb,
-	0xa2, 0xc2, 0x04, 0x62, 0x93, 0x22, 0x21, 0x94, 0x0b, 0x6a, 0xec, 0x56, 0x20, 0x15, 0x88, 0x36,
-	0x08, 0x24, 0x38, 0xac, 0xc6, 0xbb, 0x13, 0x7b, 0xc8, 0xee, 0xcc, 0x32, 0x33, 0x76, 0x92, 0x03,
-	0xf7, 0xc0, 0x01, 0xb8, 0x81, 0x7a, 0xaa, 0xe0, 0x52, 0x71, 0xca, 0x21, 0x7f, 0x44, 0xc5, 0xa9,
-	0xaa, 0x00, 0x21, 0x0e, 0x01, 0x92, 0x43, 0x10, 0x7f, 0x05, 0x9a, 0x9d, 0xf1, 0xae, 0x13, 0x02,
-	0x2a, 0x45, 0x16, 0x97, 0x28, 0xf3, 0xde, 0xec, 0xfb, 0xbe, 0xef, 0xcd, 0x37, 0x6f, 0x0c, 0xeb,
-	0x3e, 0x97, 0x11, 0x97, 0x8d, 0x80, 0x4a, 0x25, 0x68, 0xbb, 0xa7, 0x28, 0x67, 0x8d, 0xfe, 0x72,
-	0x9b, 0x28, 0xbc, 0x7c, 0x22, 0x58, 0x8f, 0x05, 0x57, 0x1c, 0x5d, 0x35, 0xfb, 0xeb, 0x27, 0x52,
-	0x76, 0x7f, 0xa9, 0xd0, 0xe1, 0x1d, 0x9e, 0xec, 0x6b, 0xe8, 0xff, 0xcc, 0x27, 0xa5, 0xb2, 0x85,
-	0x68, 0x63, 0x49, 0xd2, 0xd2, 0x3e, 0xa7, 0xb6, 0x64, 0x69, 0xc1, 0xe4, 0x3d, 0xf3, 0xa1, 0xad,
-	0x6f, 0x52, 0x97, 0x71, 0x44, 0x19, 0x6f, 0x24, 0x7f, 0x4d, 0xc8, 0xb9, 0x37, 0x06, 0xf3, 0x6b,
-	0x58, 0xe0, 0x48, 0xa2, 0xf7, 0xe1, 0x25, 0x9f, 0x47, 0x51, 0x8f, 0x51, 0xb5, 0xe3, 0x29, 0xbc,
-	0x5d, 0x04, 0x55, 0x50, 0x9b, 0x5a, 0x7d, 0xf9, 0xe1, 0x41, 0x25, 0xf7, 0xd3, 0x41, 0xc5, 0x52,
-	0x95, 0xc1, 0x66, 0x9d, 0xf2, 0x46, 0x84, 0x55, 0xb7, 0x7e, 0x97, 0x74, 0xb0, 0xbf, 0xd3, 0x22,
-	0xfe, 0xe3, 0xfd, 0x25, 0x68, 0x91, 0x5a, 0xc4, 0x7f, 0x70, 0xbc, 0xb7, 0x08, 0xdc, 0x8b, 0x69,
-	0xb1, 0xb7, 0xf1, 0x36, 0xfa, 0x00, 0x16, 0x34, 0x61, 0xcd, 0x2a, 0xe6, 0x92, 0x08, 0x4f, 0x90,
-	0x2d, 0x2c, 0x82, 0xe2, 0xb9, 0x04, 0xe3, 0x95, 0xa7, 0xc3, 0x28, 0x02, 0x17, 0xe9, 0xaa, 0x6b,
-	0xb6, 0xa8, 0x9b, 0xd4, 0x44, 0x21, 0x9c, 0x6b, 0x73, 0xd6, 0x93, 0x7f, 0x02, 0x1b, 0xfb, 0x97,
-	0x60, 0xb3, 0x49, 0xd9, 0x53, 0x68, 0x37, 0xe1, 0xdc, 0x16, 0x55, 0xdd, 0x40, 0xe0, 0x2d, 0x0f,
-	0x07, 0x81, 0xf0, 0x08, 0xc3, 0xed, 0x90, 0x04, 0xc5, 0xf1, 0x2a, 0xa8, 0x9d, 0x77, 0x67, 0x07,
-	0xc9, 0x5b, 0x41, 0x20, 0x6e, 0x9b, 0xd4, 0xca, 0x8d, 0x4f, 0x8e, 0xf7, 0x16, 0xab, 0x06, 0x60,
-	0x49, 0x06, 0x9b, 0x8d, 0xed, 0x93, 0x8e, 0x31, 0x27, 0xe2, 0xfc, 0x00, 0x60, 0xe9, 0x1d, 0x1c,
-	0xd2, 0x00, 0x2b, 0x2e, 0x5e, 0xa3, 0x52, 0x71, 0x41, 0x7d, 0x1c, 0x1a, 0x60, 0x89, 0x3e, 0x05,
-	0xf0, 0x8a, 0xdf, 0x8b, 0x7a, 0x21, 0x56, 0xb4, 0x4f, 0xac, 0x48, 0x4f, 0x60, 0x45, 0x79, 0x11,
-	0x54, 0xc7, 0x6a, 0x17, 0x6e, 0x5e, 0xb3, 0x7e, 0xac, 0xeb, 0x2e, 0x0d, 0x7c, 0xa5, 0x15, 0x35,
-	0x39, 0x65, 0xa6, 0x11, 0xdf, 0xfc, 0x5c, 0x79, 0xbe, 0x43, 0x55, 0xb7, 0xd7, 0xae, 0xfb, 0x3c,
-	0xb2, 0x7e, 0x69, 0x0c, 0x51, 0x53, 0x3b, 0x31, 0x91, 0x83, 0x6f, 0xa4, 0x39, 0xdb, 0xb9, 0x0c,
-	0xd6, 0x90, 0x71, 0x35, 0x28, 0x7a, 0x16, 0xce, 0x08, 0xb2, 0x41, 0x04, 0x61, 0x3e, 0xf1, 0x7c,
-	0xde, 0x63, 0x2a, 0x39, 0xdf, 0x4b, 0xee, 0x74, 0x1a, 0x6e, 0xea, 0xa8, 0xf3, 0x35, 0x80, 0x57,
-	0x52, 0x61, 0xcd, 0x9e, 0x10, 0x84, 0xa9, 0x81, 0xaa, 0x18, 0x4e, 0x1a, 0x25, 0x72, 0xc4, 0x22,
-	0x06, 0x30, 0x68, 0x1e, 0xe6, 0x63, 0x22, 0x28, 0x37, 0x6e, 0x1c, 0x77, 0xed, 0xca, 0xf9, 0x12,
-	0xc0, 0x72, 0xca, 0xf2, 0x96, 0x6f, 0x35, 0x93, 0xa0, 0xc9, 0xa3, 0x88, 0x4a, 0x49, 0x39, 0x43,
-	0x7d, 0x08, 0xfd, 0x74, 0x35, 0x62, 0xbe, 0x43, 0x48, 0xce, 0x67, 0x00, 0x5e, 0x4d, 0xa9, 0xbd,
-	0xd5, 0x53, 0x52, 0x61, 0x16, 0x50, 0xd6, 0xf9, 0xcf, 0x9a, 0xa8, 0x19, 0xcd, 0xa6, 0x8c, 0xd6,
-	0x43, 0x2c, 0xbb, 0xb7, 0xfb, 0x84, 0x29, 0xf4, 0x1c, 0xfc, 0x5f, 0x7f, 0x10, 0xf6, 0x6c, 0x9b,
-	0x41, 0xd2, 0xe6, 0x99, 0x34, 0xbe, 0x96, 0x84, 0xd1, 0x1b, 0xf0, 0xfc, 0x86, 0xc0, 0xbe, 0xbe,
-	0x01, 0x76, 0x2e, 0x2c, 0xff, 0xe3, 0xab, 0xea, 0xa6, 0x25, 0x9c, 0x8f, 0x01, 0x2c, 0x9c, 0xc1,
-	0x48, 0xa2, 0x0f, 0xe1, 0x7c, 0x46, 0x49, 0xea, 0x84, 0x47, 0x92, 0x8c, 0xed, 0xd5, 0x8b, 0xf5,
-	0xbf, 0x99, 0xca, 0xf5, 0x33, 0x4a, 0xae, 0x4e, 0x69, 0x9e, 0xa6, 0x21, 0x85, 0xfe, 0x19, 0x90,
-	0xce, 0x2e, 0x80, 0x93, 0x77, 0x08, 0x59, 0xe3, 0x3c, 0x44, 0x1f, 0xc1, 0xe9, 0x6c, 0xce, 0xc6,
-	0x9c, 0x87, 0x23, 0x3e, 0xa2, 0x6c, 0xaa, 0x6b, 0x78, 0xe7, 0x8b, 0x73, 0xb0, 0xd4, 0x1c, 0x8e,
-	0xac, 0xc7, 0x84, 0x05, 0x66, 0xa8, 0xe1, 0x10, 0x15, 0xe0, 0x84, 0xa2, 0x2a, 0x24, 0x66, 0xfa,
-	0xbb, 0x66, 0x81, 0xaa, 0xf0, 0x42, 0x40, 0xa4, 0x2f, 0x68, 0x9c, 0x9d, 0x8e, 0x3b, 0x1c, 0x42,
-	0xd7, 0xe0, 0x94, 0x20, 0x3e, 0x8d, 0x29, 0x61, 0xca, 0x0c, 0x5a, 0x37, 0x0b, 0xa0, 0x1d, 0x98,
-	0xc7, 0x51, 0x32, 0x10, 0xc6, 0x13, 0xad, 0x0b, 0x67, 0x6a, 0x4d, 0x84, 0xde, 0xb1, 0x42, 0x6b,
-	0x4f, 0x20, 0x34, 0x51, 0x79, 0xef, 0x78, 0x6f, 0xf1, 0x62, 0x98, 0xd8, 0xc1, 0xf3, 0x33, 0xd9,
-	0x16, 0x70, 0xa5, 0xb6, 0x7b, 0xbf, 0x92, 0xfb, 0xed, 0x7e, 0x25, 0xf7, 0xed, 0xfe, 0x52, 0xc9,
-	0xa2, 0x76, 0x78, 0x7f, 0x08, 0x94, 0x29, 0xcd, 0x19, 0x38, 0xdf, 0x01, 0x38, 0xd7, 0x22, 0xba,
-	0x92, 0x3e, 0x3d, 0x85, 0x85, 0xa2, 0xac, 0xf3, 0x3a, 0xdb, 0x48, 0x06, 0x5b, 0x2c, 0x48, 0x9f,
-	0x72, 0xfd, 0xa8, 0x0c, 0x7b, 0x78, 0x7a, 0x10, 0xb6, 0x16, 0xbe, 0x0b, 0x27, 0xa4, 0xc2, 0x9b,
-	0xc4, 0xfa, 0xf7, 0x69, 0xdf, 0x4e, 0x53, 0x04, 0xb5, 0x60, 0xbe, 0x4b, 0x68, 0xa7, 0x6b, 0x1a,
-	0x3a, 0xbe, 0xfa, 0xc2, 0xef, 0x07, 0x95, 0x19, 0x5f, 0x10, 0x3d, 0x6c, 0x99, 0x67, 0x52, 0x5f,
-	0x1d, 0xef, 0x2d, 0x9e, 0x8e, 0xd9, 0x06, 0x98, 0x85, 0xf3, 0x2b, 0x80, 0x0b, 0x56, 0x16, 0xe5,
-	0x2c, 0x15, 0x68, 0x9f, 0xaf, 0x37, 0xe1, 0xe5, 0xec, 0x32, 0xe8, 0xf7, 0x8b, 0x48, 0x69, 0x5f,
-	0xfe, 0xeb, 0x8f, 0xf7, 0x97, 0xfe, 0x6f, 0xa9, 0x65, 0x73, 0xd0, 0x6c, 0x59, 0x57, 0x42, 0x8f,
-	0x9b, 0xec, 0x6e, 0xdb, 0x38, 0x62, 0x30, 0x9f, 0x3e, 0xed, 0xa3, 0x74, 0xb5, 0x45, 0x59, 0x19,
-	0xd7, 0xc7, 0xeb, 0x7c, 0x0f, 0xe0, 0x8d, 0xbf, 0x36, 0xf5, 0xbb, 0x54, 0x75, 0x5b, 0x24, 0xe6,
-	0x92, 0xaa, 0x11, 0xf9, 0x7b, 0x7e, 0xc8, 0xdf, 0x3a, 0x65, 0x57, 0xa8, 0x08, 0x27, 0x03, 0x03,
-	0x5c, 0x9c, 0x48, 0x12, 0x83, 0xe5, 0xca, 0x33, 0xbb, 0x4f, 0x60, 0xc9, 0xd5, 0x57, 0x1f, 0x1c,
-	0x96, 0xc1, 0xc3, 0xc3, 0x32, 0x78, 0x74, 0x58, 0x06, 0xbf, 0x1c, 0x96, 0xc1, 0xe7, 0x47, 0xe5,
-	0xdc, 0xa3, 0xa3, 0x72, 0xee, 0xc7, 0xa3, 0x72, 0xee, 0xbd, 0xeb, 0x27, 0x6c, 0x75, 0xea, 0x37,
-	0x44, 0xd2, 0xb4, 0x76, 0x3e, 0xf9, 0x99, 0xf7, 0xd2, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x10,
-	0x1e, 0x8d, 0x91, 0x99, 0x0a, 0x00, 0x00,
+func (m *WithdrawSplit) Reset()         { *m = WithdrawSplit{} }
+func (m *WithdrawSplit) String() string { return proto.CompactTextString(m) }
+func (*WithdrawSplit) ProtoMessage()    {}
+func (*WithdrawSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{14}
 }
-
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *WithdrawSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.CommunityTax.Equal(that1.CommunityTax) {
-		return false
-	}
-	if !this.BaseProposerReward.Equal(that1.BaseProposerReward) {
-		return false
-	}
-	if !this.BonusProposerReward.Equal(that1.BonusProposerReward) {
-		return false
-	}
-	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
-		return false
-	}
-	return true
 }
-func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *WithdrawSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawSplit.Merge(m, src)
+}
+func (m *WithdrawSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawSplit proto.InternalMessageInfo
+
+func (m *WithdrawSplit) GetEntries() []WithdrawSplitEntry {
+	if m != nil {
+		return m.Entries
 	}
+	return nil
+}
 
-	that1, ok := that.(*ValidatorHistoricalRewards)
-	if !ok {
-		that2, ok := that.(ValidatorHistoricalRewards)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ValidatorRewardSample holds the tokens allocated to a validator in a block,
+// including its commission, and the time of the block. The samples of recent
+// blocks are used to estimate the reward rate of the validator.
+type ValidatorRewardSample struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+	Time    time.Time                                   `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *ValidatorRewardSample) Reset()         { *m = ValidatorRewardSample{} }
+func (m *ValidatorRewardSample) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardSample) ProtoMessage()    {}
+func (*ValidatorRewardSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{15}
+}
+func (m *ValidatorRewardSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ValidatorRewardSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardSample.Merge(m, src)
+}
+func (m *ValidatorRewardSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardSample proto.InternalMessageInfo
+
+func (m *ValidatorRewardSample) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
 	}
-	if len(this.CumulativeRewardRatio) != len(that1.CumulativeRewardRatio) {
-		return false
+	return nil
+}
+
+func (m *ValidatorRewardSample) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	for i := range this.CumulativeRewardRatio {
-		if !this.CumulativeRewardRatio[i].Equal(&that1.CumulativeRewardRatio[i]) {
-			return false
+	return time.Time{}
+}
+
+// DecimalPoolSample holds the truncation remainder an allocation added to the
+// decimal pool and the rounding deficit it drew from the decimal pool. The
+// samples of recent blocks are used to forecast the growth of the decimal pool.
+type DecimalPoolSample struct {
+	Remainder github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=remainder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"remainder"`
+	Deficit   github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=deficit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"deficit"`
+}
+
+func (m *DecimalPoolSample) Reset()         { *m = DecimalPoolSample{} }
+func (m *DecimalPoolSample) String() string { return proto.CompactTextString(m) }
+func (*DecimalPoolSample) ProtoMessage()    {}
+func (*DecimalPoolSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{16}
+}
+func (m *DecimalPoolSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecimalPoolSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecimalPoolSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.ReferenceCount != that1.ReferenceCount {
-		return false
+}
+func (m *DecimalPoolSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecimalPoolSample.Merge(m, src)
+}
+func (m *DecimalPoolSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecimalPoolSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecimalPoolSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecimalPoolSample proto.InternalMessageInfo
+
+func (m *DecimalPoolSample) GetRemainder() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Remainder
 	}
-	return true
+	return nil
 }
-func (this *ValidatorCurrentRewards) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DecimalPoolSample) GetDeficit() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Deficit
 	}
+	return nil
+}
 
-	that1, ok := that.(*ValidatorCurrentRewards)
-	if !ok {
-		that2, ok := that.(ValidatorCurrentRewards)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ValidatorEscrowedRewards holds the rewards allocated to a validator while it
+// was jailed, which are credited to the validator once it is bonded again.
+type ValidatorEscrowedRewards struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+}
+
+func (m *ValidatorEscrowedRewards) Reset()         { *m = ValidatorEscrowedRewards{} }
+func (m *ValidatorEscrowedRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEscrowedRewards) ProtoMessage()    {}
+func (*ValidatorEscrowedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{17}
+}
+func (m *ValidatorEscrowedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEscrowedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEscrowedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Rewards) != len(that1.Rewards) {
-		return false
+}
+func (m *ValidatorEscrowedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEscrowedRewards.Merge(m, src)
+}
+func (m *ValidatorEscrowedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEscrowedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEscrowedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEscrowedRewards proto.InternalMessageInfo
+
+func (m *ValidatorEscrowedRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
 	}
-	for i := range this.Rewards {
-		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
-			return false
+	return nil
+}
+
+// ValidatorCommissionCap holds the maximum commission rate the rewards of a
+// validator are split with, regardless of a higher rate set by the validator.
+type ValidatorCommissionCap struct {
+	MaxRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=max_rate,json=maxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rate"`
+}
+
+func (m *ValidatorCommissionCap) Reset()         { *m = ValidatorCommissionCap{} }
+func (m *ValidatorCommissionCap) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionCap) ProtoMessage()    {}
+func (*ValidatorCommissionCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{18}
+}
+func (m *ValidatorCommissionCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCommissionCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCommissionCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Period != that1.Period {
-		return false
-	}
-	return true
 }
-func (this *ValidatorAccumulatedCommission) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ValidatorCommissionCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionCap.Merge(m, src)
+}
+func (m *ValidatorCommissionCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCommissionCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionCap.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ValidatorAccumulatedCommission)
-	if !ok {
-		that2, ok := that.(ValidatorAccumulatedCommission)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ValidatorCommissionCap proto.InternalMessageInfo
+
+// AllocationEpoch holds the progress of the current allocation epoch.
+type AllocationEpoch struct {
+	// blocks is the number of blocks recorded in the epoch.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// total_power is the sum of the total previous power of the recorded
+	// blocks.
+	TotalPower int64 `protobuf:"varint,2,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+}
+
+func (m *AllocationEpoch) Reset()         { *m = AllocationEpoch{} }
+func (m *AllocationEpoch) String() string { return proto.CompactTextString(m) }
+func (*AllocationEpoch) ProtoMessage()    {}
+func (*AllocationEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{19}
+}
+func (m *AllocationEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllocationEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocationEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *AllocationEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocationEpoch.Merge(m, src)
+}
+func (m *AllocationEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocationEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocationEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocationEpoch proto.InternalMessageInfo
+
+func (m *AllocationEpoch) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
 	}
-	if len(this.Commission) != len(that1.Commission) {
-		return false
+	return 0
+}
+
+func (m *AllocationEpoch) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
 	}
-	for i := range this.Commission {
-		if !this.Commission[i].Equal(&that1.Commission[i]) {
-			return false
+	return 0
+}
+
+// AllocationEpochPower holds the power of a validator summed over the blocks
+// of the current allocation epoch.
+type AllocationEpochPower struct {
+	// power is the sum of the power of the validator's votes.
+	Power int64 `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
+	// signed_power is the sum of the power of the validator's votes that
+	// committed the block.
+	SignedPower int64 `protobuf:"varint,2,opt,name=signed_power,json=signedPower,proto3" json:"signed_power,omitempty"`
+}
+
+func (m *AllocationEpochPower) Reset()         { *m = AllocationEpochPower{} }
+func (m *AllocationEpochPower) String() string { return proto.CompactTextString(m) }
+func (*AllocationEpochPower) ProtoMessage()    {}
+func (*AllocationEpochPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{20}
+}
+func (m *AllocationEpochPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllocationEpochPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocationEpochPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ValidatorOutstandingRewards) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *AllocationEpochPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocationEpochPower.Merge(m, src)
+}
+func (m *AllocationEpochPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocationEpochPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocationEpochPower.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ValidatorOutstandingRewards)
-	if !ok {
-		that2, ok := that.(ValidatorOutstandingRewards)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Rewards) != len(that1.Rewards) {
-		return false
-	}
-	for i := range this.Rewards {
-		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
-			return false
-		}
+var xxx_messageInfo_AllocationEpochPower proto.InternalMessageInfo
+
+func (m *AllocationEpochPower) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	return true
+	return 0
 }
-func (this *ValidatorSlashEvent) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AllocationEpochPower) GetSignedPower() int64 {
+	if m != nil {
+		return m.SignedPower
 	}
+	return 0
+}
 
-	that1, ok := that.(*ValidatorSlashEvent)
-	if !ok {
-		that2, ok := that.(ValidatorSlashEvent)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// BlockFees holds the fees allocated by the last AllocateTokens call. As the
+// allocation runs in BeginBlock, the fees were collected in the block before
+// the one the allocation ran in.
+type BlockFees struct {
+	// height is the height of the block the allocation ran in.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// fees_collected are the fees taken from the fee collectors.
+	FeesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees_collected,json=feesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_collected"`
+	// fees_distributed are the fees collected net of the burned fees and of the
+	// infra fund cut, which were allocated to the validators and the community
+	// pool or carried to a later block.
+	FeesDistributed github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=fees_distributed,json=feesDistributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fees_distributed"`
+}
+
+func (m *BlockFees) Reset()         { *m = BlockFees{} }
+func (m *BlockFees) String() string { return proto.CompactTextString(m) }
+func (*BlockFees) ProtoMessage()    {}
+func (*BlockFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{21}
+}
+func (m *BlockFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ValidatorPeriod != that1.ValidatorPeriod {
-		return false
+}
+func (m *BlockFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFees.Merge(m, src)
+}
+func (m *BlockFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFees proto.InternalMessageInfo
+
+func (m *BlockFees) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if !this.Fraction.Equal(that1.Fraction) {
-		return false
+	return 0
+}
+
+func (m *BlockFees) GetFeesCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesCollected
 	}
-	return true
+	return nil
 }
-func (this *ValidatorSlashEvents) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *BlockFees) GetFeesDistributed() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeesDistributed
 	}
+	return nil
+}
 
-	that1, ok := that.(*ValidatorSlashEvents)
-	if !ok {
-		that2, ok := that.(ValidatorSlashEvents)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ValidatorRewardMultiplier holds the multiplier applied by governance to the
+// rewards allocated to a validator, e.g. a bonus or a penalty.
+type ValidatorRewardMultiplier struct {
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *ValidatorRewardMultiplier) Reset()         { *m = ValidatorRewardMultiplier{} }
+func (m *ValidatorRewardMultiplier) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardMultiplier) ProtoMessage()    {}
+func (*ValidatorRewardMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{22}
+}
+func (m *ValidatorRewardMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ValidatorRewardMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardMultiplier.Merge(m, src)
+}
+func (m *ValidatorRewardMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardMultiplier proto.InternalMessageInfo
+
+// ValidatorCreationHeight holds the height a validator was created at, which
+// starts its commission grace period.
+type ValidatorCreationHeight struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ValidatorCreationHeight) Reset()         { *m = ValidatorCreationHeight{} }
+func (m *ValidatorCreationHeight) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCreationHeight) ProtoMessage()    {}
+func (*ValidatorCreationHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{23}
+}
+func (m *ValidatorCreationHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCreationHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCreationHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(this.ValidatorSlashEvents) != len(that1.ValidatorSlashEvents) {
-		return false
+}
+func (m *ValidatorCreationHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCreationHeight.Merge(m, src)
+}
+func (m *ValidatorCreationHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCreationHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCreationHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCreationHeight proto.InternalMessageInfo
+
+func (m *ValidatorCreationHeight) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	for i := range this.ValidatorSlashEvents {
-		if !this.ValidatorSlashEvents[i].Equal(&that1.ValidatorSlashEvents[i]) {
-			return false
+	return 0
+}
+
+// ValidatorCommissionExemption holds the denoms no commission is taken on
+// from the rewards of a validator, which are shared in full with its
+// delegators.
+type ValidatorCommissionExemption struct {
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *ValidatorCommissionExemption) Reset()         { *m = ValidatorCommissionExemption{} }
+func (m *ValidatorCommissionExemption) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionExemption) ProtoMessage()    {}
+func (*ValidatorCommissionExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{24}
+}
+func (m *ValidatorCommissionExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCommissionExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCommissionExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *FeePool) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ValidatorCommissionExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionExemption.Merge(m, src)
+}
+func (m *ValidatorCommissionExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCommissionExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCommissionExemption proto.InternalMessageInfo
+
+func (m *ValidatorCommissionExemption) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
+	return nil
+}
 
-	that1, ok := that.(*FeePool)
-	if !ok {
-		that2, ok := that.(FeePool)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// CommissionWithdrawal holds the commission withdrawn by a validator at a
+// height. The withdrawals of a same height are summed up.
+type CommissionWithdrawal struct {
+	Height uint64                                   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *CommissionWithdrawal) Reset()         { *m = CommissionWithdrawal{} }
+func (m *CommissionWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*CommissionWithdrawal) ProtoMessage()    {}
+func (*CommissionWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{25}
+}
+func (m *CommissionWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *CommissionWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionWithdrawal.Merge(m, src)
+}
+func (m *CommissionWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionWithdrawal proto.InternalMessageInfo
+
+func (m *CommissionWithdrawal) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(this.CommunityPool) != len(that1.CommunityPool) {
-		return false
+	return 0
+}
+
+func (m *CommissionWithdrawal) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	for i := range this.CommunityPool {
-		if !this.CommunityPool[i].Equal(&that1.CommunityPool[i]) {
-			return false
+	return nil
+}
+
+// ValidatorLifetimeCommission holds the cumulative commission withdrawn by a
+// validator while the lifetime commission is recorded.
+type ValidatorLifetimeCommission struct {
+	Commission github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=commission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission"`
+}
+
+func (m *ValidatorLifetimeCommission) Reset()         { *m = ValidatorLifetimeCommission{} }
+func (m *ValidatorLifetimeCommission) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLifetimeCommission) ProtoMessage()    {}
+func (*ValidatorLifetimeCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{26}
+}
+func (m *ValidatorLifetimeCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLifetimeCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLifetimeCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ValidatorLifetimeCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLifetimeCommission.Merge(m, src)
+}
+func (m *ValidatorLifetimeCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLifetimeCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLifetimeCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLifetimeCommission proto.InternalMessageInfo
+
+func (m *ValidatorLifetimeCommission) GetCommission() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Commission
 	}
+	return nil
+}
 
-	that1, ok := that.(*DelegatorStartingInfo)
-	if !ok {
-		that2, ok := that.(DelegatorStartingInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// DelegationRewardsPeriod holds the rewards accrued by a delegation between two
+// periods of its validator. The periods of a delegation are split by the
+// slashes of the validator.
+type DelegationRewardsPeriod struct {
+	StartingPeriod uint64                                      `protobuf:"varint,1,opt,name=starting_period,json=startingPeriod,proto3" json:"starting_period,omitempty"`
+	EndingPeriod   uint64                                      `protobuf:"varint,2,opt,name=ending_period,json=endingPeriod,proto3" json:"ending_period,omitempty"`
+	Rewards        github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+}
+
+func (m *DelegationRewardsPeriod) Reset()         { *m = DelegationRewardsPeriod{} }
+func (m *DelegationRewardsPeriod) String() string { return proto.CompactTextString(m) }
+func (*DelegationRewardsPeriod) ProtoMessage()    {}
+func (*DelegationRewardsPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{27}
+}
+func (m *DelegationRewardsPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationRewardsPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationRewardsPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PreviousPeriod != that1.PreviousPeriod {
-		return false
-	}
-	if !this.Stake.Equal(that1.Stake) {
-		return false
-	}
-	if this.Height != that1.Height {
-		return false
-	}
-	return true
 }
-func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DelegationRewardsPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationRewardsPeriod.Merge(m, src)
+}
+func (m *DelegationRewardsPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationRewardsPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationRewardsPeriod.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*DelegationDelegatorReward)
-	if !ok {
-		that2, ok := that.(DelegationDelegatorReward)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ValidatorAddress != that1.ValidatorAddress {
-		return false
-	}
-	if len(this.Reward) != len(that1.Reward) {
-		return false
+var xxx_messageInfo_DelegationRewardsPeriod proto.InternalMessageInfo
+
+func (m *DelegationRewardsPeriod) GetStartingPeriod() uint64 {
+	if m != nil {
+		return m.StartingPeriod
 	}
-	for i := range this.Reward {
-		if !this.Reward[i].Equal(&that1.Reward[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *DelegationRewardsPeriod) GetEndingPeriod() uint64 {
+	if m != nil {
+		return m.EndingPeriod
 	}
-	return true
+	return 0
 }
-func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DelegationRewardsPeriod) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
 	}
+	return nil
+}
 
-	that1, ok := that.(*CommunityPoolSpendProposalWithDeposit)
-	if !ok {
-		that2, ok := that.(CommunityPoolSpendProposalWithDeposit)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// DelegationWithdrawalBreakdown details a withdrawal of delegation rewards.
+// The rewards of its periods and the previously carried coins add up to the
+// amount withdrawn, the carried coins and the dust.
+type DelegationWithdrawalBreakdown struct {
+	// periods defines the rewards of the delegation by period.
+	Periods []DelegationRewardsPeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods"`
+	// carried defines the whole coins of the rewards of the delegation not
+	// withdrawn, below the min_withdraw_amount or above the
+	// max_withdraw_per_block param, carried over to a later withdrawal.
+	Carried github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=carried,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"carried"`
+	// dust defines the sub-unit part of the rewards, which cannot be sent and
+	// is left unwithdrawn.
+	Dust github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=dust,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"dust"`
+	// previously_carried defines the whole coins carried over by earlier
+	// withdrawals from the delegation, added to the withdrawal.
+	PreviouslyCarried github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=previously_carried,json=previouslyCarried,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"previously_carried"`
+}
+
+func (m *DelegationWithdrawalBreakdown) Reset()         { *m = DelegationWithdrawalBreakdown{} }
+func (m *DelegationWithdrawalBreakdown) String() string { return proto.CompactTextString(m) }
+func (*DelegationWithdrawalBreakdown) ProtoMessage()    {}
+func (*DelegationWithdrawalBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{28}
+}
+func (m *DelegationWithdrawalBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationWithdrawalBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationWithdrawalBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if this.Recipient != that1.Recipient {
-		return false
+}
+func (m *DelegationWithdrawalBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationWithdrawalBreakdown.Merge(m, src)
+}
+func (m *DelegationWithdrawalBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationWithdrawalBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationWithdrawalBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationWithdrawalBreakdown proto.InternalMessageInfo
+
+func (m *DelegationWithdrawalBreakdown) GetPeriods() []DelegationRewardsPeriod {
+	if m != nil {
+		return m.Periods
 	}
-	if this.Amount != that1.Amount {
-		return false
+	return nil
+}
+
+func (m *DelegationWithdrawalBreakdown) GetCarried() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Carried
 	}
-	if this.Deposit != that1.Deposit {
-		return false
+	return nil
+}
+
+func (m *DelegationWithdrawalBreakdown) GetDust() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Dust
 	}
-	return true
+	return nil
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *DelegationWithdrawalBreakdown) GetPreviouslyCarried() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PreviouslyCarried
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CommunityTaxRecord holds the community tax that applies to the fees
+// allocated from a height, until the next record.
+type CommunityTaxRecord struct {
+	Height       uint64                      `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	CommunityTax cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_tax,json=communityTax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_tax"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WithdrawAddrEnabled {
-		i--
-		if m.WithdrawAddrEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.BonusProposerReward.Size()
-		i -= size
-		if _, err := m.BonusProposerReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.BaseProposerReward.Size()
-		i -= size
-		if _, err := m.BaseProposerReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.CommunityTax.Size()
-		i -= size
-		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *CommunityTaxRecord) Reset()         { *m = CommunityTaxRecord{} }
+func (m *CommunityTaxRecord) String() string { return proto.CompactTextString(m) }
+func (*CommunityTaxRecord) ProtoMessage()    {}
+func (*CommunityTaxRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{29}
+}
+func (m *CommunityTaxRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityTaxRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityTaxRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *CommunityTaxRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityTaxRecord.Merge(m, src)
+}
+func (m *CommunityTaxRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityTaxRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityTaxRecord.DiscardUnknown(m)
 }
 
-func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CommunityTaxRecord proto.InternalMessageInfo
+
+func (m *CommunityTaxRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorHistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DelegatorBlockWithdrawal holds the delegation rewards withdrawn by a
+// delegator in the current block, counted against the maximum withdrawal per
+// block.
+type DelegatorBlockWithdrawal struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *ValidatorHistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReferenceCount != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.ReferenceCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.CumulativeRewardRatio) > 0 {
-		for iNdEx := len(m.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CumulativeRewardRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DelegatorBlockWithdrawal) Reset()         { *m = DelegatorBlockWithdrawal{} }
+func (m *DelegatorBlockWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*DelegatorBlockWithdrawal) ProtoMessage()    {}
+func (*DelegatorBlockWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{30}
+}
+func (m *DelegatorBlockWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorBlockWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorBlockWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DelegatorBlockWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorBlockWithdrawal.Merge(m, src)
+}
+func (m *DelegatorBlockWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorBlockWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorBlockWithdrawal.DiscardUnknown(m)
 }
 
-func (m *ValidatorCurrentRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelegatorBlockWithdrawal proto.InternalMessageInfo
+
+func (m *DelegatorBlockWithdrawal) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ValidatorCurrentRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DelegatorCarriedRewards holds the rewards withdrawn from a delegation above
+// the maximum withdrawal per block of the delegator, which are paid out in the
+// next blocks.
+type DelegatorCarriedRewards struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func (m *ValidatorCurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Period != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.Period))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DelegatorCarriedRewards) Reset()         { *m = DelegatorCarriedRewards{} }
+func (m *DelegatorCarriedRewards) String() string { return proto.CompactTextString(m) }
+func (*DelegatorCarriedRewards) ProtoMessage()    {}
+func (*DelegatorCarriedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{31}
+}
+func (m *DelegatorCarriedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorCarriedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorCarriedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DelegatorCarriedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorCarriedRewards.Merge(m, src)
+}
+func (m *DelegatorCarriedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorCarriedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorCarriedRewards.DiscardUnknown(m)
 }
 
-func (m *ValidatorAccumulatedCommission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelegatorCarriedRewards proto.InternalMessageInfo
+
+func (m *DelegatorCarriedRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ValidatorAccumulatedCommission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AllocationRecord records the fees allocated at the beginning of the last
+// block, net of the burned fees, and the distribution state changed by the
+// allocation before and after it, so that the conservation of the allocated
+// tokens can be checked.
+type AllocationRecord struct {
+	// fees defines the fees allocated, net of the burned fees.
+	Fees github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fees"`
+	// outstanding_rewards_before defines the sum of the outstanding rewards of
+	// the validators that voted or proposed the block, before the allocation.
+	OutstandingRewardsBefore github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=outstanding_rewards_before,json=outstandingRewardsBefore,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"outstanding_rewards_before"`
+	// outstanding_rewards_after defines the sum of the outstanding rewards of
+	// the same validators after the allocation.
+	OutstandingRewardsAfter github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=outstanding_rewards_after,json=outstandingRewardsAfter,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"outstanding_rewards_after"`
+	// community_pool_before defines the community pool before the allocation.
+	CommunityPoolBefore github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=community_pool_before,json=communityPoolBefore,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"community_pool_before"`
+	// community_pool_after defines the community pool after the allocation.
+	CommunityPoolAfter github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=community_pool_after,json=communityPoolAfter,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"community_pool_after"`
+	// decimal_pool_before defines the decimal pool before the allocation.
+	DecimalPoolBefore github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=decimal_pool_before,json=decimalPoolBefore,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"decimal_pool_before"`
+	// decimal_pool_after defines the decimal pool after the allocation.
+	DecimalPoolAfter github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=decimal_pool_after,json=decimalPoolAfter,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"decimal_pool_after"`
+	// escrowed_rewards_before defines the sum of the escrowed rewards of the
+	// validators before the allocation.
+	EscrowedRewardsBefore github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,8,rep,name=escrowed_rewards_before,json=escrowedRewardsBefore,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"escrowed_rewards_before"`
+	// escrowed_rewards_after defines the sum of the escrowed rewards of the
+	// validators after the allocation.
+	EscrowedRewardsAfter github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,9,rep,name=escrowed_rewards_after,json=escrowedRewardsAfter,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"escrowed_rewards_after"`
+	// routed defines the community pool cut sent to the module accounts of the
+	// community pool routes during the allocation.
+	Routed github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,10,rep,name=routed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"routed"`
+	// carried_fees_before defines the fees carried over from the blocks with no
+	// bonded power before the allocation.
+	CarriedFeesBefore github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,11,rep,name=carried_fees_before,json=carriedFeesBefore,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"carried_fees_before"`
+	// carried_fees_after defines the fees carried over from the blocks with no
+	// bonded power after the allocation.
+	CarriedFeesAfter github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,12,rep,name=carried_fees_after,json=carriedFeesAfter,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"carried_fees_after"`
+}
+
+func (m *AllocationRecord) Reset()         { *m = AllocationRecord{} }
+func (m *AllocationRecord) String() string { return proto.CompactTextString(m) }
+func (*AllocationRecord) ProtoMessage()    {}
+func (*AllocationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{32}
+}
+func (m *AllocationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ValidatorAccumulatedCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commission) > 0 {
-		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *AllocationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ValidatorOutstandingRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *AllocationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocationRecord.Merge(m, src)
 }
-
-func (m *ValidatorOutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AllocationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocationRecord.DiscardUnknown(m)
 }
 
-func (m *ValidatorOutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_AllocationRecord proto.InternalMessageInfo
+
+func (m *AllocationRecord) GetFees() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Fees
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ValidatorSlashEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AllocationRecord) GetOutstandingRewardsBefore() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.OutstandingRewardsBefore
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ValidatorSlashEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AllocationRecord) GetOutstandingRewardsAfter() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.OutstandingRewardsAfter
+	}
+	return nil
 }
 
-func (m *ValidatorSlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Fraction.Size()
-		i -= size
-		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
+func (m *AllocationRecord) GetCommunityPoolBefore() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CommunityPoolBefore
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.ValidatorPeriod != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.ValidatorPeriod))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *AllocationRecord) GetCommunityPoolAfter() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CommunityPoolAfter
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ValidatorSlashEvents) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AllocationRecord) GetDecimalPoolBefore() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.DecimalPoolBefore
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ValidatorSlashEvents) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AllocationRecord) GetDecimalPoolAfter() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.DecimalPoolAfter
+	}
+	return nil
 }
 
-func (m *ValidatorSlashEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorSlashEvents) > 0 {
-		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorSlashEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *AllocationRecord) GetEscrowedRewardsBefore() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.EscrowedRewardsBefore
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *FeePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AllocationRecord) GetEscrowedRewardsAfter() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.EscrowedRewardsAfter
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *FeePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AllocationRecord) GetRouted() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Routed
+	}
+	return nil
 }
 
-func (m *FeePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CommunityPool) > 0 {
-		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *AllocationRecord) GetCarriedFeesBefore() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CarriedFeesBefore
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AllocationRecord) GetCarriedFeesAfter() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CarriedFeesAfter
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CommunityPoolSpendProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CommunityPoolSpendProposalWithDeposit defines a CommunityPoolSpendProposal
+// with a deposit
+//
+// Deprecated: Do not use.
+//
+// Deprecated: Do not use.
+type CommunityPoolSpendProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Recipient   string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Deposit     string `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
 }
 
-func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = CommunityPoolSpendProposalWithDeposit{} }
+func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
+func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{33}
+}
+func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolSpendProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolSpendProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CommunityPoolSpendProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolSpendProposalWithDeposit.Merge(m, src)
+}
+func (m *CommunityPoolSpendProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolSpendProposalWithDeposit.DiscardUnknown(m)
 }
 
-func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
+
+// CommunityPoolSpendEntry defines a transfer from the community pool to a
+// recipient of a MsgCommunityPoolSpendBatch.
+type CommunityPoolSpendEntry struct {
+	// recipient defines the address receiving the amount.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount defines the coins transferred to the recipient.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.Stake.Size()
-		i -= size
-		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *CommunityPoolSpendEntry) Reset()         { *m = CommunityPoolSpendEntry{} }
+func (m *CommunityPoolSpendEntry) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolSpendEntry) ProtoMessage()    {}
+func (*CommunityPoolSpendEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{34}
+}
+func (m *CommunityPoolSpendEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolSpendEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolSpendEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.PreviousPeriod != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CommunityPoolSpendEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolSpendEntry.Merge(m, src)
+}
+func (m *CommunityPoolSpendEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolSpendEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolSpendEntry.DiscardUnknown(m)
 }
 
-func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CommunityPoolSpendEntry proto.InternalMessageInfo
+
+func (m *CommunityPoolSpendEntry) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CommunityPoolSpendEntry) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reward) > 0 {
-		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterEnum("cosmos.distribution.v1beta1.RoundingMode", RoundingMode_name, RoundingMode_value)
+	proto.RegisterEnum("cosmos.distribution.v1beta1.ZeroPowerStrategy", ZeroPowerStrategy_name, ZeroPowerStrategy_value)
+	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
+	proto.RegisterType((*CommunityPoolDenomDestination)(nil), "cosmos.distribution.v1beta1.CommunityPoolDenomDestination")
+	proto.RegisterType((*CommunityPoolRoute)(nil), "cosmos.distribution.v1beta1.CommunityPoolRoute")
+	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
+	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
+	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
+	proto.RegisterType((*ValidatorOutstandingRewards)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewards")
+	proto.RegisterType((*ValidatorSlashEvent)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvent")
+	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
+	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
+	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
+	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
+	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
+	proto.RegisterType((*WithdrawSplitEntry)(nil), "cosmos.distribution.v1beta1.WithdrawSplitEntry")
+	proto.RegisterType((*WithdrawSplit)(nil), "cosmos.distribution.v1beta1.WithdrawSplit")
+	proto.RegisterType((*ValidatorRewardSample)(nil), "cosmos.distribution.v1beta1.ValidatorRewardSample")
+	proto.RegisterType((*DecimalPoolSample)(nil), "cosmos.distribution.v1beta1.DecimalPoolSample")
+	proto.RegisterType((*ValidatorEscrowedRewards)(nil), "cosmos.distribution.v1beta1.ValidatorEscrowedRewards")
+	proto.RegisterType((*ValidatorCommissionCap)(nil), "cosmos.distribution.v1beta1.ValidatorCommissionCap")
+	proto.RegisterType((*AllocationEpoch)(nil), "cosmos.distribution.v1beta1.AllocationEpoch")
+	proto.RegisterType((*AllocationEpochPower)(nil), "cosmos.distribution.v1beta1.AllocationEpochPower")
+	proto.RegisterType((*BlockFees)(nil), "cosmos.distribution.v1beta1.BlockFees")
+	proto.RegisterType((*ValidatorRewardMultiplier)(nil), "cosmos.distribution.v1beta1.ValidatorRewardMultiplier")
+	proto.RegisterType((*ValidatorCreationHeight)(nil), "cosmos.distribution.v1beta1.ValidatorCreationHeight")
+	proto.RegisterType((*ValidatorCommissionExemption)(nil), "cosmos.distribution.v1beta1.ValidatorCommissionExemption")
+	proto.RegisterType((*CommissionWithdrawal)(nil), "cosmos.distribution.v1beta1.CommissionWithdrawal")
+	proto.RegisterType((*ValidatorLifetimeCommission)(nil), "cosmos.distribution.v1beta1.ValidatorLifetimeCommission")
+	proto.RegisterType((*DelegationRewardsPeriod)(nil), "cosmos.distribution.v1beta1.DelegationRewardsPeriod")
+	proto.RegisterType((*DelegationWithdrawalBreakdown)(nil), "cosmos.distribution.v1beta1.DelegationWithdrawalBreakdown")
+	proto.RegisterType((*CommunityTaxRecord)(nil), "cosmos.distribution.v1beta1.CommunityTaxRecord")
+	proto.RegisterType((*DelegatorBlockWithdrawal)(nil), "cosmos.distribution.v1beta1.DelegatorBlockWithdrawal")
+	proto.RegisterType((*DelegatorCarriedRewards)(nil), "cosmos.distribution.v1beta1.DelegatorCarriedRewards")
+	proto.RegisterType((*AllocationRecord)(nil), "cosmos.distribution.v1beta1.AllocationRecord")
+	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*CommunityPoolSpendEntry)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendEntry")
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterFile("cosmos/distribution/v1beta1/distribution.proto", fileDescriptor_cd78a31ea281a992)
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_cd78a31ea281a992 = []byte{
+	// 2870 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xdb, 0x6f, 0x24, 0x47,
+	0xd5, 0x77, 0xdb, 0xb3, 0xbe, 0x1c, 0xdb, 0x6b, 0xbb, 0x3c, 0xb6, 0xdb, 0xb3, 0xde, 0xf1, 0xec,
+	0x7c, 0x5f, 0x14, 0xc7, 0xb0, 0xe3, 0xec, 0x12, 0x56, 0xb0, 0x10, 0x45, 0xbe, 0x26, 0x5e, 0xf9,
+	0x46, 0xdb, 0x49, 0xb4, 0x41, 0x4a, 0xab, 0xa7, 0xbb, 0x66, 0xa6, 0xd7, 0x3d, 0x5d, 0x93, 0xea,
+	0x1a, 0x5f, 0x12, 0xf1, 0x10, 0x09, 0x45, 0x8b, 0x15, 0x41, 0x40, 0x10, 0x10, 0xd2, 0x4a, 0x81,
+	0x08, 0x29, 0xf0, 0xb4, 0x0f, 0xfb, 0x47, 0x44, 0x88, 0x87, 0x28, 0x02, 0x04, 0x3c, 0x24, 0xb0,
+	0x79, 0xd8, 0x88, 0x47, 0x1e, 0x78, 0x41, 0x42, 0xa8, 0x2e, 0x7d, 0x99, 0x19, 0xaf, 0x49, 0xd6,
+	0x8c, 0xf3, 0xb2, 0xeb, 0xba, 0x9d, 0x73, 0xea, 0xd4, 0x39, 0xbf, 0xfa, 0xd5, 0xe9, 0x81, 0x82,
+	0x4d, 0x82, 0x2a, 0x09, 0xe6, 0x1c, 0x37, 0x60, 0xd4, 0x2d, 0xd6, 0x99, 0x4b, 0xfc, 0xb9, 0xbd,
+	0x2b, 0x45, 0xcc, 0xac, 0x2b, 0x0d, 0x9d, 0x85, 0x1a, 0x25, 0x8c, 0xa0, 0x0b, 0x72, 0x7e, 0xa1,
+	0x61, 0x48, 0xcd, 0xcf, 0xa4, 0xcb, 0xa4, 0x4c, 0xc4, 0xbc, 0x39, 0xfe, 0x97, 0x5c, 0x92, 0xc9,
+	0x2a, 0x15, 0x45, 0x2b, 0xc0, 0x91, 0x68, 0x9b, 0xb8, 0x4a, 0x64, 0x66, 0x52, 0x8e, 0x9b, 0x72,
+	0xa1, 0x92, 0x2f, 0x87, 0x46, 0xac, 0xaa, 0xeb, 0x93, 0x39, 0xf1, 0xaf, 0xea, 0x9a, 0x2e, 0x13,
+	0x52, 0xf6, 0xf0, 0x9c, 0x68, 0x15, 0xeb, 0xa5, 0x39, 0xe6, 0x56, 0x71, 0xc0, 0xac, 0x6a, 0x4d,
+	0x4e, 0xc8, 0xff, 0x6a, 0x0c, 0xba, 0xb7, 0x2c, 0x6a, 0x55, 0x03, 0xf4, 0x6d, 0x18, 0xb4, 0x49,
+	0xb5, 0x5a, 0xf7, 0x5d, 0x76, 0x68, 0x32, 0xeb, 0x40, 0xd7, 0x72, 0xda, 0x4c, 0xdf, 0xc2, 0xb5,
+	0xf7, 0x3f, 0x9a, 0xee, 0xf8, 0xcb, 0x47, 0xd3, 0x6a, 0x2f, 0x81, 0xb3, 0x5b, 0x70, 0xc9, 0x5c,
+	0xd5, 0x62, 0x95, 0xc2, 0x1a, 0x2e, 0x5b, 0xf6, 0xe1, 0x12, 0xb6, 0x3f, 0xbc, 0x77, 0x19, 0x94,
+	0x29, 0x4b, 0xd8, 0x7e, 0xef, 0xc1, 0xdd, 0x59, 0xcd, 0x18, 0x88, 0x84, 0xed, 0x58, 0x07, 0xa8,
+	0x02, 0x69, 0xbe, 0x23, 0x6e, 0x76, 0x8d, 0x04, 0x98, 0x9a, 0x14, 0xef, 0x5b, 0xd4, 0xd1, 0x3b,
+	0x4f, 0xa5, 0x03, 0x71, 0x99, 0x5b, 0x4a, 0xa4, 0x21, 0x24, 0xa2, 0x5b, 0x30, 0x56, 0x24, 0x7e,
+	0x3d, 0x68, 0x51, 0xd5, 0x75, 0x2a, 0x55, 0xa3, 0x42, 0x68, 0x93, 0xae, 0xab, 0x30, 0xb6, 0xef,
+	0xb2, 0x8a, 0x43, 0xad, 0x7d, 0xd3, 0x72, 0x1c, 0x6a, 0x62, 0xdf, 0x2a, 0x7a, 0xd8, 0xd1, 0x53,
+	0x39, 0x6d, 0xa6, 0xd7, 0x18, 0x0d, 0x07, 0xe7, 0x1d, 0x87, 0x2e, 0xcb, 0x21, 0xf4, 0x0a, 0x4c,
+	0x39, 0xd8, 0x76, 0xab, 0x96, 0x67, 0xd6, 0x08, 0xf1, 0xcc, 0x92, 0x57, 0x0f, 0x2a, 0x26, 0xab,
+	0x50, 0x1c, 0x54, 0x88, 0xe7, 0xe8, 0xe7, 0x84, 0x99, 0x4f, 0x2a, 0x33, 0xc7, 0x5a, 0xcd, 0x5c,
+	0xf5, 0x59, 0xc2, 0xc0, 0x55, 0x9f, 0x49, 0x03, 0x27, 0x95, 0xd4, 0x2d, 0x42, 0xbc, 0x15, 0x2e,
+	0x73, 0x27, 0x14, 0x89, 0xe6, 0x60, 0x34, 0x19, 0x81, 0x66, 0xcd, 0xaa, 0x07, 0xd8, 0xd1, 0xb3,
+	0xc2, 0x48, 0x94, 0x1c, 0xda, 0x12, 0x23, 0xe8, 0x1a, 0x4c, 0x70, 0xd3, 0xf9, 0x62, 0xf3, 0x96,
+	0xe5, 0x7a, 0xd8, 0x51, 0x4e, 0x0c, 0xf4, 0x1e, 0xb1, 0x68, 0x2c, 0x1c, 0xbe, 0x21, 0x46, 0xa5,
+	0x3b, 0x02, 0x54, 0x82, 0xd1, 0xaa, 0xeb, 0x9b, 0xfc, 0xe4, 0xdd, 0x20, 0xe0, 0xaa, 0xa8, 0xc5,
+	0xb0, 0xde, 0x7b, 0x2a, 0xcf, 0x8f, 0x54, 0x5d, 0x7f, 0x31, 0x92, 0x68, 0x58, 0x0c, 0xa3, 0x6d,
+	0xe8, 0x2b, 0xd6, 0xa9, 0x92, 0xde, 0x77, 0x2a, 0xe9, 0xbd, 0x5c, 0x90, 0x10, 0x7a, 0x0b, 0x26,
+	0xb9, 0xf1, 0x01, 0xf6, 0x4a, 0x66, 0x91, 0xf8, 0x8e, 0x59, 0x22, 0x34, 0xda, 0x36, 0x3c, 0xe2,
+	0xa9, 0x8c, 0x55, 0x5d, 0x7f, 0x1b, 0x7b, 0xa5, 0x05, 0xe2, 0x3b, 0x2b, 0x84, 0x86, 0x8e, 0x7a,
+	0x43, 0x83, 0xc1, 0xa0, 0x5e, 0x0c, 0x5c, 0xe7, 0xd0, 0x2c, 0x79, 0x84, 0x50, 0xbd, 0x3f, 0xd7,
+	0x35, 0xd3, 0x7f, 0x75, 0x52, 0x21, 0x4c, 0x81, 0x07, 0x76, 0x88, 0x14, 0x85, 0x45, 0xe2, 0xfa,
+	0x0b, 0x2b, 0x5c, 0xf7, 0x6f, 0x3e, 0x9e, 0x9e, 0x29, 0xbb, 0xac, 0x52, 0x2f, 0x16, 0x6c, 0x52,
+	0x55, 0xe9, 0xaf, 0xfe, 0xbb, 0x1c, 0x38, 0xbb, 0x73, 0xec, 0xb0, 0x86, 0x03, 0xb1, 0x20, 0xf8,
+	0xf9, 0x83, 0xbb, 0xb3, 0x03, 0x9e, 0xd8, 0xbb, 0xc9, 0x01, 0x24, 0x50, 0x79, 0xa9, 0xf4, 0xae,
+	0x70, 0xb5, 0x3c, 0x82, 0x71, 0x60, 0x53, 0xb2, 0xdf, 0x7c, 0xce, 0x03, 0x32, 0x82, 0xe5, 0x60,
+	0xe3, 0x29, 0x5f, 0x81, 0x74, 0x14, 0x33, 0x3c, 0xa6, 0x4d, 0x07, 0xfb, 0xa4, 0x1a, 0xe8, 0x83,
+	0xb9, 0xae, 0x99, 0x3e, 0x63, 0xb4, 0x61, 0x6c, 0x49, 0x0c, 0xa1, 0x0d, 0x18, 0xa4, 0xa4, 0xee,
+	0x3b, 0xae, 0x5f, 0x36, 0xab, 0xc4, 0xc1, 0xfa, 0xf9, 0x9c, 0x36, 0x73, 0xfe, 0xea, 0x13, 0x85,
+	0x13, 0x00, 0xb2, 0x60, 0xa8, 0x15, 0xeb, 0xc4, 0xc1, 0xc6, 0x00, 0x4d, 0xb4, 0xd0, 0xdb, 0x1a,
+	0x8c, 0x57, 0xad, 0x03, 0x33, 0xca, 0xbe, 0x1a, 0xa6, 0x66, 0xd1, 0x23, 0xf6, 0xae, 0x3e, 0x74,
+	0x56, 0x8e, 0x1c, 0xad, 0x5a, 0x07, 0x2f, 0x2a, 0xfd, 0x5b, 0x98, 0x2e, 0x70, 0xed, 0x68, 0x11,
+	0xb2, 0x89, 0xe8, 0x2f, 0x53, 0xcb, 0xc6, 0xdc, 0x36, 0x97, 0x38, 0xd2, 0xbc, 0x40, 0x1f, 0xce,
+	0x69, 0x33, 0x29, 0xe3, 0x42, 0x3c, 0xeb, 0x59, 0x3e, 0x69, 0x4b, 0xcc, 0x11, 0x32, 0x02, 0xe4,
+	0xc3, 0x58, 0x8c, 0xc4, 0x02, 0x24, 0x28, 0xa9, 0x33, 0x1c, 0xe8, 0x23, 0x62, 0x6f, 0x73, 0x27,
+	0x7a, 0x6d, 0x31, 0x5c, 0xc9, 0x81, 0xc0, 0xe0, 0xeb, 0x16, 0xfa, 0xf8, 0x8e, 0x95, 0xd1, 0x76,
+	0xcb, 0x70, 0x80, 0x5e, 0x86, 0xd1, 0x57, 0x31, 0x25, 0x66, 0x8d, 0xec, 0x63, 0x6a, 0x06, 0x8c,
+	0xa7, 0x55, 0xf9, 0x50, 0x47, 0xe2, 0x8c, 0x0a, 0x27, 0x6a, 0x7b, 0x09, 0x53, 0xb2, 0xc5, 0x97,
+	0x6d, 0xab, 0x55, 0xc6, 0xc8, 0xab, 0xcd, 0x5d, 0xe8, 0x69, 0xb8, 0x70, 0x0c, 0xe4, 0xb9, 0x3e,
+	0xc3, 0x74, 0xcf, 0xf2, 0xf4, 0x51, 0xe1, 0x11, 0xbd, 0x19, 0xbf, 0x56, 0xd5, 0x38, 0xba, 0x0e,
+	0x93, 0x14, 0xdb, 0x84, 0x3a, 0x49, 0x60, 0xa9, 0xb8, 0x01, 0x23, 0xf4, 0x50, 0x4f, 0x8b, 0x38,
+	0x9d, 0x90, 0x13, 0x62, 0x98, 0x78, 0x4e, 0x0e, 0xa3, 0x97, 0x61, 0xc8, 0xf5, 0x4b, 0xd4, 0x32,
+	0x4b, 0x75, 0xdf, 0x91, 0x78, 0x31, 0x76, 0x2a, 0xbc, 0x18, 0x14, 0xe2, 0x56, 0xea, 0xbe, 0x23,
+	0x40, 0x63, 0x16, 0x46, 0x12, 0xf2, 0xab, 0xc4, 0xa9, 0x7b, 0x58, 0x1f, 0xe7, 0x1a, 0x8c, 0xa1,
+	0x68, 0xe6, 0xba, 0xe8, 0x46, 0x1e, 0x8c, 0x27, 0x91, 0x11, 0x17, 0x2d, 0x86, 0xa5, 0x49, 0x13,
+	0xa7, 0x32, 0x29, 0x1d, 0x4b, 0x35, 0x84, 0x50, 0x61, 0xd9, 0x0f, 0x35, 0x09, 0xc6, 0xf1, 0x05,
+	0x55, 0x25, 0x75, 0x9f, 0xe9, 0xfa, 0x59, 0xe5, 0x07, 0xc7, 0xed, 0x30, 0x3f, 0xe6, 0x85, 0x6e,
+	0x7e, 0xaf, 0xf0, 0xac, 0x95, 0x18, 0x83, 0x1d, 0x73, 0xcf, 0xf2, 0x5c, 0xc7, 0x62, 0x84, 0x06,
+	0xfa, 0xa4, 0x08, 0x82, 0xb1, 0xaa, 0x75, 0x60, 0xa8, 0xd1, 0x17, 0xa2, 0x41, 0xbe, 0xce, 0xf2,
+	0x3c, 0x62, 0x5b, 0xe2, 0xfa, 0xc2, 0x35, 0x62, 0x57, 0xc2, 0x74, 0xca, 0xc8, 0x75, 0xf1, 0xf0,
+	0x32, 0x1f, 0x55, 0x89, 0xf4, 0x96, 0x06, 0x97, 0x9a, 0x32, 0x49, 0x60, 0x95, 0xe9, 0xe0, 0x80,
+	0xb9, 0xbe, 0x98, 0x1f, 0xe8, 0x17, 0x84, 0x47, 0xae, 0x7f, 0xf6, 0xac, 0x12, 0xa0, 0xb6, 0x14,
+	0x8b, 0x48, 0x26, 0x58, 0xd6, 0x3e, 0x69, 0x66, 0x80, 0xbe, 0x09, 0x19, 0x15, 0xcc, 0x9e, 0x5b,
+	0xc2, 0x9c, 0x8e, 0x25, 0xa2, 0x5a, 0x9f, 0x12, 0xd1, 0xac, 0xcb, 0x19, 0x6b, 0x6a, 0x42, 0x1c,
+	0xd5, 0xe8, 0x19, 0x98, 0x6a, 0xe0, 0x68, 0x61, 0x1a, 0x98, 0x1e, 0xf6, 0xcb, 0xac, 0xa2, 0x5f,
+	0x14, 0xde, 0x98, 0x4c, 0x52, 0x2f, 0x95, 0x09, 0x6b, 0x62, 0x02, 0xfa, 0x06, 0x64, 0x76, 0x31,
+	0xae, 0x99, 0xb1, 0x14, 0x1e, 0xb8, 0x81, 0xc9, 0xbd, 0xe7, 0xe9, 0xd3, 0x32, 0x99, 0xf8, 0x8c,
+	0x68, 0xc3, 0x3c, 0x80, 0x83, 0x35, 0x3e, 0xcc, 0x8f, 0x21, 0x60, 0xae, 0xbd, 0x7b, 0x68, 0x36,
+	0xb0, 0x1e, 0x1c, 0x04, 0x7a, 0x4e, 0xd2, 0x02, 0x39, 0xfc, 0x62, 0x82, 0xf6, 0xe0, 0x20, 0xb8,
+	0xfe, 0xd8, 0xd1, 0x83, 0xbb, 0xb3, 0xb9, 0x44, 0xf0, 0x1c, 0x34, 0x32, 0x68, 0x49, 0x40, 0x6f,
+	0xa4, 0x7a, 0xbb, 0x87, 0x7b, 0x8c, 0x74, 0x72, 0x28, 0x24, 0x54, 0xf9, 0x75, 0xb8, 0x78, 0xe2,
+	0x11, 0xa0, 0x34, 0x9c, 0x13, 0x47, 0x2b, 0x59, 0xab, 0x21, 0x1b, 0x68, 0x1c, 0xba, 0x55, 0x4e,
+	0x0a, 0xa2, 0x69, 0xa8, 0x56, 0x7e, 0x1f, 0x50, 0x2b, 0x4e, 0x26, 0x66, 0x6b, 0xc9, 0xd9, 0x68,
+	0x15, 0xba, 0xf7, 0xb1, 0x5b, 0xae, 0x30, 0x45, 0x57, 0xaf, 0x7c, 0xee, 0x44, 0x35, 0x94, 0x80,
+	0xfc, 0x1f, 0x35, 0xc8, 0x44, 0x81, 0x2d, 0x8f, 0xc6, 0xb5, 0x2d, 0x2f, 0xbc, 0x5a, 0xbf, 0xaf,
+	0xc1, 0x84, 0x5d, 0xaf, 0xd6, 0x3d, 0x8b, 0xb9, 0x7b, 0x58, 0x25, 0x0a, 0xc7, 0x08, 0x97, 0xe8,
+	0x9a, 0x08, 0xd3, 0xa9, 0x63, 0x13, 0x77, 0x09, 0xdb, 0x22, 0x77, 0xbf, 0xa6, 0x72, 0xf7, 0x4b,
+	0x9f, 0x21, 0x77, 0xd5, 0x1a, 0x95, 0xad, 0x63, 0xb1, 0x5a, 0x69, 0x8c, 0xc1, 0x95, 0xa2, 0xc7,
+	0x61, 0x88, 0xe2, 0x12, 0xa6, 0xd8, 0xb7, 0x79, 0xa0, 0xd6, 0x7d, 0xe9, 0x83, 0x41, 0xe3, 0x7c,
+	0xd4, 0xbd, 0xc8, 0x7b, 0xf3, 0xef, 0x6a, 0x30, 0x11, 0x6d, 0x6c, 0xb1, 0x4e, 0x29, 0xf6, 0x59,
+	0xb8, 0xab, 0x1a, 0xf4, 0x84, 0xb4, 0xa2, 0xbd, 0x9b, 0x08, 0xd5, 0xf0, 0x93, 0x94, 0xb7, 0xae,
+	0xb0, 0x36, 0x65, 0xa8, 0x56, 0xfe, 0x67, 0x1a, 0x64, 0x23, 0x2b, 0xe7, 0x6d, 0xb5, 0x67, 0x9c,
+	0xb8, 0x38, 0xd0, 0x1e, 0x40, 0x22, 0x21, 0xdb, 0x6b, 0x6f, 0x42, 0x53, 0xfe, 0x07, 0x1a, 0x5c,
+	0x88, 0x4c, 0xdb, 0xac, 0xb3, 0x80, 0x59, 0x82, 0xef, 0x7c, 0x61, 0x4e, 0xe4, 0x16, 0x8d, 0x46,
+	0x16, 0x6d, 0x7b, 0x56, 0x50, 0x59, 0xde, 0xc3, 0x3e, 0x43, 0x4f, 0xc0, 0x70, 0x04, 0xdc, 0x8a,
+	0xdc, 0x88, 0x84, 0x49, 0x19, 0x43, 0x51, 0xbf, 0xe4, 0x33, 0x68, 0x1d, 0x7a, 0x4b, 0xd4, 0xb2,
+	0x79, 0x86, 0x3e, 0x7a, 0xee, 0x44, 0x22, 0xf2, 0xdf, 0xd3, 0x20, 0x7d, 0x8c, 0x45, 0x01, 0x7a,
+	0x05, 0xc6, 0x63, 0x93, 0x02, 0x3e, 0x60, 0x62, 0x31, 0xa2, 0x7c, 0xf5, 0xe4, 0x89, 0xe0, 0x7e,
+	0x8c, 0xc8, 0x24, 0xa4, 0xa7, 0xf7, 0x8e, 0x51, 0x99, 0xff, 0x77, 0x27, 0xf4, 0xac, 0x60, 0xcc,
+	0xd1, 0x03, 0x7d, 0x07, 0xce, 0x37, 0x5e, 0x33, 0x6d, 0x3e, 0xa2, 0xc1, 0x86, 0x4b, 0x06, 0x1d,
+	0xc2, 0x40, 0x92, 0x5f, 0xe9, 0x9d, 0x6d, 0x55, 0xde, 0x9f, 0x20, 0x6a, 0x5c, 0xb5, 0x6d, 0x51,
+	0xea, 0x62, 0xc7, 0x2c, 0x61, 0x1c, 0xe8, 0x5d, 0xed, 0x55, 0xad, 0x74, 0xad, 0x60, 0x1c, 0xe4,
+	0x7f, 0xda, 0x09, 0x99, 0x06, 0x10, 0xdf, 0xae, 0x61, 0xdf, 0x91, 0x2f, 0x74, 0xcb, 0xe3, 0x17,
+	0x02, 0x73, 0x59, 0x84, 0xe5, 0xb2, 0x81, 0x72, 0xd0, 0xef, 0xf0, 0x37, 0x8d, 0x5b, 0x8b, 0x63,
+	0xd2, 0x48, 0x76, 0xa1, 0x29, 0xe8, 0xa3, 0xd8, 0x76, 0x6b, 0x2e, 0xf6, 0x99, 0xac, 0x19, 0x18,
+	0x71, 0x07, 0x3a, 0x84, 0x6e, 0xc5, 0xa3, 0x52, 0x67, 0xc5, 0xa3, 0x94, 0xc2, 0xeb, 0x33, 0xb7,
+	0xdf, 0x99, 0xee, 0xf8, 0xf4, 0x9d, 0xe9, 0x8e, 0xdf, 0xde, 0xbb, 0x9c, 0x51, 0x5a, 0xcb, 0x64,
+	0x2f, 0xa1, 0xd4, 0x67, 0xdc, 0x66, 0x2d, 0xff, 0x7b, 0x0d, 0xc6, 0x96, 0x30, 0x97, 0xc4, 0x63,
+	0x96, 0x59, 0x94, 0xb9, 0x7e, 0x79, 0xd5, 0x2f, 0x09, 0x38, 0xaf, 0x51, 0xbc, 0xe7, 0x12, 0x5e,
+	0x1f, 0x49, 0x66, 0xee, 0xf9, 0xb0, 0x5b, 0x25, 0xee, 0x1a, 0x9c, 0x0b, 0x98, 0xb5, 0x8b, 0x4f,
+	0x59, 0xa0, 0x91, 0x42, 0xd0, 0x12, 0x74, 0x57, 0xe4, 0x05, 0xca, 0x1d, 0x9a, 0x5a, 0xf8, 0xf2,
+	0xdf, 0x3f, 0x9a, 0x1e, 0xb2, 0x29, 0x96, 0x7c, 0x4e, 0x0e, 0xfd, 0xf2, 0xc1, 0xdd, 0xd9, 0xe6,
+	0x3e, 0xe5, 0x00, 0xd9, 0xc8, 0xff, 0x4d, 0x83, 0x49, 0xb5, 0x2d, 0x97, 0xf8, 0xd1, 0x06, 0x55,
+	0x2d, 0x66, 0x03, 0x46, 0x62, 0x08, 0x08, 0x69, 0x89, 0x2c, 0x61, 0x5d, 0xfa, 0xf0, 0xde, 0xe5,
+	0x8b, 0xca, 0xb4, 0x18, 0xfd, 0xe5, 0x94, 0x6d, 0x46, 0x39, 0xc8, 0x0e, 0xef, 0x35, 0xf5, 0x23,
+	0x1f, 0xba, 0xa3, 0x1a, 0x55, 0x3b, 0x63, 0x5a, 0x69, 0xb9, 0x9e, 0xe2, 0xc7, 0xcb, 0x2f, 0x28,
+	0x14, 0xd2, 0xa7, 0xed, 0x9a, 0xe7, 0xb2, 0x65, 0x9f, 0xd1, 0x43, 0x74, 0x15, 0x7a, 0x1a, 0xb7,
+	0xa4, 0x7f, 0x78, 0xef, 0x72, 0x5a, 0x19, 0xd4, 0xb8, 0x93, 0x70, 0x22, 0xda, 0x68, 0x62, 0x2d,
+	0x8f, 0x7a, 0x86, 0x21, 0x75, 0xc1, 0x30, 0xd8, 0x60, 0x19, 0xda, 0x81, 0x1e, 0xec, 0x33, 0xea,
+	0xe2, 0x10, 0x65, 0x4f, 0x7e, 0x98, 0xb6, 0x6e, 0x2b, 0x09, 0xb2, 0xa1, 0xa8, 0xfc, 0xfb, 0x1a,
+	0x8c, 0x45, 0x87, 0x24, 0xcf, 0x76, 0xdb, 0xaa, 0xd6, 0x3c, 0xfc, 0x05, 0xd0, 0x88, 0xa7, 0x21,
+	0xc5, 0x09, 0xb8, 0x70, 0x60, 0xff, 0xd5, 0x4c, 0x41, 0x56, 0x53, 0x0b, 0x61, 0x35, 0xb5, 0xb0,
+	0x13, 0x56, 0x53, 0x17, 0x06, 0xb9, 0xb2, 0xb7, 0x3e, 0x9e, 0xd6, 0xa4, 0x04, 0xb1, 0x2c, 0xff,
+	0x2f, 0x0d, 0x46, 0x96, 0x62, 0xb0, 0x54, 0xdb, 0x60, 0x1c, 0x60, 0xaa, 0x96, 0xeb, 0x3b, 0x98,
+	0xb6, 0x79, 0x23, 0xb1, 0x22, 0xee, 0x3c, 0x07, 0x97, 0x5c, 0xdb, 0x65, 0x6d, 0x8e, 0xe7, 0x50,
+	0x4d, 0xfe, 0x4d, 0x0d, 0xf4, 0xe8, 0x20, 0x97, 0x45, 0x1d, 0x29, 0xae, 0x21, 0x9d, 0x3d, 0x9b,
+	0xd9, 0x85, 0xf1, 0x98, 0x9f, 0x46, 0xb4, 0x6b, 0xd1, 0xaa, 0xa1, 0x6f, 0x41, 0xaf, 0x78, 0x95,
+	0xf2, 0x97, 0xf8, 0xe9, 0x6a, 0xde, 0x3d, 0xfc, 0xf9, 0x6a, 0x31, 0x9c, 0xbf, 0x01, 0x43, 0xf3,
+	0x8d, 0x2f, 0x52, 0x4e, 0x49, 0xd5, 0x93, 0x55, 0x22, 0xae, 0x6a, 0xa1, 0x69, 0xe8, 0x67, 0x84,
+	0x89, 0xab, 0x7b, 0x1f, 0x53, 0x11, 0x6a, 0x5d, 0x06, 0x88, 0x2e, 0x51, 0x45, 0xc9, 0x6f, 0x42,
+	0xba, 0x49, 0x96, 0xe8, 0xe7, 0x17, 0x9c, 0x5c, 0xa2, 0x89, 0x25, 0xb2, 0x81, 0x2e, 0xc1, 0x40,
+	0xe0, 0x96, 0x7d, 0xec, 0x34, 0xc8, 0xeb, 0x97, 0x7d, 0x52, 0xe0, 0x3b, 0x9d, 0xd0, 0x27, 0x1e,
+	0xc8, 0xfc, 0x1a, 0xe5, 0x76, 0x29, 0x6c, 0x96, 0x72, 0x54, 0x0b, 0xed, 0xc3, 0x79, 0x7e, 0xa3,
+	0x9b, 0x36, 0xf1, 0x3c, 0x6c, 0x33, 0x1c, 0xe2, 0xe0, 0x09, 0x37, 0xde, 0x57, 0x3f, 0xef, 0x8d,
+	0xa7, 0xd8, 0x0c, 0xd7, 0xb3, 0x18, 0xaa, 0x41, 0xaf, 0x6b, 0x30, 0x2c, 0x34, 0x47, 0x28, 0x82,
+	0x9d, 0x36, 0xf3, 0x8a, 0x21, 0xae, 0x6f, 0x29, 0x56, 0x97, 0x0f, 0x60, 0xb2, 0x09, 0x83, 0xd6,
+	0xeb, 0x1e, 0x73, 0x6b, 0x9e, 0x8b, 0x29, 0x7a, 0x01, 0xa0, 0x1a, 0xb5, 0x4e, 0x19, 0x31, 0x09,
+	0x49, 0xf9, 0x2b, 0xc9, 0x17, 0x94, 0xba, 0x08, 0x9f, 0x93, 0x87, 0xd1, 0x78, 0x48, 0xa9, 0xe8,
+	0x4a, 0xbc, 0x06, 0x53, 0xc7, 0x04, 0xf5, 0xf2, 0x01, 0xae, 0x4a, 0x32, 0x33, 0x0e, 0xdd, 0xaa,
+	0x38, 0xab, 0x89, 0xe2, 0xac, 0x6a, 0xe5, 0x7f, 0xad, 0x41, 0x3a, 0x9e, 0x1f, 0x22, 0xb3, 0xe5,
+	0x3d, 0x4c, 0x51, 0x82, 0xf7, 0x74, 0x9e, 0x31, 0xef, 0xc9, 0xff, 0x22, 0xf9, 0x30, 0x3a, 0xa6,
+	0x26, 0xf2, 0xba, 0x76, 0xcc, 0x8b, 0xed, 0x0c, 0xec, 0x4b, 0x3e, 0xde, 0xfe, 0xac, 0xc1, 0x44,
+	0x4c, 0x4d, 0x14, 0xc8, 0x29, 0x2a, 0xf5, 0x38, 0x0c, 0x05, 0x8a, 0x83, 0x35, 0x71, 0xae, 0xb0,
+	0x5b, 0x4d, 0xfc, 0x3f, 0x18, 0xc4, 0xbe, 0x93, 0x98, 0x26, 0xdf, 0xae, 0x03, 0xb2, 0x53, 0x4d,
+	0x4a, 0x00, 0x67, 0xd7, 0xd9, 0x00, 0xe7, 0x3f, 0xbb, 0xe0, 0x62, 0xbc, 0xb7, 0x38, 0x56, 0x16,
+	0x28, 0xb6, 0x76, 0x1d, 0xb2, 0xef, 0xa3, 0x9b, 0xd0, 0x23, 0x2d, 0x0e, 0xc1, 0xfc, 0xa9, 0x13,
+	0x89, 0xc0, 0x43, 0x1c, 0xd5, 0xc0, 0x06, 0x94, 0x3c, 0xf4, 0x1a, 0xf4, 0x28, 0xce, 0x7f, 0x76,
+	0x81, 0x17, 0x6a, 0x44, 0xb7, 0x20, 0xe5, 0xd4, 0x03, 0xd6, 0x66, 0x47, 0x0b, 0x1d, 0xbc, 0x54,
+	0x89, 0x42, 0x0e, 0xee, 0x1d, 0x9a, 0xe1, 0xa6, 0xcf, 0xec, 0x95, 0x31, 0x12, 0x2b, 0x5f, 0x94,
+	0xba, 0xf9, 0x6b, 0x3b, 0xae, 0x92, 0xed, 0xf0, 0xc2, 0x2c, 0xaf, 0x4a, 0x3e, 0x14, 0x22, 0x5a,
+	0xbe, 0x1f, 0x77, 0xfe, 0xef, 0xbe, 0x1f, 0xe7, 0x7f, 0xa2, 0x81, 0x1e, 0x31, 0x7e, 0x71, 0x79,
+	0x25, 0x40, 0x2b, 0x06, 0x27, 0xed, 0xac, 0xc1, 0xe9, 0xed, 0x38, 0xf1, 0x09, 0x55, 0x8e, 0x0b,
+	0x39, 0xce, 0x6b, 0xcd, 0x1c, 0xe7, 0x2c, 0x62, 0x37, 0xcc, 0xda, 0x7f, 0x0c, 0xc0, 0x70, 0x4c,
+	0x1b, 0xd4, 0xd1, 0xdd, 0x82, 0x94, 0x78, 0xa5, 0xb7, 0x97, 0x72, 0x09, 0x1d, 0xe8, 0xc7, 0x1a,
+	0x64, 0x48, 0x5c, 0xc6, 0x0a, 0x3f, 0x2c, 0x9a, 0x45, 0x5c, 0x22, 0x14, 0xb7, 0x99, 0x84, 0xea,
+	0xa4, 0xa5, 0x80, 0xb6, 0x20, 0xf4, 0xa2, 0x1f, 0x69, 0x30, 0x79, 0x9c, 0x59, 0x56, 0x89, 0x61,
+	0xda, 0xe6, 0x4c, 0x9f, 0x68, 0xb5, 0x6a, 0x9e, 0xab, 0x45, 0x47, 0x5a, 0xcb, 0x17, 0x3f, 0xe5,
+	0xa6, 0x54, 0x5b, 0x0d, 0x6a, 0xfc, 0x1a, 0xa8, 0x3c, 0x74, 0x5b, 0x83, 0x74, 0x93, 0x31, 0xd2,
+	0x39, 0xe7, 0xda, 0x6a, 0x0b, 0x6a, 0xb0, 0x45, 0xfa, 0xe5, 0x0d, 0x0d, 0x46, 0x1b, 0xbe, 0x1c,
+	0x2a, 0xaf, 0x74, 0xb7, 0xd5, 0x92, 0x91, 0x44, 0x81, 0x4b, 0xf9, 0xe4, 0xbb, 0x1a, 0xa0, 0x06,
+	0x43, 0xa4, 0x47, 0x7a, 0xda, 0x6a, 0xc7, 0x70, 0xc2, 0x0e, 0xe9, 0x0f, 0xfe, 0x79, 0x00, 0xab,
+	0x97, 0x54, 0x73, 0x42, 0xf5, 0xb6, 0xf7, 0xf3, 0x00, 0x6e, 0x7c, 0xc0, 0x29, 0xbf, 0xbc, 0xa9,
+	0xc1, 0x78, 0x8b, 0x41, 0xd2, 0x37, 0x7d, 0x6d, 0xb5, 0x27, 0xdd, 0x64, 0x8f, 0xf4, 0x0f, 0xaf,
+	0xd9, 0x10, 0xf1, 0x5e, 0x80, 0x36, 0xd7, 0x6c, 0x84, 0x16, 0x11, 0x9f, 0xc9, 0xf2, 0x67, 0x78,
+	0x16, 0xfd, 0xed, 0x8d, 0xcf, 0x44, 0x15, 0x34, 0x11, 0x9f, 0x0d, 0x86, 0xc8, 0x33, 0x18, 0x68,
+	0x6f, 0x7c, 0x26, 0xec, 0x10, 0xfe, 0xcf, 0xff, 0x41, 0x83, 0xc7, 0x1e, 0x5e, 0x92, 0xe5, 0x37,
+	0xf6, 0x12, 0xae, 0x91, 0xc0, 0x65, 0x6d, 0xaa, 0xce, 0x8e, 0x27, 0xaa, 0xb3, 0x7c, 0x48, 0xb5,
+	0x90, 0xce, 0x8b, 0x1f, 0x42, 0xb1, 0xfc, 0x79, 0x95, 0x11, 0x36, 0xaf, 0xff, 0xff, 0xed, 0xcf,
+	0x52, 0x50, 0xfd, 0x9d, 0x06, 0x13, 0xad, 0xfb, 0x92, 0xa5, 0xb9, 0x6b, 0x49, 0x8b, 0xfe, 0x5b,
+	0x71, 0xee, 0xd8, 0x4a, 0xf2, 0x59, 0xbf, 0xa8, 0x66, 0x5f, 0x85, 0x81, 0xe4, 0x6f, 0x6b, 0xd0,
+	0x53, 0x30, 0x6e, 0x6c, 0x3e, 0xbf, 0xb1, 0xb4, 0xba, 0xf1, 0xac, 0xb9, 0xbe, 0xb9, 0xb4, 0x6c,
+	0xee, 0x18, 0xcf, 0x6f, 0x2c, 0xce, 0xef, 0x2c, 0x0f, 0x77, 0x64, 0xf4, 0xa3, 0x3b, 0xb9, 0x74,
+	0x72, 0xf6, 0x0e, 0xad, 0xfb, 0x36, 0xff, 0x81, 0x41, 0x01, 0x46, 0x1b, 0x57, 0x89, 0xd6, 0xb0,
+	0x96, 0x19, 0x3b, 0xba, 0x93, 0x1b, 0x49, 0x2e, 0x11, 0x7f, 0x67, 0x52, 0xb7, 0xdf, 0xcd, 0x76,
+	0xcc, 0x7e, 0xaa, 0xc1, 0x48, 0xcb, 0x8f, 0x46, 0xd0, 0x0d, 0xc8, 0xbf, 0xb4, 0x6c, 0x6c, 0x9a,
+	0x5b, 0x9b, 0x2f, 0x2e, 0x1b, 0xe6, 0xf6, 0x8e, 0x31, 0xbf, 0xb3, 0xfc, 0xec, 0x4d, 0x73, 0x71,
+	0x73, 0x7d, 0xfd, 0xf9, 0x8d, 0xd5, 0x9d, 0x9b, 0xe6, 0xd6, 0xe6, 0xe6, 0xda, 0x70, 0x47, 0x26,
+	0x7f, 0x74, 0x27, 0x97, 0x6d, 0x59, 0xde, 0x70, 0x34, 0x68, 0x05, 0x72, 0xc7, 0xc9, 0x5a, 0x5a,
+	0x5e, 0x5c, 0x5d, 0x9f, 0x5f, 0x93, 0x92, 0xb4, 0x4c, 0xee, 0xe8, 0x4e, 0x6e, 0xaa, 0x45, 0x52,
+	0xa2, 0x5a, 0x87, 0xbe, 0x0e, 0x93, 0xc7, 0xda, 0x34, 0x6f, 0x18, 0x37, 0x87, 0x3b, 0x33, 0x99,
+	0xa3, 0x3b, 0xb9, 0xf1, 0x56, 0x53, 0x2c, 0x4a, 0x0f, 0xe5, 0x56, 0x17, 0x9e, 0x79, 0xef, 0x7e,
+	0x56, 0x7b, 0xff, 0x7e, 0x56, 0xfb, 0xe0, 0x7e, 0x56, 0xfb, 0xeb, 0xfd, 0xac, 0xf6, 0xd6, 0x27,
+	0xd9, 0x8e, 0x0f, 0x3e, 0xc9, 0x76, 0xfc, 0xe9, 0x93, 0x6c, 0xc7, 0x4b, 0x97, 0x1a, 0xf8, 0x70,
+	0xd3, 0x17, 0x71, 0x71, 0x96, 0xc5, 0x6e, 0x51, 0x68, 0xfc, 0xca, 0x7f, 0x06, 0x00, 0xd9, 0x81,
+	0x1c, 0x2e, 0x77, 0x2a, 0x00, 0x00,
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x2a
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	if !this.CommunityTax.Equal(that1.CommunityTax) {
+		return false
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	if !this.BaseProposerReward.Equal(that1.BaseProposerReward) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if !this.BonusProposerReward.Equal(that1.BonusProposerReward) {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
+		return false
 	}
-	var l int
-	_ = l
-	l = m.CommunityTax.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BaseProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BonusProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	if m.WithdrawAddrEnabled {
-		n += 2
+	if !this.DecimalPoolFlushThreshold.Equal(that1.DecimalPoolFlushThreshold) {
+		return false
 	}
-	return n
-}
-
-func (m *ValidatorHistoricalRewards) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.DistributionPaused != that1.DistributionPaused {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.CumulativeRewardRatio) > 0 {
-		for _, e := range m.CumulativeRewardRatio {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	if this.WithholdJailedRewards != that1.WithholdJailedRewards {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	if !this.BurnRate.Equal(that1.BurnRate) {
+		return false
+	}
+	if !this.MinSelfBondForRewards.Equal(that1.MinSelfBondForRewards) {
+		return false
+	}
+	if len(this.SubsidyFloor) != len(that1.SubsidyFloor) {
+		return false
+	}
+	for i := range this.SubsidyFloor {
+		if !this.SubsidyFloor[i].Equal(&that1.SubsidyFloor[i]) {
+			return false
 		}
 	}
-	if m.ReferenceCount != 0 {
-		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	if this.EscrowJailedRewards != that1.EscrowJailedRewards {
+		return false
 	}
-	return n
-}
-
-func (m *ValidatorCurrentRewards) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.DistributableDenoms) != len(that1.DistributableDenoms) {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	for i := range this.DistributableDenoms {
+		if this.DistributableDenoms[i] != that1.DistributableDenoms[i] {
+			return false
 		}
 	}
-	if m.Period != 0 {
-		n += 1 + sovDistribution(uint64(m.Period))
+	if this.RoundingMode != that1.RoundingMode {
+		return false
 	}
-	return n
-}
-
-func (m *ValidatorAccumulatedCommission) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.MaxWithdrawPerBlock) != len(that1.MaxWithdrawPerBlock) {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.Commission) > 0 {
-		for _, e := range m.Commission {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	for i := range this.MaxWithdrawPerBlock {
+		if !this.MaxWithdrawPerBlock[i].Equal(&that1.MaxWithdrawPerBlock[i]) {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *ValidatorOutstandingRewards) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.CommissionGracePeriodBlocks != that1.CommissionGracePeriodBlocks {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	if len(this.CommunityPoolRoutes) != len(that1.CommunityPoolRoutes) {
+		return false
+	}
+	for i := range this.CommunityPoolRoutes {
+		if !this.CommunityPoolRoutes[i].Equal(&that1.CommunityPoolRoutes[i]) {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *ValidatorSlashEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.ZeroPowerStrategy != that1.ZeroPowerStrategy {
+		return false
 	}
-	var l int
-	_ = l
-	if m.ValidatorPeriod != 0 {
-		n += 1 + sovDistribution(uint64(m.ValidatorPeriod))
+	if this.DecimalPoolFlushInterval != that1.DecimalPoolFlushInterval {
+		return false
 	}
-	l = m.Fraction.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	return n
-}
-
-func (m *ValidatorSlashEvents) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.RecordCommissionHistory != that1.RecordCommissionHistory {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.ValidatorSlashEvents) > 0 {
-		for _, e := range m.ValidatorSlashEvents {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	if !this.InfraFundRate.Equal(that1.InfraFundRate) {
+		return false
+	}
+	if this.InfraFundModule != that1.InfraFundModule {
+		return false
+	}
+	if !this.CommissionRebateRate.Equal(that1.CommissionRebateRate) {
+		return false
+	}
+	if len(this.MinWithdrawAmount) != len(that1.MinWithdrawAmount) {
+		return false
+	}
+	for i := range this.MinWithdrawAmount {
+		if !this.MinWithdrawAmount[i].Equal(&that1.MinWithdrawAmount[i]) {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *FeePool) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.MaxRewardedValidators != that1.MaxRewardedValidators {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.CommunityPool) > 0 {
-		for _, e := range m.CommunityPool {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	if this.AllocationEpochBlocks != that1.AllocationEpochBlocks {
+		return false
+	}
+	if len(this.CommunityPoolDenomDestinations) != len(that1.CommunityPoolDenomDestinations) {
+		return false
+	}
+	for i := range this.CommunityPoolDenomDestinations {
+		if !this.CommunityPoolDenomDestinations[i].Equal(&that1.CommunityPoolDenomDestinations[i]) {
+			return false
 		}
 	}
-	return n
+	if this.RecordLifetimeCommission != that1.RecordLifetimeCommission {
+		return false
+	}
+	if this.CommunityTaxHistoryLength != that1.CommunityTaxHistoryLength {
+		return false
+	}
+	if this.KeepCommunityFundsLocal != that1.KeepCommunityFundsLocal {
+		return false
+	}
+	if this.StickyWithdrawAddress != that1.StickyWithdrawAddress {
+		return false
+	}
+	return true
 }
+func (this *CommunityPoolDenomDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CommunityPoolSpendProposal) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*CommunityPoolDenomDestination)
+	if !ok {
+		that2, ok := that.(CommunityPoolDenomDestination)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if this.Denom != that1.Denom {
+		return false
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if this.Module != that1.Module {
+		return false
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	return true
+}
+func (this *CommunityPoolRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityPoolRoute)
+	if !ok {
+		that2, ok := that.(CommunityPoolRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Module != that1.Module {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
 }
+func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DelegatorStartingInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ValidatorHistoricalRewards)
+	if !ok {
+		that2, ok := that.(ValidatorHistoricalRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.PreviousPeriod != 0 {
-		n += 1 + sovDistribution(uint64(m.PreviousPeriod))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = m.Stake.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovDistribution(uint64(m.Height))
+	if len(this.CumulativeRewardRatio) != len(that1.CumulativeRewardRatio) {
+		return false
 	}
-	return n
+	for i := range this.CumulativeRewardRatio {
+		if !this.CumulativeRewardRatio[i].Equal(&that1.CumulativeRewardRatio[i]) {
+			return false
+		}
+	}
+	if this.ReferenceCount != that1.ReferenceCount {
+		return false
+	}
+	return true
 }
-
-func (m *DelegationDelegatorReward) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ValidatorCurrentRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+
+	that1, ok := that.(*ValidatorCurrentRewards)
+	if !ok {
+		that2, ok := that.(ValidatorCurrentRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Reward) > 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
+	}
+	if this.Period != that1.Period {
+		return false
+	}
+	return true
+}
+func (this *ValidatorAccumulatedCommission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorAccumulatedCommission)
+	if !ok {
+		that2, ok := that.(ValidatorAccumulatedCommission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Commission) != len(that1.Commission) {
+		return false
+	}
+	for i := range this.Commission {
+		if !this.Commission[i].Equal(&that1.Commission[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorOutstandingRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorOutstandingRewards)
+	if !ok {
+		that2, ok := that.(ValidatorOutstandingRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorSlashEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorSlashEvent)
+	if !ok {
+		that2, ok := that.(ValidatorSlashEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorPeriod != that1.ValidatorPeriod {
+		return false
+	}
+	if !this.Fraction.Equal(that1.Fraction) {
+		return false
+	}
+	return true
+}
+func (this *ValidatorSlashEvents) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorSlashEvents)
+	if !ok {
+		that2, ok := that.(ValidatorSlashEvents)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ValidatorSlashEvents) != len(that1.ValidatorSlashEvents) {
+		return false
+	}
+	for i := range this.ValidatorSlashEvents {
+		if !this.ValidatorSlashEvents[i].Equal(&that1.ValidatorSlashEvents[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FeePool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeePool)
+	if !ok {
+		that2, ok := that.(FeePool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.CommunityPool) != len(that1.CommunityPool) {
+		return false
+	}
+	for i := range this.CommunityPool {
+		if !this.CommunityPool[i].Equal(&that1.CommunityPool[i]) {
+			return false
+		}
+	}
+	if len(this.DecimalPool) != len(that1.DecimalPool) {
+		return false
+	}
+	for i := range this.DecimalPool {
+		if !this.DecimalPool[i].Equal(&that1.DecimalPool[i]) {
+			return false
+		}
+	}
+	if len(this.CarriedFees) != len(that1.CarriedFees) {
+		return false
+	}
+	for i := range this.CarriedFees {
+		if !this.CarriedFees[i].Equal(&that1.CarriedFees[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorStartingInfo)
+	if !ok {
+		that2, ok := that.(DelegatorStartingInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PreviousPeriod != that1.PreviousPeriod {
+		return false
+	}
+	if !this.Stake.Equal(that1.Stake) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationDelegatorReward)
+	if !ok {
+		that2, ok := that.(DelegationDelegatorReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if len(this.Reward) != len(that1.Reward) {
+		return false
+	}
+	for i := range this.Reward {
+		if !this.Reward[i].Equal(&that1.Reward[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *WithdrawSplitEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawSplitEntry)
+	if !ok {
+		that2, ok := that.(WithdrawSplitEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *WithdrawSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawSplit)
+	if !ok {
+		that2, ok := that.(WithdrawSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(&that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorRewardSample) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorRewardSample)
+	if !ok {
+		that2, ok := that.(ValidatorRewardSample)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
+}
+func (this *DecimalPoolSample) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DecimalPoolSample)
+	if !ok {
+		that2, ok := that.(DecimalPoolSample)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Remainder) != len(that1.Remainder) {
+		return false
+	}
+	for i := range this.Remainder {
+		if !this.Remainder[i].Equal(&that1.Remainder[i]) {
+			return false
+		}
+	}
+	if len(this.Deficit) != len(that1.Deficit) {
+		return false
+	}
+	for i := range this.Deficit {
+		if !this.Deficit[i].Equal(&that1.Deficit[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorEscrowedRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorEscrowedRewards)
+	if !ok {
+		that2, ok := that.(ValidatorEscrowedRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorCommissionCap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorCommissionCap)
+	if !ok {
+		that2, ok := that.(ValidatorCommissionCap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxRate.Equal(that1.MaxRate) {
+		return false
+	}
+	return true
+}
+func (this *AllocationEpoch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AllocationEpoch)
+	if !ok {
+		that2, ok := that.(AllocationEpoch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Blocks != that1.Blocks {
+		return false
+	}
+	if this.TotalPower != that1.TotalPower {
+		return false
+	}
+	return true
+}
+func (this *AllocationEpochPower) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AllocationEpochPower)
+	if !ok {
+		that2, ok := that.(AllocationEpochPower)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if this.SignedPower != that1.SignedPower {
+		return false
+	}
+	return true
+}
+func (this *BlockFees) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockFees)
+	if !ok {
+		that2, ok := that.(BlockFees)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if len(this.FeesCollected) != len(that1.FeesCollected) {
+		return false
+	}
+	for i := range this.FeesCollected {
+		if !this.FeesCollected[i].Equal(&that1.FeesCollected[i]) {
+			return false
+		}
+	}
+	if len(this.FeesDistributed) != len(that1.FeesDistributed) {
+		return false
+	}
+	for i := range this.FeesDistributed {
+		if !this.FeesDistributed[i].Equal(&that1.FeesDistributed[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorRewardMultiplier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorRewardMultiplier)
+	if !ok {
+		that2, ok := that.(ValidatorRewardMultiplier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Multiplier.Equal(that1.Multiplier) {
+		return false
+	}
+	return true
+}
+func (this *ValidatorCreationHeight) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorCreationHeight)
+	if !ok {
+		that2, ok := that.(ValidatorCreationHeight)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *ValidatorCommissionExemption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorCommissionExemption)
+	if !ok {
+		that2, ok := that.(ValidatorCommissionExemption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Denoms) != len(that1.Denoms) {
+		return false
+	}
+	for i := range this.Denoms {
+		if this.Denoms[i] != that1.Denoms[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *CommissionWithdrawal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionWithdrawal)
+	if !ok {
+		that2, ok := that.(CommissionWithdrawal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorLifetimeCommission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorLifetimeCommission)
+	if !ok {
+		that2, ok := that.(ValidatorLifetimeCommission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Commission) != len(that1.Commission) {
+		return false
+	}
+	for i := range this.Commission {
+		if !this.Commission[i].Equal(&that1.Commission[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DelegationRewardsPeriod) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationRewardsPeriod)
+	if !ok {
+		that2, ok := that.(DelegationRewardsPeriod)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StartingPeriod != that1.StartingPeriod {
+		return false
+	}
+	if this.EndingPeriod != that1.EndingPeriod {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DelegationWithdrawalBreakdown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationWithdrawalBreakdown)
+	if !ok {
+		that2, ok := that.(DelegationWithdrawalBreakdown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Periods) != len(that1.Periods) {
+		return false
+	}
+	for i := range this.Periods {
+		if !this.Periods[i].Equal(&that1.Periods[i]) {
+			return false
+		}
+	}
+	if len(this.Carried) != len(that1.Carried) {
+		return false
+	}
+	for i := range this.Carried {
+		if !this.Carried[i].Equal(&that1.Carried[i]) {
+			return false
+		}
+	}
+	if len(this.Dust) != len(that1.Dust) {
+		return false
+	}
+	for i := range this.Dust {
+		if !this.Dust[i].Equal(&that1.Dust[i]) {
+			return false
+		}
+	}
+	if len(this.PreviouslyCarried) != len(that1.PreviouslyCarried) {
+		return false
+	}
+	for i := range this.PreviouslyCarried {
+		if !this.PreviouslyCarried[i].Equal(&that1.PreviouslyCarried[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CommunityTaxRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityTaxRecord)
+	if !ok {
+		that2, ok := that.(CommunityTaxRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.CommunityTax.Equal(that1.CommunityTax) {
+		return false
+	}
+	return true
+}
+func (this *DelegatorBlockWithdrawal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorBlockWithdrawal)
+	if !ok {
+		that2, ok := that.(DelegatorBlockWithdrawal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DelegatorCarriedRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorCarriedRewards)
+	if !ok {
+		that2, ok := that.(DelegatorCarriedRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AllocationRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AllocationRecord)
+	if !ok {
+		that2, ok := that.(AllocationRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Fees) != len(that1.Fees) {
+		return false
+	}
+	for i := range this.Fees {
+		if !this.Fees[i].Equal(&that1.Fees[i]) {
+			return false
+		}
+	}
+	if len(this.OutstandingRewardsBefore) != len(that1.OutstandingRewardsBefore) {
+		return false
+	}
+	for i := range this.OutstandingRewardsBefore {
+		if !this.OutstandingRewardsBefore[i].Equal(&that1.OutstandingRewardsBefore[i]) {
+			return false
+		}
+	}
+	if len(this.OutstandingRewardsAfter) != len(that1.OutstandingRewardsAfter) {
+		return false
+	}
+	for i := range this.OutstandingRewardsAfter {
+		if !this.OutstandingRewardsAfter[i].Equal(&that1.OutstandingRewardsAfter[i]) {
+			return false
+		}
+	}
+	if len(this.CommunityPoolBefore) != len(that1.CommunityPoolBefore) {
+		return false
+	}
+	for i := range this.CommunityPoolBefore {
+		if !this.CommunityPoolBefore[i].Equal(&that1.CommunityPoolBefore[i]) {
+			return false
+		}
+	}
+	if len(this.CommunityPoolAfter) != len(that1.CommunityPoolAfter) {
+		return false
+	}
+	for i := range this.CommunityPoolAfter {
+		if !this.CommunityPoolAfter[i].Equal(&that1.CommunityPoolAfter[i]) {
+			return false
+		}
+	}
+	if len(this.DecimalPoolBefore) != len(that1.DecimalPoolBefore) {
+		return false
+	}
+	for i := range this.DecimalPoolBefore {
+		if !this.DecimalPoolBefore[i].Equal(&that1.DecimalPoolBefore[i]) {
+			return false
+		}
+	}
+	if len(this.DecimalPoolAfter) != len(that1.DecimalPoolAfter) {
+		return false
+	}
+	for i := range this.DecimalPoolAfter {
+		if !this.DecimalPoolAfter[i].Equal(&that1.DecimalPoolAfter[i]) {
+			return false
+		}
+	}
+	if len(this.EscrowedRewardsBefore) != len(that1.EscrowedRewardsBefore) {
+		return false
+	}
+	for i := range this.EscrowedRewardsBefore {
+		if !this.EscrowedRewardsBefore[i].Equal(&that1.EscrowedRewardsBefore[i]) {
+			return false
+		}
+	}
+	if len(this.EscrowedRewardsAfter) != len(that1.EscrowedRewardsAfter) {
+		return false
+	}
+	for i := range this.EscrowedRewardsAfter {
+		if !this.EscrowedRewardsAfter[i].Equal(&that1.EscrowedRewardsAfter[i]) {
+			return false
+		}
+	}
+	if len(this.Routed) != len(that1.Routed) {
+		return false
+	}
+	for i := range this.Routed {
+		if !this.Routed[i].Equal(&that1.Routed[i]) {
+			return false
+		}
+	}
+	if len(this.CarriedFeesBefore) != len(that1.CarriedFeesBefore) {
+		return false
+	}
+	for i := range this.CarriedFeesBefore {
+		if !this.CarriedFeesBefore[i].Equal(&that1.CarriedFeesBefore[i]) {
+			return false
+		}
+	}
+	if len(this.CarriedFeesAfter) != len(that1.CarriedFeesAfter) {
+		return false
+	}
+	for i := range this.CarriedFeesAfter {
+		if !this.CarriedFeesAfter[i].Equal(&that1.CarriedFeesAfter[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityPoolSpendProposalWithDeposit)
+	if !ok {
+		that2, ok := that.(CommunityPoolSpendProposalWithDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.Deposit != that1.Deposit {
+		return false
+	}
+	return true
+}
+func (this *CommunityPoolSpendEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityPoolSpendEntry)
+	if !ok {
+		that2, ok := that.(CommunityPoolSpendEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StickyWithdrawAddress {
+		i--
+		if m.StickyWithdrawAddress {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.KeepCommunityFundsLocal {
+		i--
+		if m.KeepCommunityFundsLocal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.DistributionPaused {
+		i--
+		if m.DistributionPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.CommunityTaxHistoryLength != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.CommunityTaxHistoryLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.RecordLifetimeCommission {
+		i--
+		if m.RecordLifetimeCommission {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.CommunityPoolDenomDestinations) > 0 {
+		for iNdEx := len(m.CommunityPoolDenomDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPoolDenomDestinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.AllocationEpochBlocks != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AllocationEpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.MaxRewardedValidators != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxRewardedValidators))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.MinWithdrawAmount) > 0 {
+		for iNdEx := len(m.MinWithdrawAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinWithdrawAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	{
+		size := m.CommissionRebateRate.Size()
+		i -= size
+		if _, err := m.CommissionRebateRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if len(m.InfraFundModule) > 0 {
+		i -= len(m.InfraFundModule)
+		copy(dAtA[i:], m.InfraFundModule)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.InfraFundModule)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	{
+		size := m.InfraFundRate.Size()
+		i -= size
+		if _, err := m.InfraFundRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.RecordCommissionHistory {
+		i--
+		if m.RecordCommissionHistory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.DecimalPoolFlushInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.DecimalPoolFlushInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.ZeroPowerStrategy != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ZeroPowerStrategy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.CommunityPoolRoutes) > 0 {
+		for iNdEx := len(m.CommunityPoolRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPoolRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.CommissionGracePeriodBlocks != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.CommissionGracePeriodBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.MaxWithdrawPerBlock) > 0 {
+		for iNdEx := len(m.MaxWithdrawPerBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxWithdrawPerBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.RoundingMode != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RoundingMode))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.DistributableDenoms) > 0 {
+		for iNdEx := len(m.DistributableDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DistributableDenoms[iNdEx])
+			copy(dAtA[i:], m.DistributableDenoms[iNdEx])
+			i = encodeVarintDistribution(dAtA, i, uint64(len(m.DistributableDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.EscrowJailedRewards {
+		i--
+		if m.EscrowJailedRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.SubsidyFloor) > 0 {
+		for iNdEx := len(m.SubsidyFloor) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubsidyFloor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size := m.MinSelfBondForRewards.Size()
+		i -= size
+		if _, err := m.MinSelfBondForRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BurnRate.Size()
+		i -= size
+		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.WithholdJailedRewards {
+		i--
+		if m.WithholdJailedRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.DecimalPoolFlushThreshold.Size()
+		i -= size
+		if _, err := m.DecimalPoolFlushThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.WithdrawAddrEnabled {
+		i--
+		if m.WithdrawAddrEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.BonusProposerReward.Size()
+		i -= size
+		if _, err := m.BonusProposerReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseProposerReward.Size()
+		i -= size
+		if _, err := m.BaseProposerReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CommunityTax.Size()
+		i -= size
+		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolDenomDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolDenomDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolDenomDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorHistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorHistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReferenceCount != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ReferenceCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CumulativeRewardRatio) > 0 {
+		for iNdEx := len(m.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeRewardRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCurrentRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCurrentRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorAccumulatedCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAccumulatedCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorAccumulatedCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commission) > 0 {
+		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOutstandingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSlashEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ValidatorPeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ValidatorPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSlashEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorSlashEvents) > 0 {
+		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSlashEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CarriedFees) > 0 {
+		for iNdEx := len(m.CarriedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CarriedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DecimalPool) > 0 {
+		for iNdEx := len(m.DecimalPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecimalPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PreviousPeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawSplitEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawSplitEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawSplitEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDistribution(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecimalPoolSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecimalPoolSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecimalPoolSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deficit) > 0 {
+		for iNdEx := len(m.Deficit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deficit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Remainder) > 0 {
+		for iNdEx := len(m.Remainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEscrowedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEscrowedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEscrowedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCommissionCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AllocationEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocationEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocationEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllocationEpochPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocationEpochPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocationEpochPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignedPower != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.SignedPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Power != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesDistributed) > 0 {
+		for iNdEx := len(m.FeesDistributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesDistributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeesCollected) > 0 {
+		for iNdEx := len(m.FeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCreationHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCreationHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCreationHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCommissionExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLifetimeCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLifetimeCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLifetimeCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commission) > 0 {
+		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationRewardsPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationRewardsPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationRewardsPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EndingPeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.EndingPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartingPeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.StartingPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationWithdrawalBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationWithdrawalBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationWithdrawalBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviouslyCarried) > 0 {
+		for iNdEx := len(m.PreviouslyCarried) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviouslyCarried[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Carried) > 0 {
+		for iNdEx := len(m.Carried) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Carried[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityTaxRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityTaxRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityTaxRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommunityTax.Size()
+		i -= size
+		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorBlockWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorBlockWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorBlockWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorCarriedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorCarriedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorCarriedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllocationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CarriedFeesAfter) > 0 {
+		for iNdEx := len(m.CarriedFeesAfter) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CarriedFeesAfter[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.CarriedFeesBefore) > 0 {
+		for iNdEx := len(m.CarriedFeesBefore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CarriedFeesBefore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Routed) > 0 {
+		for iNdEx := len(m.Routed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.EscrowedRewardsAfter) > 0 {
+		for iNdEx := len(m.EscrowedRewardsAfter) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedRewardsAfter[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.EscrowedRewardsBefore) > 0 {
+		for iNdEx := len(m.EscrowedRewardsBefore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedRewardsBefore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DecimalPoolAfter) > 0 {
+		for iNdEx := len(m.DecimalPoolAfter) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecimalPoolAfter[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DecimalPoolBefore) > 0 {
+		for iNdEx := len(m.DecimalPoolBefore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecimalPoolBefore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CommunityPoolAfter) > 0 {
+		for iNdEx := len(m.CommunityPoolAfter) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPoolAfter[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CommunityPoolBefore) > 0 {
+		for iNdEx := len(m.CommunityPoolBefore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPoolBefore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OutstandingRewardsAfter) > 0 {
+		for iNdEx := len(m.OutstandingRewardsAfter) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutstandingRewardsAfter[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.OutstandingRewardsBefore) > 0 {
+		for iNdEx := len(m.OutstandingRewardsBefore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutstandingRewardsBefore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolSpendEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BaseProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BonusProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.WithdrawAddrEnabled {
+		n += 2
+	}
+	l = m.DecimalPoolFlushThreshold.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.WithholdJailedRewards {
+		n += 2
+	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BurnRate.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.MinSelfBondForRewards.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if len(m.SubsidyFloor) > 0 {
+		for _, e := range m.SubsidyFloor {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.EscrowJailedRewards {
+		n += 2
+	}
+	if len(m.DistributableDenoms) > 0 {
+		for _, s := range m.DistributableDenoms {
+			l = len(s)
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.RoundingMode != 0 {
+		n += 1 + sovDistribution(uint64(m.RoundingMode))
+	}
+	if len(m.MaxWithdrawPerBlock) > 0 {
+		for _, e := range m.MaxWithdrawPerBlock {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.CommissionGracePeriodBlocks != 0 {
+		n += 2 + sovDistribution(uint64(m.CommissionGracePeriodBlocks))
+	}
+	if len(m.CommunityPoolRoutes) > 0 {
+		for _, e := range m.CommunityPoolRoutes {
+			l = e.Size()
+			n += 2 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.ZeroPowerStrategy != 0 {
+		n += 2 + sovDistribution(uint64(m.ZeroPowerStrategy))
+	}
+	if m.DecimalPoolFlushInterval != 0 {
+		n += 2 + sovDistribution(uint64(m.DecimalPoolFlushInterval))
+	}
+	if m.RecordCommissionHistory {
+		n += 3
+	}
+	l = m.InfraFundRate.Size()
+	n += 2 + l + sovDistribution(uint64(l))
+	l = len(m.InfraFundModule)
+	if l > 0 {
+		n += 2 + l + sovDistribution(uint64(l))
+	}
+	l = m.CommissionRebateRate.Size()
+	n += 2 + l + sovDistribution(uint64(l))
+	if len(m.MinWithdrawAmount) > 0 {
+		for _, e := range m.MinWithdrawAmount {
+			l = e.Size()
+			n += 2 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.MaxRewardedValidators != 0 {
+		n += 2 + sovDistribution(uint64(m.MaxRewardedValidators))
+	}
+	if m.AllocationEpochBlocks != 0 {
+		n += 2 + sovDistribution(uint64(m.AllocationEpochBlocks))
+	}
+	if len(m.CommunityPoolDenomDestinations) > 0 {
+		for _, e := range m.CommunityPoolDenomDestinations {
+			l = e.Size()
+			n += 2 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.RecordLifetimeCommission {
+		n += 3
+	}
+	if m.CommunityTaxHistoryLength != 0 {
+		n += 2 + sovDistribution(uint64(m.CommunityTaxHistoryLength))
+	}
+	if m.DistributionPaused {
+		n += 3
+	}
+	if m.KeepCommunityFundsLocal {
+		n += 3
+	}
+	if m.StickyWithdrawAddress {
+		n += 3
+	}
+	return n
+}
+
+func (m *CommunityPoolDenomDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *CommunityPoolRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorHistoricalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeRewardRatio) > 0 {
+		for _, e := range m.CumulativeRewardRatio {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	}
+	return n
+}
+
+func (m *ValidatorCurrentRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Period != 0 {
+		n += 1 + sovDistribution(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *ValidatorAccumulatedCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorOutstandingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorSlashEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorPeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.ValidatorPeriod))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorSlashEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorSlashEvents) > 0 {
+		for _, e := range m.ValidatorSlashEvents {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.DecimalPool) > 0 {
+		for _, e := range m.DecimalPool {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.CarriedFees) > 0 {
+		for _, e := range m.CarriedFees {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorStartingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousPeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.PreviousPeriod))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DelegationDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Reward) > 0 {
 		for _, e := range m.Reward {
 			l = e.Size()
 			n += 1 + l + sovDistribution(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *WithdrawSplitEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *WithdrawSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorRewardSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *DecimalPoolSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Remainder) > 0 {
+		for _, e := range m.Remainder {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.Deficit) > 0 {
+		for _, e := range m.Deficit {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorEscrowedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorCommissionCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxRate.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *AllocationEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovDistribution(uint64(m.Blocks))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovDistribution(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func (m *AllocationEpochPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovDistribution(uint64(m.Power))
+	}
+	if m.SignedPower != 0 {
+		n += 1 + sovDistribution(uint64(m.SignedPower))
+	}
+	return n
+}
+
+func (m *BlockFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	if len(m.FeesCollected) > 0 {
+		for _, e := range m.FeesCollected {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.FeesDistributed) > 0 {
+		for _, e := range m.FeesDistributed {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorRewardMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Multiplier.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorCreationHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ValidatorCommissionExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorLifetimeCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationRewardsPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartingPeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.StartingPeriod))
+	}
+	if m.EndingPeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.EndingPeriod))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationWithdrawalBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.Carried) > 0 {
+		for _, e := range m.Carried {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.PreviouslyCarried) > 0 {
+		for _, e := range m.PreviouslyCarried {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityTaxRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *DelegatorBlockWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorCarriedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllocationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.OutstandingRewardsBefore) > 0 {
+		for _, e := range m.OutstandingRewardsBefore {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.OutstandingRewardsAfter) > 0 {
+		for _, e := range m.OutstandingRewardsAfter {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.CommunityPoolBefore) > 0 {
+		for _, e := range m.CommunityPoolBefore {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.CommunityPoolAfter) > 0 {
+		for _, e := range m.CommunityPoolAfter {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.DecimalPoolBefore) > 0 {
+		for _, e := range m.DecimalPoolBefore {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.DecimalPoolAfter) > 0 {
+		for _, e := range m.DecimalPoolAfter {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.EscrowedRewardsBefore) > 0 {
+		for _, e := range m.EscrowedRewardsBefore {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.EscrowedRewardsAfter) > 0 {
+		for _, e := range m.EscrowedRewardsAfter {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.Routed) > 0 {
+		for _, e := range m.Routed {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.CarriedFeesBefore) > 0 {
+		for _, e := range m.CarriedFeesBefore {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.CarriedFeesAfter) > 0 {
+		for _, e := range m.CarriedFeesAfter {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseProposerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusProposerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BonusProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalPoolFlushThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecimalPoolFlushThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithholdJailedRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithholdJailedRewards = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfBondForRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfBondForRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubsidyFloor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubsidyFloor = append(m.SubsidyFloor, types.Coin{})
+			if err := m.SubsidyFloor[len(m.SubsidyFloor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowJailedRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EscrowJailedRewards = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributableDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributableDenoms = append(m.DistributableDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundingMode", wireType)
+			}
+			m.RoundingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundingMode |= RoundingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawPerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxWithdrawPerBlock = append(m.MaxWithdrawPerBlock, types.Coin{})
+			if err := m.MaxWithdrawPerBlock[len(m.MaxWithdrawPerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionGracePeriodBlocks", wireType)
+			}
+			m.CommissionGracePeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionGracePeriodBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolRoutes = append(m.CommunityPoolRoutes, CommunityPoolRoute{})
+			if err := m.CommunityPoolRoutes[len(m.CommunityPoolRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroPowerStrategy", wireType)
+			}
+			m.ZeroPowerStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZeroPowerStrategy |= ZeroPowerStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalPoolFlushInterval", wireType)
+			}
+			m.DecimalPoolFlushInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecimalPoolFlushInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordCommissionHistory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordCommissionHistory = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfraFundRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InfraFundRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfraFundModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfraFundModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRebateRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRebateRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWithdrawAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinWithdrawAmount = append(m.MinWithdrawAmount, types.Coin{})
+			if err := m.MinWithdrawAmount[len(m.MinWithdrawAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardedValidators", wireType)
+			}
+			m.MaxRewardedValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRewardedValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationEpochBlocks", wireType)
+			}
+			m.AllocationEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllocationEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolDenomDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolDenomDestinations = append(m.CommunityPoolDenomDestinations, CommunityPoolDenomDestination{})
+			if err := m.CommunityPoolDenomDestinations[len(m.CommunityPoolDenomDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordLifetimeCommission", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordLifetimeCommission = bool(v != 0)
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTaxHistoryLength", wireType)
+			}
+			m.CommunityTaxHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityTaxHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DistributionPaused = bool(v != 0)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepCommunityFundsLocal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeepCommunityFundsLocal = bool(v != 0)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyWithdrawAddress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StickyWithdrawAddress = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolDenomDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolDenomDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolDenomDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
+			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = append(m.Commission, types.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+			}
+			m.ValidatorPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
+			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecimalPool = append(m.DecimalPool, types.DecCoin{})
+			if err := m.DecimalPool[len(m.DecimalPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarriedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CarriedFees = append(m.CarriedFees, types.DecCoin{})
+			if err := m.CarriedFees[len(m.CarriedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+			}
+			m.PreviousPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types.DecCoin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawSplitEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawSplitEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawSplitEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, WithdrawSplitEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewardSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecimalPoolSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecimalPoolSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecimalPoolSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remainder = append(m.Remainder, types.DecCoin{})
+			if err := m.Remainder[len(m.Remainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deficit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deficit = append(m.Deficit, types.DecCoin{})
+			if err := m.Deficit[len(m.Deficit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEscrowedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEscrowedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEscrowedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCommissionCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocationEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocationEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocationEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocationEpochPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocationEpochPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocationEpochPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
+			}
+			m.SignedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesCollected = append(m.FeesCollected, types.Coin{})
+			if err := m.FeesCollected[len(m.FeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesDistributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesDistributed = append(m.FeesDistributed, types.DecCoin{})
+			if err := m.FeesDistributed[len(m.FeesDistributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewardMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCreationHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCreationHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCreationHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCommissionExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLifetimeCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLifetimeCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLifetimeCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = append(m.Commission, types.Coin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationRewardsPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationRewardsPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationRewardsPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingPeriod", wireType)
+			}
+			m.StartingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingPeriod", wireType)
+			}
+			m.EndingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDistribution(x uint64) (n int) {
-	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DelegationWithdrawalBreakdown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1848,17 +10221,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationWithdrawalBreakdown: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationWithdrawalBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -1868,31 +10241,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Periods = append(m.Periods, DelegationRewardsPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseProposerReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Carried", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -1902,31 +10275,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Carried = append(m.Carried, types.Coin{})
+			if err := m.Carried[len(m.Carried)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BonusProposerReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -1936,31 +10309,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BonusProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Dust = append(m.Dust, types.DecCoin{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviouslyCarried", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -1970,12 +10343,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithdrawAddrEnabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviouslyCarried = append(m.PreviouslyCarried, types.Coin{})
+			if err := m.PreviouslyCarried[len(m.PreviouslyCarried)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -1997,7 +10384,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
+func (m *CommunityTaxRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2020,17 +10407,17 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityTaxRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityTaxRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2040,31 +10427,16 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
-			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
 			}
-			m.ReferenceCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2074,11 +10446,26 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReferenceCount |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2100,7 +10487,7 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
+func (m *DelegatorBlockWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2123,15 +10510,15 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorBlockWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorBlockWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2158,30 +10545,11 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
-			}
-			m.Period = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2203,7 +10571,7 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
+func (m *DelegatorCarriedRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2226,15 +10594,15 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorCarriedRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorCarriedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2261,8 +10629,8 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = append(m.Commission, types.DecCoin{})
-			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2287,7 +10655,7 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+func (m *AllocationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2310,15 +10678,15 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllocationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllocationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2345,66 +10713,50 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, types.DecCoin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewardsBefore", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			m.ValidatorPeriod = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutstandingRewardsBefore = append(m.OutstandingRewardsBefore, types.DecCoin{})
+			if err := m.OutstandingRewardsBefore[len(m.OutstandingRewardsBefore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewardsAfter", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2414,16 +10766,31 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutstandingRewardsAfter = append(m.OutstandingRewardsAfter, types.DecCoin{})
+			if err := m.OutstandingRewardsAfter[len(m.OutstandingRewardsAfter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolBefore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2433,79 +10800,29 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommunityPoolBefore = append(m.CommunityPoolBefore, types.DecCoin{})
+			if err := m.CommunityPoolBefore[len(m.CommunityPoolBefore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2532,64 +10849,48 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
-			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommunityPoolAfter = append(m.CommunityPoolAfter, types.DecCoin{})
+			if err := m.CommunityPoolAfter[len(m.CommunityPoolAfter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalPoolBefore", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDistribution
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FeePool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DecimalPoolBefore = append(m.DecimalPoolBefore, types.DecCoin{})
+			if err := m.DecimalPoolBefore[len(m.DecimalPoolBefore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalPoolAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2616,66 +10917,50 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
-			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DecimalPoolAfter = append(m.DecimalPoolAfter, types.DecCoin{})
+			if err := m.DecimalPoolAfter[len(m.DecimalPoolAfter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedRewardsBefore", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDistribution
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EscrowedRewardsBefore = append(m.EscrowedRewardsBefore, types.DecCoin{})
+			if err := m.EscrowedRewardsBefore[len(m.EscrowedRewardsBefore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedRewardsAfter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2685,29 +10970,31 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.EscrowedRewardsAfter = append(m.EscrowedRewardsAfter, types.DecCoin{})
+			if err := m.EscrowedRewardsAfter[len(m.EscrowedRewardsAfter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2717,29 +11004,31 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Routed = append(m.Routed, types.DecCoin{})
+			if err := m.Routed[len(m.Routed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CarriedFeesBefore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2749,27 +11038,29 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.CarriedFeesBefore = append(m.CarriedFeesBefore, types.DecCoin{})
+			if err := m.CarriedFeesBefore[len(m.CarriedFeesBefore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CarriedFeesAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2796,8 +11087,8 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CarriedFeesAfter = append(m.CarriedFeesAfter, types.DecCoin{})
+			if err := m.CarriedFeesAfter[len(m.CarriedFeesAfter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2822,7 +11113,7 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
+func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2845,17 +11136,17 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.PreviousPeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2865,14 +11156,27 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2900,15 +11204,13 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2918,64 +11220,27 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3003,13 +11268,13 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3019,25 +11284,23 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = append(m.Reward, types.DecCoin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3060,7 +11323,7 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *CommunityPoolSpendEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3083,77 +11346,13 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityPoolSpendEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityPoolSpendEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
@@ -3185,11 +11384,11 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			}
 			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3199,55 +11398,25 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
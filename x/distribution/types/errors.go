@@ -4,18 +4,34 @@ import "cosmossdk.io/errors"
 
 // x/distribution module sentinel errors
 var (
-	ErrEmptyDelegatorAddr      = errors.Register(ModuleName, 2, "delegator address is empty")
-	ErrEmptyWithdrawAddr       = errors.Register(ModuleName, 3, "withdraw address is empty")
-	ErrEmptyValidatorAddr      = errors.Register(ModuleName, 4, "validator address is empty")
-	ErrEmptyDelegationDistInfo = errors.Register(ModuleName, 5, "no delegation distribution info")
-	ErrNoValidatorDistInfo     = errors.Register(ModuleName, 6, "no validator distribution info")
-	ErrNoValidatorCommission   = errors.Register(ModuleName, 7, "no validator commission to withdraw")
-	ErrSetWithdrawAddrDisabled = errors.Register(ModuleName, 8, "set withdraw address disabled")
-	ErrBadDistribution         = errors.Register(ModuleName, 9, "community pool does not have sufficient coins to distribute")
-	ErrInvalidProposalAmount   = errors.Register(ModuleName, 10, "invalid community pool spend proposal amount")
-	ErrEmptyProposalRecipient  = errors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
-	ErrNoValidatorExists       = errors.Register(ModuleName, 12, "validator does not exist")
-	ErrNoDelegationExists      = errors.Register(ModuleName, 13, "delegation does not exist")
-	ErrInvalidProposalContent  = errors.Register(ModuleName, 14, "invalid proposal content")
-	ErrInvalidSigner           = errors.Register(ModuleName, 15, "expected authority account as only signer for proposal message")
+	ErrEmptyDelegatorAddr       = errors.Register(ModuleName, 2, "delegator address is empty")
+	ErrEmptyWithdrawAddr        = errors.Register(ModuleName, 3, "withdraw address is empty")
+	ErrEmptyValidatorAddr       = errors.Register(ModuleName, 4, "validator address is empty")
+	ErrEmptyDelegationDistInfo  = errors.Register(ModuleName, 5, "no delegation distribution info")
+	ErrNoValidatorDistInfo      = errors.Register(ModuleName, 6, "no validator distribution info")
+	ErrNoValidatorCommission    = errors.Register(ModuleName, 7, "no validator commission to withdraw")
+	ErrSetWithdrawAddrDisabled  = errors.Register(ModuleName, 8, "set withdraw address disabled")
+	ErrBadDistribution          = errors.Register(ModuleName, 9, "community pool does not have sufficient coins to distribute")
+	ErrInvalidProposalAmount    = errors.Register(ModuleName, 10, "invalid community pool spend proposal amount")
+	ErrEmptyProposalRecipient   = errors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
+	ErrNoValidatorExists        = errors.Register(ModuleName, 12, "validator does not exist")
+	ErrNoDelegationExists       = errors.Register(ModuleName, 13, "delegation does not exist")
+	ErrInvalidProposalContent   = errors.Register(ModuleName, 14, "invalid proposal content")
+	ErrInvalidSigner            = errors.Register(ModuleName, 15, "expected authority account as only signer for proposal message")
+	ErrInvalidCommunityTax      = errors.Register(ModuleName, 16, "invalid community tax")
+	ErrCommunityPoolUnavailable = errors.Register(ModuleName, 17, "community pool module is not available")
+	ErrVotePowerMismatch        = errors.Register(ModuleName, 18, "vote power does not match total previous power")
+	ErrInvalidPowerWeight       = errors.Register(ModuleName, 19, "invalid power weight")
+	ErrInvalidWithdrawSplit     = errors.Register(ModuleName, 20, "invalid withdraw split")
+	ErrValidatorJailed          = errors.Register(ModuleName, 21, "validator is jailed")
+	ErrInvalidCommissionCap     = errors.Register(ModuleName, 22, "invalid commission cap")
+	ErrNoDecimalPoolEstimate    = errors.Register(ModuleName, 23, "decimal pool growth cannot be estimated")
+	ErrWithdrawCapReached       = errors.Register(ModuleName, 24, "maximum withdrawal per block reached")
+	ErrInvalidCommissionExempt  = errors.Register(ModuleName, 25, "invalid commission exempt denoms")
+	ErrInvalidFees              = errors.Register(ModuleName, 26, "invalid fees")
+	ErrInvalidFeeCollector      = errors.Register(ModuleName, 27, "invalid fee collector")
+	ErrInvalidRewardMultiplier  = errors.Register(ModuleName, 28, "invalid reward multiplier")
+	ErrInvalidUptimeFactor      = errors.Register(ModuleName, 29, "invalid uptime factor")
+	ErrCommunityTaxNotRecorded  = errors.Register(ModuleName, 30, "community tax not recorded at height")
+	ErrInvalidVote              = errors.Register(ModuleName, 31, "invalid vote")
 )
@@ -2,14 +2,48 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress           = "set_withdraw_address"
+	EventTypeSetCommissionWithdrawAddress = "set_commission_withdraw_address"
+	EventTypeSetWithdrawSplit             = "set_withdraw_split"
+	EventTypeRewards                      = "rewards"
+	EventTypeCommission                   = "commission"
+	EventTypeWithdrawRewards              = "withdraw_rewards"
+	EventTypeWithdrawCommission           = "withdraw_commission"
+	EventTypeProposerReward               = "proposer_reward"
+	EventTypeFundCommunityPool            = "fund_community_pool"
+	EventTypeDecimalPoolRemainder         = "decimal_pool_remainder"
+	EventTypeBurnFees                     = "burn_fees"
+	EventTypeSubsidizeRewards             = "subsidize_rewards"
+	EventTypeEscrowRewards                = "escrow_rewards"
+	EventTypeReleaseEscrowedRewards       = "release_escrowed_rewards"
+	EventTypeForfeitEscrowedRewards       = "forfeit_escrowed_rewards"
+	EventTypeSetCommissionCap             = "set_commission_cap"
+	EventTypeCarryRewards                 = "carry_rewards"
+	EventTypeCompoundRewards              = "compound_rewards"
+	EventTypeRouteCommunityFunds          = "route_community_funds"
+	EventTypeSetCommissionExemption       = "set_commission_exemption"
+	EventTypeSetRewardsFrozen             = "set_rewards_frozen"
+	EventTypeRedirectFrozenRewards        = "redirect_frozen_rewards"
+	EventTypeFlushDecimalPool             = "flush_decimal_pool"
+	EventTypeInfraFund                    = "infra_fund"
+	EventTypeSetCommissionRebated         = "set_commission_rebated"
+	EventTypeRebateCommission             = "rebate_commission"
+	EventTypeSetFeeCollectorName          = "set_fee_collector_name"
+	EventTypeSetRewardMultiplier          = "set_reward_multiplier"
+	EventTypeSetOperatorRewardsAddress    = "set_operator_rewards_address"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyCommunityPool   = "community_pool"
+	AttributeKeyDepositor       = "depositor"
+	AttributeKeyWithdrawSplit   = "withdraw_split"
+	AttributeKeyMaxRate         = "max_rate"
+	AttributeKeyShares          = "shares"
+	AttributeKeyDenoms          = "denoms"
+	AttributeKeyFrozen          = "frozen"
+	AttributeKeyRebated         = "rebated"
+	AttributeKeyFeeCollector    = "fee_collector"
+	AttributeKeyMultiplier      = "multiplier"
+	AttributeKeyRewardsAddress  = "rewards_address"
 )
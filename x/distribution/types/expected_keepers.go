@@ -4,6 +4,7 @@ import (
 	context "context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,6 +23,7 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, address []byte, amt sdk.Coins) error
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
@@ -31,6 +33,7 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 
 	BlockedAddr(addr sdk.AccAddress) bool
+	IsSendEnabledDenom(ctx context.Context, denom string) bool
 }
 
 // PoolKeeper defines the expected interface needed to fund & distribute pool balances.
@@ -63,6 +66,11 @@ type StakingKeeper interface {
 	GetAllSDKDelegations(ctx context.Context) ([]stakingtypes.Delegation, error)
 	GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error)
 	GetAllDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.Delegation, error)
+
+	// GetValidator and Delegate are used to delegate the compounded rewards.
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	Delegate(ctx context.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc stakingtypes.BondStatus,
+		validator stakingtypes.Validator, subtractAccount bool) (newShares math.LegacyDec, err error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -70,3 +78,9 @@ type StakingHooks interface {
 	AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error // Must be called when a validator is created
 	AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error
 }
+
+// DistributionHooks event hooks for distribution reward allocation (noalias)
+type DistributionHooks interface {
+	AfterValidatorRewardsAllocated(ctx context.Context, valAddr sdk.ValAddress, tokens sdk.DecCoins) error // Must be called after rewards are allocated to a validator
+	AfterCommunityPoolFunded(ctx context.Context, amount sdk.DecCoins, source string) error                // Must be called after funds are added to the community pool
+}
@@ -10,6 +10,8 @@ import (
 func InitialFeePool() FeePool {
 	return FeePool{
 		CommunityPool: sdk.DecCoins{},
+		DecimalPool:   sdk.DecCoins{},
+		CarriedFees:   sdk.DecCoins{},
 	}
 }
 
@@ -20,5 +22,21 @@ func (f FeePool) ValidateGenesis() error {
 			f.CommunityPool)
 	}
 
+	if f.DecimalPool.IsAnyNegative() {
+		return fmt.Errorf("negative DecimalPool in distribution fee pool, is %v",
+			f.DecimalPool)
+	}
+
+	// the decimal pool is restored as is, an unsorted or duplicated denom
+	// would corrupt every later addition of a remainder to it
+	if err := f.DecimalPool.Validate(); err != nil {
+		return fmt.Errorf("invalid DecimalPool in distribution fee pool: %w", err)
+	}
+
+	if f.CarriedFees.IsAnyNegative() {
+		return fmt.Errorf("negative CarriedFees in distribution fee pool, is %v",
+			f.CarriedFees)
+	}
+
 	return nil
 }
@@ -17,4 +17,19 @@ func TestValidateGenesis(t *testing.T) {
 
 	fp2 := types.FeePool{CommunityPool: sdk.DecCoins{{Denom: "stake", Amount: math.LegacyNewDec(-1)}}}
 	require.NotNil(t, fp2.ValidateGenesis())
+
+	fp3 := types.FeePool{DecimalPool: sdk.DecCoins{{Denom: "stake", Amount: math.LegacyNewDec(-1)}}}
+	require.NotNil(t, fp3.ValidateGenesis())
+
+	fp4 := types.FeePool{DecimalPool: sdk.DecCoins{
+		{Denom: "stake", Amount: math.LegacyNewDecWithPrec(1, 18)},
+		{Denom: "atom", Amount: math.LegacyNewDecWithPrec(1, 18)},
+	}}
+	require.NotNil(t, fp4.ValidateGenesis())
+
+	fp5 := types.FeePool{DecimalPool: sdk.DecCoins{
+		{Denom: "atom", Amount: math.LegacyNewDecWithPrec(1, 18)},
+		{Denom: "stake", Amount: math.LegacyNewDecWithPrec(5, 1)},
+	}}
+	require.Nil(t, fp5.ValidateGenesis())
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,34 +10,75 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	vcwis []ValidatorCommissionWithdrawInfo, splits []DelegatorWithdrawSplitRecord, escrowed []ValidatorEscrowedRewardsRecord,
+	caps []ValidatorCommissionCapRecord, carried []DelegatorCarriedRewardsRecord,
+	creationHeights []ValidatorCreationHeightRecord, exemptions []ValidatorCommissionExemptionRecord,
+	frozen []string, withdrawals []ValidatorCommissionWithdrawalRecord, rebated []string, feeCollectorName string,
+	multipliers []ValidatorRewardMultiplierRecord, epoch AllocationEpoch, epochPowers []AllocationEpochPowerRecord,
+	operatorRewardsAddrs []ValidatorOperatorRewardsAddressRecord, lifetimeCommissions []ValidatorLifetimeCommissionRecord,
+	taxHistory []CommunityTaxRecord,
 ) *GenesisState {
 	return &GenesisState{
-		Params:                          params,
-		FeePool:                         fp,
-		DelegatorWithdrawInfos:          dwis,
-		PreviousProposer:                pp.String(),
-		OutstandingRewards:              r,
-		ValidatorAccumulatedCommissions: acc,
-		ValidatorHistoricalRewards:      historical,
-		ValidatorCurrentRewards:         cur,
-		DelegatorStartingInfos:          dels,
-		ValidatorSlashEvents:            slashes,
+		Params:                            params,
+		FeePool:                           fp,
+		DelegatorWithdrawInfos:            dwis,
+		PreviousProposer:                  pp.String(),
+		OutstandingRewards:                r,
+		ValidatorAccumulatedCommissions:   acc,
+		ValidatorHistoricalRewards:        historical,
+		ValidatorCurrentRewards:           cur,
+		DelegatorStartingInfos:            dels,
+		ValidatorSlashEvents:              slashes,
+		ValidatorCommissionWithdrawInfos:  vcwis,
+		DelegatorWithdrawSplits:           splits,
+		ValidatorEscrowedRewards:          escrowed,
+		ValidatorCommissionCaps:           caps,
+		DelegatorCarriedRewards:           carried,
+		ValidatorCreationHeights:          creationHeights,
+		ValidatorCommissionExemptions:     exemptions,
+		FrozenValidators:                  frozen,
+		ValidatorCommissionWithdrawals:    withdrawals,
+		RebatedValidators:                 rebated,
+		FeeCollectorName:                  feeCollectorName,
+		ValidatorRewardMultipliers:        multipliers,
+		AllocationEpoch:                   epoch,
+		AllocationEpochPowers:             epochPowers,
+		ValidatorOperatorRewardsAddresses: operatorRewardsAddrs,
+		ValidatorLifetimeCommissions:      lifetimeCommissions,
+		CommunityTaxHistory:               taxHistory,
 	}
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		FeePool:                         InitialFeePool(),
-		Params:                          DefaultParams(),
-		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
-		PreviousProposer:                "",
-		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
-		ValidatorAccumulatedCommissions: []ValidatorAccumulatedCommissionRecord{},
-		ValidatorHistoricalRewards:      []ValidatorHistoricalRewardsRecord{},
-		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
-		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
-		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		FeePool:                           InitialFeePool(),
+		Params:                            DefaultParams(),
+		DelegatorWithdrawInfos:            []DelegatorWithdrawInfo{},
+		PreviousProposer:                  "",
+		OutstandingRewards:                []ValidatorOutstandingRewardsRecord{},
+		ValidatorAccumulatedCommissions:   []ValidatorAccumulatedCommissionRecord{},
+		ValidatorHistoricalRewards:        []ValidatorHistoricalRewardsRecord{},
+		ValidatorCurrentRewards:           []ValidatorCurrentRewardsRecord{},
+		DelegatorStartingInfos:            []DelegatorStartingInfoRecord{},
+		ValidatorSlashEvents:              []ValidatorSlashEventRecord{},
+		ValidatorCommissionWithdrawInfos:  []ValidatorCommissionWithdrawInfo{},
+		DelegatorWithdrawSplits:           []DelegatorWithdrawSplitRecord{},
+		ValidatorEscrowedRewards:          []ValidatorEscrowedRewardsRecord{},
+		ValidatorCommissionCaps:           []ValidatorCommissionCapRecord{},
+		DelegatorCarriedRewards:           []DelegatorCarriedRewardsRecord{},
+		ValidatorCreationHeights:          []ValidatorCreationHeightRecord{},
+		ValidatorCommissionExemptions:     []ValidatorCommissionExemptionRecord{},
+		FrozenValidators:                  []string{},
+		ValidatorCommissionWithdrawals:    []ValidatorCommissionWithdrawalRecord{},
+		RebatedValidators:                 []string{},
+		FeeCollectorName:                  "",
+		ValidatorRewardMultipliers:        []ValidatorRewardMultiplierRecord{},
+		AllocationEpoch:                   AllocationEpoch{},
+		AllocationEpochPowers:             []AllocationEpochPowerRecord{},
+		ValidatorOperatorRewardsAddresses: []ValidatorOperatorRewardsAddressRecord{},
+		ValidatorLifetimeCommissions:      []ValidatorLifetimeCommissionRecord{},
+		CommunityTaxHistory:               []CommunityTaxRecord{},
 	}
 }
 
@@ -44,5 +87,59 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, split := range gs.DelegatorWithdrawSplits {
+		if err := NewWithdrawSplit(split.Entries...).Validate(); err != nil {
+			return fmt.Errorf("invalid withdraw split of %s: %w", split.DelegatorAddress, err)
+		}
+	}
+	for _, escrowed := range gs.ValidatorEscrowedRewards {
+		if err := escrowed.Rewards.Validate(); err != nil {
+			return fmt.Errorf("invalid escrowed rewards of %s: %w", escrowed.ValidatorAddress, err)
+		}
+	}
+	for _, commissionCap := range gs.ValidatorCommissionCaps {
+		if err := ValidateCommissionCap(commissionCap.MaxRate); err != nil {
+			return fmt.Errorf("invalid commission cap of %s: %w", commissionCap.ValidatorAddress, err)
+		}
+	}
+	for _, multiplier := range gs.ValidatorRewardMultipliers {
+		if err := ValidateRewardMultiplier(multiplier.Multiplier); err != nil {
+			return fmt.Errorf("invalid reward multiplier of %s: %w", multiplier.ValidatorAddress, err)
+		}
+	}
+	for _, carried := range gs.DelegatorCarriedRewards {
+		if err := carried.Rewards.Validate(); err != nil {
+			return fmt.Errorf("invalid carried rewards of %s: %w", carried.DelegatorAddress, err)
+		}
+	}
+	for _, exemption := range gs.ValidatorCommissionExemptions {
+		if err := ValidateCommissionExemptDenoms(exemption.Denoms); err != nil {
+			return fmt.Errorf("invalid commission exempt denoms of %s: %w", exemption.ValidatorAddress, err)
+		}
+	}
+	for _, withdrawal := range gs.ValidatorCommissionWithdrawals {
+		if err := withdrawal.Withdrawal.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid commission withdrawal of %s: %w", withdrawal.ValidatorAddress, err)
+		}
+	}
+	for _, record := range gs.ValidatorLifetimeCommissions {
+		if err := record.Lifetime.Commission.Validate(); err != nil {
+			return fmt.Errorf("invalid lifetime commission of %s: %w", record.ValidatorAddress, err)
+		}
+	}
+	for _, record := range gs.CommunityTaxHistory {
+		if err := validateCommunityTax(record.CommunityTax); err != nil {
+			return fmt.Errorf("invalid community tax recorded at height %d: %w", record.Height, err)
+		}
+	}
+	if gs.AllocationEpoch.TotalPower < 0 {
+		return fmt.Errorf("negative allocation epoch total power %d", gs.AllocationEpoch.TotalPower)
+	}
+	for _, record := range gs.AllocationEpochPowers {
+		if record.Power.SignedPower < 0 || record.Power.SignedPower > record.Power.Power {
+			return fmt.Errorf("invalid allocation epoch power of %s: signed power %d, power %d",
+				record.ConsensusAddress, record.Power.SignedPower, record.Power.Power)
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -70,6 +71,135 @@ func (m *DelegatorWithdrawInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorWithdrawInfo proto.InternalMessageInfo
 
+// ValidatorCommissionWithdrawInfo is the address where the commission of a
+// validator is withdrawn to, this struct is only used at genesis to feed in
+// the commission withdraw addresses.
+type ValidatorCommissionWithdrawInfo struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// withdraw_address is the address to withdraw the commission to.
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *ValidatorCommissionWithdrawInfo) Reset()         { *m = ValidatorCommissionWithdrawInfo{} }
+func (m *ValidatorCommissionWithdrawInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionWithdrawInfo) ProtoMessage()    {}
+func (*ValidatorCommissionWithdrawInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{1}
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCommissionWithdrawInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionWithdrawInfo.Merge(m, src)
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionWithdrawInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCommissionWithdrawInfo proto.InternalMessageInfo
+
+// ValidatorOperatorRewardsAddressRecord is the address the rewards of a
+// validator operator's self-delegation are routed to, this struct is only used
+// at genesis to feed in the operator rewards addresses.
+type ValidatorOperatorRewardsAddressRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rewards_address is the address the self-delegation rewards are sent to.
+	RewardsAddress string `protobuf:"bytes,2,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
+}
+
+func (m *ValidatorOperatorRewardsAddressRecord) Reset()         { *m = ValidatorOperatorRewardsAddressRecord{} }
+func (m *ValidatorOperatorRewardsAddressRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOperatorRewardsAddressRecord) ProtoMessage()    {}
+func (*ValidatorOperatorRewardsAddressRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{2}
+}
+func (m *ValidatorOperatorRewardsAddressRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOperatorRewardsAddressRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOperatorRewardsAddressRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOperatorRewardsAddressRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOperatorRewardsAddressRecord.Merge(m, src)
+}
+func (m *ValidatorOperatorRewardsAddressRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOperatorRewardsAddressRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOperatorRewardsAddressRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOperatorRewardsAddressRecord proto.InternalMessageInfo
+
+// DelegatorWithdrawSplitRecord is the split of the rewards of a delegator
+// between several addresses, this struct is only used at genesis to feed in
+// the withdraw splits.
+type DelegatorWithdrawSplitRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// entries are the addresses the rewards are split between and their weights.
+	Entries []WithdrawSplitEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *DelegatorWithdrawSplitRecord) Reset()         { *m = DelegatorWithdrawSplitRecord{} }
+func (m *DelegatorWithdrawSplitRecord) String() string { return proto.CompactTextString(m) }
+func (*DelegatorWithdrawSplitRecord) ProtoMessage()    {}
+func (*DelegatorWithdrawSplitRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{3}
+}
+func (m *DelegatorWithdrawSplitRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorWithdrawSplitRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorWithdrawSplitRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorWithdrawSplitRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorWithdrawSplitRecord.Merge(m, src)
+}
+func (m *DelegatorWithdrawSplitRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorWithdrawSplitRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorWithdrawSplitRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorWithdrawSplitRecord proto.InternalMessageInfo
+
 // ValidatorOutstandingRewardsRecord is used for import/export via genesis json.
 type ValidatorOutstandingRewardsRecord struct {
 	// validator_address is the address of the validator.
@@ -82,7 +212,7 @@ func (m *ValidatorOutstandingRewardsRecord) Reset()         { *m = ValidatorOuts
 func (m *ValidatorOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewardsRecord) ProtoMessage()    {}
 func (*ValidatorOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{1}
+	return fileDescriptor_76eed0f9489db580, []int{4}
 }
 func (m *ValidatorOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -111,27 +241,73 @@ func (m *ValidatorOutstandingRewardsRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorOutstandingRewardsRecord proto.InternalMessageInfo
 
-// ValidatorAccumulatedCommissionRecord is used for import / export via genesis
-// json.
-type ValidatorAccumulatedCommissionRecord struct {
+// RewardSnapshot is a read-only view of the reward state of the distribution
+// module at a height, for off-chain reconciliation.
+type RewardSnapshot struct {
+	// height is the height the snapshot is taken at.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// fee_pool is the fee pool, holding the community and decimal pools.
+	FeePool FeePool `protobuf:"bytes,2,opt,name=fee_pool,json=feePool,proto3" json:"fee_pool"`
+	// outstanding_rewards are the outstanding rewards of every validator.
+	OutstandingRewards []ValidatorOutstandingRewardsRecord `protobuf:"bytes,3,rep,name=outstanding_rewards,json=outstandingRewards,proto3" json:"outstanding_rewards"`
+	// validator_accumulated_commissions are the accumulated commissions of every
+	// validator.
+	ValidatorAccumulatedCommissions []ValidatorAccumulatedCommissionRecord `protobuf:"bytes,4,rep,name=validator_accumulated_commissions,json=validatorAccumulatedCommissions,proto3" json:"validator_accumulated_commissions"`
+}
+
+func (m *RewardSnapshot) Reset()         { *m = RewardSnapshot{} }
+func (m *RewardSnapshot) String() string { return proto.CompactTextString(m) }
+func (*RewardSnapshot) ProtoMessage()    {}
+func (*RewardSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{5}
+}
+func (m *RewardSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardSnapshot.Merge(m, src)
+}
+func (m *RewardSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardSnapshot proto.InternalMessageInfo
+
+// ValidatorEscrowedRewardsRecord is used for import/export via genesis json.
+type ValidatorEscrowedRewardsRecord struct {
 	// validator_address is the address of the validator.
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// accumulated is the accumulated commission of a validator.
-	Accumulated ValidatorAccumulatedCommission `protobuf:"bytes,2,opt,name=accumulated,proto3" json:"accumulated"`
+	// rewards represents the escrowed rewards of a validator.
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
 }
 
-func (m *ValidatorAccumulatedCommissionRecord) Reset()         { *m = ValidatorAccumulatedCommissionRecord{} }
-func (m *ValidatorAccumulatedCommissionRecord) String() string { return proto.CompactTextString(m) }
-func (*ValidatorAccumulatedCommissionRecord) ProtoMessage()    {}
-func (*ValidatorAccumulatedCommissionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{2}
+func (m *ValidatorEscrowedRewardsRecord) Reset()         { *m = ValidatorEscrowedRewardsRecord{} }
+func (m *ValidatorEscrowedRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEscrowedRewardsRecord) ProtoMessage()    {}
+func (*ValidatorEscrowedRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{6}
 }
-func (m *ValidatorAccumulatedCommissionRecord) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorEscrowedRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorAccumulatedCommissionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorEscrowedRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorAccumulatedCommissionRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorEscrowedRewardsRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -141,41 +317,38 @@ func (m *ValidatorAccumulatedCommissionRecord) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *ValidatorAccumulatedCommissionRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorAccumulatedCommissionRecord.Merge(m, src)
+func (m *ValidatorEscrowedRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEscrowedRewardsRecord.Merge(m, src)
 }
-func (m *ValidatorAccumulatedCommissionRecord) XXX_Size() int {
+func (m *ValidatorEscrowedRewardsRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorAccumulatedCommissionRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorAccumulatedCommissionRecord.DiscardUnknown(m)
+func (m *ValidatorEscrowedRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEscrowedRewardsRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorAccumulatedCommissionRecord proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorEscrowedRewardsRecord proto.InternalMessageInfo
 
-// ValidatorHistoricalRewardsRecord is used for import / export via genesis
-// json.
-type ValidatorHistoricalRewardsRecord struct {
+// ValidatorCommissionCapRecord is used for import/export via genesis json.
+type ValidatorCommissionCapRecord struct {
 	// validator_address is the address of the validator.
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// period defines the period the historical rewards apply to.
-	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
-	// rewards defines the historical rewards of a validator.
-	Rewards ValidatorHistoricalRewards `protobuf:"bytes,3,opt,name=rewards,proto3" json:"rewards"`
+	// max_rate represents the commission cap of a validator.
+	MaxRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rate"`
 }
 
-func (m *ValidatorHistoricalRewardsRecord) Reset()         { *m = ValidatorHistoricalRewardsRecord{} }
-func (m *ValidatorHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
-func (*ValidatorHistoricalRewardsRecord) ProtoMessage()    {}
-func (*ValidatorHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{3}
+func (m *ValidatorCommissionCapRecord) Reset()         { *m = ValidatorCommissionCapRecord{} }
+func (m *ValidatorCommissionCapRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionCapRecord) ProtoMessage()    {}
+func (*ValidatorCommissionCapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{7}
 }
-func (m *ValidatorHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorCommissionCapRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorHistoricalRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorCommissionCapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorHistoricalRewardsRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorCommissionCapRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -185,38 +358,38 @@ func (m *ValidatorHistoricalRewardsRecord) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *ValidatorHistoricalRewardsRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorHistoricalRewardsRecord.Merge(m, src)
+func (m *ValidatorCommissionCapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionCapRecord.Merge(m, src)
 }
-func (m *ValidatorHistoricalRewardsRecord) XXX_Size() int {
+func (m *ValidatorCommissionCapRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorHistoricalRewardsRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorHistoricalRewardsRecord.DiscardUnknown(m)
+func (m *ValidatorCommissionCapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionCapRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorHistoricalRewardsRecord proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorCommissionCapRecord proto.InternalMessageInfo
 
-// ValidatorCurrentRewardsRecord is used for import / export via genesis json.
-type ValidatorCurrentRewardsRecord struct {
+// ValidatorRewardMultiplierRecord is used for import/export via genesis json.
+type ValidatorRewardMultiplierRecord struct {
 	// validator_address is the address of the validator.
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// rewards defines the current rewards of a validator.
-	Rewards ValidatorCurrentRewards `protobuf:"bytes,2,opt,name=rewards,proto3" json:"rewards"`
+	// multiplier represents the reward multiplier of a validator.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
 }
 
-func (m *ValidatorCurrentRewardsRecord) Reset()         { *m = ValidatorCurrentRewardsRecord{} }
-func (m *ValidatorCurrentRewardsRecord) String() string { return proto.CompactTextString(m) }
-func (*ValidatorCurrentRewardsRecord) ProtoMessage()    {}
-func (*ValidatorCurrentRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{4}
+func (m *ValidatorRewardMultiplierRecord) Reset()         { *m = ValidatorRewardMultiplierRecord{} }
+func (m *ValidatorRewardMultiplierRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardMultiplierRecord) ProtoMessage()    {}
+func (*ValidatorRewardMultiplierRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
-func (m *ValidatorCurrentRewardsRecord) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorRewardMultiplierRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorCurrentRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorRewardMultiplierRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorCurrentRewardsRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorRewardMultiplierRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -226,40 +399,38 @@ func (m *ValidatorCurrentRewardsRecord) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *ValidatorCurrentRewardsRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorCurrentRewardsRecord.Merge(m, src)
+func (m *ValidatorRewardMultiplierRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardMultiplierRecord.Merge(m, src)
 }
-func (m *ValidatorCurrentRewardsRecord) XXX_Size() int {
+func (m *ValidatorRewardMultiplierRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorCurrentRewardsRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorCurrentRewardsRecord.DiscardUnknown(m)
+func (m *ValidatorRewardMultiplierRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardMultiplierRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorCurrentRewardsRecord proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorRewardMultiplierRecord proto.InternalMessageInfo
 
-// DelegatorStartingInfoRecord used for import / export via genesis json.
-type DelegatorStartingInfoRecord struct {
-	// delegator_address is the address of the delegator.
-	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
-	// validator_address is the address of the validator.
-	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// starting_info defines the starting info of a delegator.
-	StartingInfo DelegatorStartingInfo `protobuf:"bytes,3,opt,name=starting_info,json=startingInfo,proto3" json:"starting_info"`
+// AllocationEpochPowerRecord is used for import/export via genesis json.
+type AllocationEpochPowerRecord struct {
+	// consensus_address is the consensus address of the validator.
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// power represents the power of the validator summed over the epoch.
+	Power AllocationEpochPower `protobuf:"bytes,2,opt,name=power,proto3" json:"power"`
 }
 
-func (m *DelegatorStartingInfoRecord) Reset()         { *m = DelegatorStartingInfoRecord{} }
-func (m *DelegatorStartingInfoRecord) String() string { return proto.CompactTextString(m) }
-func (*DelegatorStartingInfoRecord) ProtoMessage()    {}
-func (*DelegatorStartingInfoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{5}
+func (m *AllocationEpochPowerRecord) Reset()         { *m = AllocationEpochPowerRecord{} }
+func (m *AllocationEpochPowerRecord) String() string { return proto.CompactTextString(m) }
+func (*AllocationEpochPowerRecord) ProtoMessage()    {}
+func (*AllocationEpochPowerRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{9}
 }
-func (m *DelegatorStartingInfoRecord) XXX_Unmarshal(b []byte) error {
+func (m *AllocationEpochPowerRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegatorStartingInfoRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AllocationEpochPowerRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegatorStartingInfoRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AllocationEpochPowerRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -269,42 +440,38 @@ func (m *DelegatorStartingInfoRecord) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *DelegatorStartingInfoRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegatorStartingInfoRecord.Merge(m, src)
+func (m *AllocationEpochPowerRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocationEpochPowerRecord.Merge(m, src)
 }
-func (m *DelegatorStartingInfoRecord) XXX_Size() int {
+func (m *AllocationEpochPowerRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegatorStartingInfoRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegatorStartingInfoRecord.DiscardUnknown(m)
+func (m *AllocationEpochPowerRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocationEpochPowerRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegatorStartingInfoRecord proto.InternalMessageInfo
+var xxx_messageInfo_AllocationEpochPowerRecord proto.InternalMessageInfo
 
-// ValidatorSlashEventRecord is used for import / export via genesis json.
-type ValidatorSlashEventRecord struct {
+// ValidatorCreationHeightRecord is used for import/export via genesis json.
+type ValidatorCreationHeightRecord struct {
 	// validator_address is the address of the validator.
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// height defines the block height at which the slash event occurred.
+	// height represents the height the validator was created at.
 	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	// period is the period of the slash event.
-	Period uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
-	// validator_slash_event describes the slash event.
-	ValidatorSlashEvent ValidatorSlashEvent `protobuf:"bytes,4,opt,name=validator_slash_event,json=validatorSlashEvent,proto3" json:"validator_slash_event"`
 }
 
-func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRecord{} }
-func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
-func (*ValidatorSlashEventRecord) ProtoMessage()    {}
-func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{6}
+func (m *ValidatorCreationHeightRecord) Reset()         { *m = ValidatorCreationHeightRecord{} }
+func (m *ValidatorCreationHeightRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCreationHeightRecord) ProtoMessage()    {}
+func (*ValidatorCreationHeightRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{10}
 }
-func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorCreationHeightRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorSlashEventRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorCreationHeightRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorSlashEventRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorCreationHeightRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -314,54 +481,39 @@ func (m *ValidatorSlashEventRecord) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ValidatorSlashEventRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorSlashEventRecord.Merge(m, src)
+func (m *ValidatorCreationHeightRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCreationHeightRecord.Merge(m, src)
 }
-func (m *ValidatorSlashEventRecord) XXX_Size() int {
+func (m *ValidatorCreationHeightRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorSlashEventRecord.DiscardUnknown(m)
+func (m *ValidatorCreationHeightRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCreationHeightRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorCreationHeightRecord proto.InternalMessageInfo
 
-// GenesisState defines the distribution module's genesis state.
-type GenesisState struct {
-	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	// fee_pool defines the fee pool at genesis.
-	FeePool FeePool `protobuf:"bytes,2,opt,name=fee_pool,json=feePool,proto3" json:"fee_pool"`
-	// fee_pool defines the delegator withdraw infos at genesis.
-	DelegatorWithdrawInfos []DelegatorWithdrawInfo `protobuf:"bytes,3,rep,name=delegator_withdraw_infos,json=delegatorWithdrawInfos,proto3" json:"delegator_withdraw_infos"`
-	// fee_pool defines the previous proposer at genesis.
-	PreviousProposer string `protobuf:"bytes,4,opt,name=previous_proposer,json=previousProposer,proto3" json:"previous_proposer,omitempty"`
-	// fee_pool defines the outstanding rewards of all validators at genesis.
-	OutstandingRewards []ValidatorOutstandingRewardsRecord `protobuf:"bytes,5,rep,name=outstanding_rewards,json=outstandingRewards,proto3" json:"outstanding_rewards"`
-	// fee_pool defines the accumulated commissions of all validators at genesis.
-	ValidatorAccumulatedCommissions []ValidatorAccumulatedCommissionRecord `protobuf:"bytes,6,rep,name=validator_accumulated_commissions,json=validatorAccumulatedCommissions,proto3" json:"validator_accumulated_commissions"`
-	// fee_pool defines the historical rewards of all validators at genesis.
-	ValidatorHistoricalRewards []ValidatorHistoricalRewardsRecord `protobuf:"bytes,7,rep,name=validator_historical_rewards,json=validatorHistoricalRewards,proto3" json:"validator_historical_rewards"`
-	// fee_pool defines the current rewards of all validators at genesis.
-	ValidatorCurrentRewards []ValidatorCurrentRewardsRecord `protobuf:"bytes,8,rep,name=validator_current_rewards,json=validatorCurrentRewards,proto3" json:"validator_current_rewards"`
-	// fee_pool defines the delegator starting infos at genesis.
-	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
-	// fee_pool defines the validator slash events at genesis.
-	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+// ValidatorCommissionExemptionRecord is used for import/export via genesis
+// json.
+type ValidatorCommissionExemptionRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// denoms represents the denoms exempt from the commission of a validator.
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+func (m *ValidatorCommissionExemptionRecord) Reset()         { *m = ValidatorCommissionExemptionRecord{} }
+func (m *ValidatorCommissionExemptionRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionExemptionRecord) ProtoMessage()    {}
+func (*ValidatorCommissionExemptionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{11}
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorCommissionExemptionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorCommissionExemptionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorCommissionExemptionRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -371,479 +523,751 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *ValidatorCommissionExemptionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionExemptionRecord.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *ValidatorCommissionExemptionRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *ValidatorCommissionExemptionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionExemptionRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorCommissionExemptionRecord proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawInfo")
-	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
-	proto.RegisterType((*ValidatorAccumulatedCommissionRecord)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommissionRecord")
-	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewardsRecord")
-	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
-	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
-	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
-	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
+// ValidatorCommissionWithdrawalRecord is used for import/export via genesis
+// json.
+type ValidatorCommissionWithdrawalRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// withdrawal represents a commission withdrawal of the validator.
+	Withdrawal CommissionWithdrawal `protobuf:"bytes,2,opt,name=withdrawal,proto3" json:"withdrawal"`
 }
 
-func init() {
-	proto.RegisterFile("cosmos/distribution/v1beta1/genesis.proto", fileDescriptor_76eed0f9489db580)
+func (m *ValidatorCommissionWithdrawalRecord) Reset()         { *m = ValidatorCommissionWithdrawalRecord{} }
+func (m *ValidatorCommissionWithdrawalRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionWithdrawalRecord) ProtoMessage()    {}
+func (*ValidatorCommissionWithdrawalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{12}
 }
-
-var fileDescriptor_76eed0f9489db580 = []byte{
-	// 943 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x25, 0x4d, 0x26, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0x76, 0x9d, 0x94, 0x1e,
-	0x0a, 0x28, 0x6b, 0x1a, 0x10, 0x54, 0x45, 0x80, 0x1a, 0xb7, 0xe5, 0xc7, 0x01, 0x22, 0x5b, 0x02,
-	0x81, 0x90, 0xac, 0xf1, 0xee, 0x78, 0x3d, 0xaa, 0xbd, 0x63, 0xcd, 0x8c, 0xd7, 0x80, 0xc4, 0x81,
-	0x53, 0x11, 0xe2, 0xc0, 0x09, 0x71, 0xac, 0x38, 0x55, 0x48, 0x48, 0x1c, 0xfa, 0x47, 0xf4, 0x58,
-	0x55, 0x1c, 0x38, 0x01, 0x72, 0x0e, 0x20, 0x8e, 0xfc, 0x05, 0x68, 0x67, 0x66, 0x77, 0xc7, 0xd9,
-	0xed, 0xd6, 0x0d, 0xf5, 0x25, 0xf1, 0xce, 0xbc, 0xf7, 0xbe, 0xef, 0x7b, 0xef, 0xed, 0x7b, 0x0b,
-	0x9e, 0xf7, 0x28, 0x1f, 0x50, 0x5e, 0xf7, 0x09, 0x17, 0x8c, 0x74, 0x46, 0x82, 0xd0, 0xb0, 0x1e,
-	0x5d, 0xea, 0x60, 0x81, 0x2e, 0xd5, 0x03, 0x1c, 0x62, 0x4e, 0xb8, 0x3b, 0x64, 0x54, 0x50, 0x78,
-	0x46, 0x99, 0xba, 0xa6, 0xa9, 0xab, 0x4d, 0x37, 0xd7, 0x02, 0x1a, 0x50, 0x69, 0x57, 0x8f, 0x7f,
-	0x29, 0x97, 0x4d, 0x47, 0x47, 0xef, 0x20, 0x8e, 0xd3, 0xa8, 0x1e, 0x25, 0xa1, 0xbe, 0x77, 0xcb,
-	0xd0, 0xa7, 0x70, 0x94, 0xfd, 0x86, 0xb2, 0x6f, 0x2b, 0x20, 0xcd, 0x47, 0x5d, 0xad, 0xa2, 0x01,
-	0x09, 0x69, 0x5d, 0xfe, 0x55, 0x47, 0xe7, 0x7f, 0xb6, 0xc0, 0xe9, 0x6b, 0xb8, 0x8f, 0x03, 0x24,
-	0x28, 0xfb, 0x88, 0x88, 0x9e, 0xcf, 0xd0, 0xf8, 0xdd, 0xb0, 0x4b, 0xe1, 0x75, 0xb0, 0xea, 0x27,
-	0x17, 0x6d, 0xe4, 0xfb, 0x0c, 0x73, 0x6e, 0x5b, 0x5b, 0xd6, 0xc5, 0xe5, 0x3d, 0xfb, 0xc1, 0xdd,
-	0x9d, 0x35, 0x1d, 0xf9, 0xaa, 0xba, 0x69, 0x09, 0x46, 0xc2, 0xa0, 0x79, 0x32, 0x75, 0xd1, 0xe7,
-	0xb0, 0x01, 0x4e, 0x8e, 0x75, 0xd8, 0x34, 0x4a, 0xf5, 0x11, 0x51, 0x9e, 0x49, 0x3c, 0xf4, 0xf1,
-	0x95, 0xa5, 0xaf, 0x6f, 0xd7, 0x2a, 0x7f, 0xdf, 0xae, 0x55, 0xce, 0xdf, 0xaa, 0x82, 0xed, 0x0f,
-	0x51, 0x9f, 0xf8, 0x31, 0xc6, 0x07, 0x23, 0xc1, 0x05, 0x0a, 0xfd, 0xd8, 0x07, 0x8f, 0x11, 0xf3,
-	0x79, 0x13, 0x7b, 0x94, 0xf9, 0xf0, 0x7d, 0xb0, 0x1a, 0x25, 0x46, 0x87, 0xb8, 0x6f, 0x3f, 0xb8,
-	0xbb, 0x73, 0x4e, 0xa3, 0xa6, 0x81, 0x0e, 0x89, 0x88, 0x0e, 0x9d, 0xc3, 0x5b, 0x16, 0x38, 0x45,
-	0x33, 0xb0, 0x36, 0x53, 0x68, 0x76, 0x75, 0x6b, 0xe1, 0xe2, 0xca, 0xee, 0x59, 0x5d, 0x22, 0x37,
-	0x2e, 0x61, 0x52, 0x6d, 0xf7, 0x1a, 0xf6, 0x1a, 0x94, 0x84, 0x7b, 0x97, 0xef, 0xfd, 0x5e, 0xab,
-	0xfc, 0xf4, 0x47, 0xed, 0xc5, 0x80, 0x88, 0xde, 0xa8, 0xe3, 0x7a, 0x74, 0xa0, 0xab, 0xa2, 0xff,
-	0xed, 0x70, 0xff, 0x66, 0x5d, 0x7c, 0x3e, 0xc4, 0x3c, 0xf1, 0xe1, 0x77, 0xfe, 0xfa, 0xe5, 0x05,
-	0xab, 0x09, 0x69, 0x4e, 0x9f, 0x91, 0x89, 0x7f, 0x2c, 0x70, 0x21, 0x13, 0xe0, 0x79, 0xa3, 0xc1,
-	0xa8, 0x8f, 0x04, 0xf6, 0x1b, 0x74, 0x30, 0x20, 0x9c, 0x13, 0x1a, 0xce, 0x29, 0x19, 0x3d, 0xb0,
-	0x82, 0x32, 0x38, 0x59, 0xcc, 0x95, 0xdd, 0xd7, 0xdd, 0x92, 0xce, 0x77, 0xcb, 0x79, 0xee, 0x2d,
-	0xc7, 0x29, 0x52, 0x9a, 0xcd, 0xd0, 0x86, 0xd8, 0x7f, 0x2d, 0xb0, 0x95, 0x06, 0x79, 0x87, 0x70,
-	0x41, 0x19, 0xf1, 0x50, 0x7f, 0xbe, 0x55, 0x5f, 0x07, 0x8b, 0x43, 0xcc, 0x08, 0x55, 0x1a, 0x8f,
-	0x35, 0xf5, 0x13, 0xfc, 0x14, 0x1c, 0x4f, 0x1a, 0x60, 0x41, 0x8a, 0x7f, 0x6d, 0x36, 0xf1, 0x39,
-	0xde, 0xa6, 0xf0, 0x24, 0xa4, 0x21, 0xfa, 0x57, 0x0b, 0x9c, 0x4b, 0x9d, 0x1b, 0x23, 0xc6, 0x70,
-	0x28, 0xe6, 0xab, 0xf8, 0xe3, 0x4c, 0x99, 0x2a, 0xeb, 0x2b, 0xb3, 0x29, 0x9b, 0x26, 0xf7, 0x08,
-	0x59, 0x3f, 0x56, 0xc1, 0x99, 0x74, 0xe4, 0xb4, 0x04, 0x62, 0x82, 0x84, 0x41, 0x3c, 0x72, 0xb4,
-	0xa8, 0x27, 0x34, 0x78, 0x0a, 0x73, 0x53, 0x3d, 0x7a, 0x6e, 0x3a, 0xe0, 0x69, 0xae, 0xc9, 0xb6,
-	0x49, 0xd8, 0xa5, 0xba, 0xf6, 0xbb, 0xa5, 0x19, 0x2a, 0xd4, 0x69, 0xe6, 0xe7, 0x04, 0x37, 0x2e,
-	0x8c, 0x24, 0x7d, 0x5f, 0x05, 0x1b, 0x29, 0xb5, 0x56, 0x1f, 0xf1, 0xde, 0xf5, 0x48, 0x66, 0x78,
-	0x5e, 0x9d, 0xde, 0xc3, 0x24, 0xe8, 0x89, 0xa4, 0xd3, 0xd5, 0x93, 0xf1, 0x06, 0x2c, 0x4c, 0xbd,
-	0x01, 0x14, 0x9c, 0xce, 0xf0, 0x79, 0xcc, 0xae, 0x8d, 0x63, 0x7a, 0xf6, 0x31, 0x99, 0x93, 0x97,
-	0x66, 0xeb, 0x9a, 0x4c, 0x96, 0x99, 0x91, 0x53, 0x51, 0xfe, 0xde, 0x48, 0xcc, 0xb7, 0xcb, 0xe0,
-	0xc4, 0xdb, 0x6a, 0xe7, 0xb6, 0x04, 0x12, 0x18, 0xde, 0x00, 0x8b, 0x43, 0xc4, 0xd0, 0x40, 0x25,
-	0x60, 0x65, 0xf7, 0xb9, 0x52, 0xf0, 0x7d, 0x69, 0x6a, 0xe2, 0x69, 0x6f, 0xf8, 0x1e, 0x58, 0xea,
-	0x62, 0xdc, 0x1e, 0x52, 0xda, 0xd7, 0xcd, 0x7f, 0xa1, 0x34, 0xd2, 0x0d, 0x8c, 0xf7, 0x29, 0xed,
-	0x4f, 0x35, 0x7b, 0x57, 0x9d, 0xc1, 0x31, 0xb0, 0xb3, 0x16, 0x4e, 0xd7, 0x5f, 0xdc, 0x35, 0xf1,
-	0xc8, 0x58, 0x98, 0xbd, 0x6d, 0xcc, 0x8d, 0x6c, 0x22, 0xad, 0xfb, 0x45, 0x16, 0x3c, 0x7e, 0x77,
-	0x86, 0x0c, 0x47, 0x84, 0x8e, 0xe4, 0x07, 0xc0, 0x90, 0x72, 0xcc, 0x64, 0x51, 0x4a, 0xdf, 0x9d,
-	0xc4, 0x65, 0x5f, 0x7b, 0xc0, 0x2f, 0x8a, 0xd7, 0xdd, 0x53, 0x92, 0xfa, 0x9b, 0xb3, 0x55, 0xf7,
-	0x61, 0xcb, 0xd9, 0x94, 0x51, 0xb0, 0xe1, 0xe0, 0x0f, 0x16, 0xd8, 0x36, 0x9a, 0x3b, 0x5b, 0x07,
-	0x6d, 0x2f, 0xdd, 0x18, 0xdc, 0x5e, 0x94, 0x54, 0xae, 0xfe, 0x8f, 0xad, 0x93, 0x67, 0x53, 0x8b,
-	0x4a, 0x1d, 0x38, 0xfc, 0xc6, 0x02, 0x67, 0x33, 0x6a, 0xbd, 0x74, 0x9c, 0xa7, 0x09, 0x3a, 0x2e,
-	0x59, 0xbd, 0x71, 0xc4, 0x75, 0x90, 0x67, 0xb4, 0x19, 0x3d, 0xd4, 0x18, 0x7e, 0x65, 0x81, 0x8d,
-	0x8c, 0x8c, 0xa7, 0x26, 0x70, 0xca, 0x64, 0x49, 0x32, 0xb9, 0x72, 0x94, 0xf1, 0x9d, 0xa7, 0xf1,
-	0x6c, 0x54, 0x6c, 0x09, 0xbf, 0x34, 0xfb, 0x7c, 0x6a, 0x3a, 0x72, 0x7b, 0x59, 0x32, 0xb8, 0xfc,
-	0xf8, 0xe3, 0x31, 0x8f, 0x9f, 0x75, 0xbb, 0x69, 0xc7, 0xe1, 0x18, 0xac, 0x17, 0x8e, 0x21, 0x6e,
-	0x03, 0x09, 0xfe, 0xea, 0xe3, 0xce, 0xa1, 0x3c, 0xf4, 0x5a, 0xc1, 0x34, 0x32, 0x96, 0xd9, 0xde,
-	0x5b, 0x77, 0x26, 0x8e, 0x75, 0x6f, 0xe2, 0x58, 0xf7, 0x27, 0x8e, 0xf5, 0xe7, 0xc4, 0xb1, 0xbe,
-	0x3b, 0x70, 0x2a, 0xf7, 0x0f, 0x9c, 0xca, 0x6f, 0x07, 0x4e, 0xe5, 0x93, 0x6d, 0x85, 0xcf, 0xfd,
-	0x9b, 0x2e, 0xa1, 0xf5, 0xcf, 0xa6, 0xbf, 0xe1, 0xe5, 0x27, 0x5f, 0x67, 0x51, 0x7e, 0x87, 0xbf,
-	0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0x53, 0xd9, 0xb1, 0x65, 0x0c, 0x00, 0x00,
+func (m *ValidatorCommissionWithdrawalRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorCommissionWithdrawalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCommissionWithdrawalRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DelegatorWithdrawInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorCommissionWithdrawalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionWithdrawalRecord.Merge(m, src)
 }
-
-func (m *DelegatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WithdrawAddress) > 0 {
-		i -= len(m.WithdrawAddress)
-		copy(dAtA[i:], m.WithdrawAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *ValidatorCommissionWithdrawalRecord) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorCommissionWithdrawalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionWithdrawalRecord.DiscardUnknown(m)
 }
 
-func (m *ValidatorOutstandingRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_ValidatorCommissionWithdrawalRecord proto.InternalMessageInfo
 
-func (m *ValidatorOutstandingRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OutstandingRewards) > 0 {
-		for iNdEx := len(m.OutstandingRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutstandingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// ValidatorLifetimeCommissionRecord is used for import/export via genesis json.
+type ValidatorLifetimeCommissionRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// lifetime is the cumulative commission withdrawn by the validator.
+	Lifetime ValidatorLifetimeCommission `protobuf:"bytes,2,opt,name=lifetime,proto3" json:"lifetime"`
 }
 
-func (m *ValidatorAccumulatedCommissionRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorLifetimeCommissionRecord) Reset()         { *m = ValidatorLifetimeCommissionRecord{} }
+func (m *ValidatorLifetimeCommissionRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLifetimeCommissionRecord) ProtoMessage()    {}
+func (*ValidatorLifetimeCommissionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{13}
 }
-
-func (m *ValidatorAccumulatedCommissionRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorLifetimeCommissionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ValidatorAccumulatedCommissionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Accumulated.MarshalToSizedBuffer(dAtA[:i])
+func (m *ValidatorLifetimeCommissionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLifetimeCommissionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ValidatorHistoricalRewardsRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorLifetimeCommissionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLifetimeCommissionRecord.Merge(m, src)
+}
+func (m *ValidatorLifetimeCommissionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLifetimeCommissionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLifetimeCommissionRecord.DiscardUnknown(m)
 }
 
-func (m *ValidatorHistoricalRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ValidatorLifetimeCommissionRecord proto.InternalMessageInfo
+
+// DelegatorCarriedRewardsRecord is used for import/export via genesis json.
+type DelegatorCarriedRewardsRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// rewards represents the rewards carried over to the next blocks.
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// validator_address is the address of the validator of the delegation.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *ValidatorHistoricalRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+func (m *DelegatorCarriedRewardsRecord) Reset()         { *m = DelegatorCarriedRewardsRecord{} }
+func (m *DelegatorCarriedRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*DelegatorCarriedRewardsRecord) ProtoMessage()    {}
+func (*DelegatorCarriedRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{14}
+}
+func (m *DelegatorCarriedRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorCarriedRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorCarriedRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Period != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Period))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ValidatorCurrentRewardsRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DelegatorCarriedRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorCarriedRewardsRecord.Merge(m, src)
+}
+func (m *DelegatorCarriedRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorCarriedRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorCarriedRewardsRecord.DiscardUnknown(m)
 }
 
-func (m *ValidatorCurrentRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DelegatorCarriedRewardsRecord proto.InternalMessageInfo
+
+// ValidatorAccumulatedCommissionRecord is used for import / export via genesis
+// json.
+type ValidatorAccumulatedCommissionRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// accumulated is the accumulated commission of a validator.
+	Accumulated ValidatorAccumulatedCommission `protobuf:"bytes,2,opt,name=accumulated,proto3" json:"accumulated"`
 }
 
-func (m *ValidatorCurrentRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+func (m *ValidatorAccumulatedCommissionRecord) Reset()         { *m = ValidatorAccumulatedCommissionRecord{} }
+func (m *ValidatorAccumulatedCommissionRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAccumulatedCommissionRecord) ProtoMessage()    {}
+func (*ValidatorAccumulatedCommissionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{15}
+}
+func (m *ValidatorAccumulatedCommissionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAccumulatedCommissionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAccumulatedCommissionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DelegatorStartingInfoRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorAccumulatedCommissionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAccumulatedCommissionRecord.Merge(m, src)
 }
-
-func (m *DelegatorStartingInfoRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorAccumulatedCommissionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAccumulatedCommissionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAccumulatedCommissionRecord.DiscardUnknown(m)
 }
 
-func (m *DelegatorStartingInfoRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StartingInfo.MarshalToSizedBuffer(dAtA[:i])
+var xxx_messageInfo_ValidatorAccumulatedCommissionRecord proto.InternalMessageInfo
+
+// ValidatorHistoricalRewardsRecord is used for import / export via genesis
+// json.
+type ValidatorHistoricalRewardsRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// period defines the period the historical rewards apply to.
+	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// rewards defines the historical rewards of a validator.
+	Rewards ValidatorHistoricalRewards `protobuf:"bytes,3,opt,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *ValidatorHistoricalRewardsRecord) Reset()         { *m = ValidatorHistoricalRewardsRecord{} }
+func (m *ValidatorHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorHistoricalRewardsRecord) ProtoMessage()    {}
+func (*ValidatorHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{16}
+}
+func (m *ValidatorHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorHistoricalRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorHistoricalRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ValidatorSlashEventRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorHistoricalRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorHistoricalRewardsRecord.Merge(m, src)
+}
+func (m *ValidatorHistoricalRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorHistoricalRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorHistoricalRewardsRecord.DiscardUnknown(m)
 }
 
-func (m *ValidatorSlashEventRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ValidatorHistoricalRewardsRecord proto.InternalMessageInfo
+
+// ValidatorCurrentRewardsRecord is used for import / export via genesis json.
+type ValidatorCurrentRewardsRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rewards defines the current rewards of a validator.
+	Rewards ValidatorCurrentRewards `protobuf:"bytes,2,opt,name=rewards,proto3" json:"rewards"`
 }
 
-func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ValidatorSlashEvent.MarshalToSizedBuffer(dAtA[:i])
+func (m *ValidatorCurrentRewardsRecord) Reset()         { *m = ValidatorCurrentRewardsRecord{} }
+func (m *ValidatorCurrentRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCurrentRewardsRecord) ProtoMessage()    {}
+func (*ValidatorCurrentRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{17}
+}
+func (m *ValidatorCurrentRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCurrentRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCurrentRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Period != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Period))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Height != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorCurrentRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCurrentRewardsRecord.Merge(m, src)
+}
+func (m *ValidatorCurrentRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCurrentRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCurrentRewardsRecord.DiscardUnknown(m)
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ValidatorCurrentRewardsRecord proto.InternalMessageInfo
+
+// DelegatorStartingInfoRecord used for import / export via genesis json.
+type DelegatorStartingInfoRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// starting_info defines the starting info of a delegator.
+	StartingInfo DelegatorStartingInfo `protobuf:"bytes,3,opt,name=starting_info,json=startingInfo,proto3" json:"starting_info"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorSlashEvents) > 0 {
-		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorSlashEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
+func (m *DelegatorStartingInfoRecord) Reset()         { *m = DelegatorStartingInfoRecord{} }
+func (m *DelegatorStartingInfoRecord) String() string { return proto.CompactTextString(m) }
+func (*DelegatorStartingInfoRecord) ProtoMessage()    {}
+func (*DelegatorStartingInfoRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{18}
+}
+func (m *DelegatorStartingInfoRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorStartingInfoRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorStartingInfoRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DelegatorStartingInfos) > 0 {
-		for iNdEx := len(m.DelegatorStartingInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegatorStartingInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+}
+func (m *DelegatorStartingInfoRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorStartingInfoRecord.Merge(m, src)
+}
+func (m *DelegatorStartingInfoRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorStartingInfoRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorStartingInfoRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorStartingInfoRecord proto.InternalMessageInfo
+
+// ValidatorSlashEventRecord is used for import / export via genesis json.
+type ValidatorSlashEventRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height defines the block height at which the slash event occurred.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// period is the period of the slash event.
+	Period uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// validator_slash_event describes the slash event.
+	ValidatorSlashEvent ValidatorSlashEvent `protobuf:"bytes,4,opt,name=validator_slash_event,json=validatorSlashEvent,proto3" json:"validator_slash_event"`
+}
+
+func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRecord{} }
+func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSlashEventRecord) ProtoMessage()    {}
+func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{19}
+}
+func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSlashEventRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSlashEventRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ValidatorCurrentRewards) > 0 {
-		for iNdEx := len(m.ValidatorCurrentRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorCurrentRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
+}
+func (m *ValidatorSlashEventRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSlashEventRecord.Merge(m, src)
+}
+func (m *ValidatorSlashEventRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSlashEventRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
+
+// GenesisState defines the distribution module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// fee_pool defines the fee pool at genesis.
+	FeePool FeePool `protobuf:"bytes,2,opt,name=fee_pool,json=feePool,proto3" json:"fee_pool"`
+	// fee_pool defines the delegator withdraw infos at genesis.
+	DelegatorWithdrawInfos []DelegatorWithdrawInfo `protobuf:"bytes,3,rep,name=delegator_withdraw_infos,json=delegatorWithdrawInfos,proto3" json:"delegator_withdraw_infos"`
+	// fee_pool defines the previous proposer at genesis.
+	PreviousProposer string `protobuf:"bytes,4,opt,name=previous_proposer,json=previousProposer,proto3" json:"previous_proposer,omitempty"`
+	// fee_pool defines the outstanding rewards of all validators at genesis.
+	OutstandingRewards []ValidatorOutstandingRewardsRecord `protobuf:"bytes,5,rep,name=outstanding_rewards,json=outstandingRewards,proto3" json:"outstanding_rewards"`
+	// fee_pool defines the accumulated commissions of all validators at genesis.
+	ValidatorAccumulatedCommissions []ValidatorAccumulatedCommissionRecord `protobuf:"bytes,6,rep,name=validator_accumulated_commissions,json=validatorAccumulatedCommissions,proto3" json:"validator_accumulated_commissions"`
+	// fee_pool defines the historical rewards of all validators at genesis.
+	ValidatorHistoricalRewards []ValidatorHistoricalRewardsRecord `protobuf:"bytes,7,rep,name=validator_historical_rewards,json=validatorHistoricalRewards,proto3" json:"validator_historical_rewards"`
+	// fee_pool defines the current rewards of all validators at genesis.
+	ValidatorCurrentRewards []ValidatorCurrentRewardsRecord `protobuf:"bytes,8,rep,name=validator_current_rewards,json=validatorCurrentRewards,proto3" json:"validator_current_rewards"`
+	// fee_pool defines the delegator starting infos at genesis.
+	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
+	// fee_pool defines the validator slash events at genesis.
+	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// validator_commission_withdraw_infos defines the validator commission
+	// withdraw infos at genesis.
+	ValidatorCommissionWithdrawInfos []ValidatorCommissionWithdrawInfo `protobuf:"bytes,11,rep,name=validator_commission_withdraw_infos,json=validatorCommissionWithdrawInfos,proto3" json:"validator_commission_withdraw_infos"`
+	// delegator_withdraw_splits defines the delegator withdraw splits at
+	// genesis.
+	DelegatorWithdrawSplits []DelegatorWithdrawSplitRecord `protobuf:"bytes,12,rep,name=delegator_withdraw_splits,json=delegatorWithdrawSplits,proto3" json:"delegator_withdraw_splits"`
+	// validator_escrowed_rewards defines the escrowed rewards of jailed
+	// validators at genesis.
+	ValidatorEscrowedRewards []ValidatorEscrowedRewardsRecord `protobuf:"bytes,13,rep,name=validator_escrowed_rewards,json=validatorEscrowedRewards,proto3" json:"validator_escrowed_rewards"`
+	// validator_commission_caps defines the commission caps of validators at
+	// genesis.
+	ValidatorCommissionCaps []ValidatorCommissionCapRecord `protobuf:"bytes,14,rep,name=validator_commission_caps,json=validatorCommissionCaps,proto3" json:"validator_commission_caps"`
+	// delegator_carried_rewards defines the rewards of delegations carried over
+	// above the maximum withdrawal per block at genesis.
+	DelegatorCarriedRewards []DelegatorCarriedRewardsRecord `protobuf:"bytes,15,rep,name=delegator_carried_rewards,json=delegatorCarriedRewards,proto3" json:"delegator_carried_rewards"`
+	// validator_creation_heights defines the heights the validators were created
+	// at, starting their commission grace period, at genesis.
+	ValidatorCreationHeights []ValidatorCreationHeightRecord `protobuf:"bytes,16,rep,name=validator_creation_heights,json=validatorCreationHeights,proto3" json:"validator_creation_heights"`
+	// validator_commission_exemptions defines the denoms exempt from the
+	// commission of validators at genesis.
+	ValidatorCommissionExemptions []ValidatorCommissionExemptionRecord `protobuf:"bytes,17,rep,name=validator_commission_exemptions,json=validatorCommissionExemptions,proto3" json:"validator_commission_exemptions"`
+	// frozen_validators defines the validators whose reward accrual is frozen at
+	// genesis.
+	FrozenValidators []string `protobuf:"bytes,18,rep,name=frozen_validators,json=frozenValidators,proto3" json:"frozen_validators,omitempty"`
+	// validator_commission_withdrawals defines the recorded commission
+	// withdrawals of validators at genesis.
+	ValidatorCommissionWithdrawals []ValidatorCommissionWithdrawalRecord `protobuf:"bytes,19,rep,name=validator_commission_withdrawals,json=validatorCommissionWithdrawals,proto3" json:"validator_commission_withdrawals"`
+	// rebated_validators defines the validators whose commission is rebated to
+	// their delegators at genesis.
+	RebatedValidators []string `protobuf:"bytes,20,rep,name=rebated_validators,json=rebatedValidators,proto3" json:"rebated_validators,omitempty"`
+	// fee_collector_name defines the name of the module account the fees are
+	// collected from at genesis. If empty, the fee collector configured in the
+	// keeper is used.
+	FeeCollectorName string `protobuf:"bytes,21,opt,name=fee_collector_name,json=feeCollectorName,proto3" json:"fee_collector_name,omitempty"`
+	// validator_reward_multipliers defines the reward multipliers of validators
+	// at genesis.
+	ValidatorRewardMultipliers []ValidatorRewardMultiplierRecord `protobuf:"bytes,22,rep,name=validator_reward_multipliers,json=validatorRewardMultipliers,proto3" json:"validator_reward_multipliers"`
+	// allocation_epoch defines the progress of the current allocation epoch at
+	// genesis.
+	AllocationEpoch AllocationEpoch `protobuf:"bytes,23,opt,name=allocation_epoch,json=allocationEpoch,proto3" json:"allocation_epoch"`
+	// allocation_epoch_powers defines the powers of validators summed over the
+	// current allocation epoch at genesis.
+	AllocationEpochPowers []AllocationEpochPowerRecord `protobuf:"bytes,24,rep,name=allocation_epoch_powers,json=allocationEpochPowers,proto3" json:"allocation_epoch_powers"`
+	// validator_operator_rewards_addresses defines the addresses the rewards of
+	// validator operators' self-delegations are routed to at genesis.
+	ValidatorOperatorRewardsAddresses []ValidatorOperatorRewardsAddressRecord `protobuf:"bytes,25,rep,name=validator_operator_rewards_addresses,json=validatorOperatorRewardsAddresses,proto3" json:"validator_operator_rewards_addresses"`
+	// validator_lifetime_commissions defines the cumulative commission withdrawn
+	// by validators at genesis.
+	ValidatorLifetimeCommissions []ValidatorLifetimeCommissionRecord `protobuf:"bytes,26,rep,name=validator_lifetime_commissions,json=validatorLifetimeCommissions,proto3" json:"validator_lifetime_commissions"`
+	// community_tax_history defines the recorded community tax changes at
+	// genesis.
+	CommunityTaxHistory []CommunityTaxRecord `protobuf:"bytes,27,rep,name=community_tax_history,json=communityTaxHistory,proto3" json:"community_tax_history"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{20}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ValidatorHistoricalRewards) > 0 {
-		for iNdEx := len(m.ValidatorHistoricalRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorHistoricalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawInfo")
+	proto.RegisterType((*ValidatorCommissionWithdrawInfo)(nil), "cosmos.distribution.v1beta1.ValidatorCommissionWithdrawInfo")
+	proto.RegisterType((*ValidatorOperatorRewardsAddressRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOperatorRewardsAddressRecord")
+	proto.RegisterType((*DelegatorWithdrawSplitRecord)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawSplitRecord")
+	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
+	proto.RegisterType((*RewardSnapshot)(nil), "cosmos.distribution.v1beta1.RewardSnapshot")
+	proto.RegisterType((*ValidatorEscrowedRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorEscrowedRewardsRecord")
+	proto.RegisterType((*ValidatorCommissionCapRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCommissionCapRecord")
+	proto.RegisterType((*ValidatorRewardMultiplierRecord)(nil), "cosmos.distribution.v1beta1.ValidatorRewardMultiplierRecord")
+	proto.RegisterType((*AllocationEpochPowerRecord)(nil), "cosmos.distribution.v1beta1.AllocationEpochPowerRecord")
+	proto.RegisterType((*ValidatorCreationHeightRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCreationHeightRecord")
+	proto.RegisterType((*ValidatorCommissionExemptionRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCommissionExemptionRecord")
+	proto.RegisterType((*ValidatorCommissionWithdrawalRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCommissionWithdrawalRecord")
+	proto.RegisterType((*ValidatorLifetimeCommissionRecord)(nil), "cosmos.distribution.v1beta1.ValidatorLifetimeCommissionRecord")
+	proto.RegisterType((*DelegatorCarriedRewardsRecord)(nil), "cosmos.distribution.v1beta1.DelegatorCarriedRewardsRecord")
+	proto.RegisterType((*ValidatorAccumulatedCommissionRecord)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommissionRecord")
+	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewardsRecord")
+	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
+	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
+	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
+	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/distribution/v1beta1/genesis.proto", fileDescriptor_76eed0f9489db580)
+}
+
+var fileDescriptor_76eed0f9489db580 = []byte{
+	// 1841 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0xd7, 0x68, 0x65, 0x59, 0x1a, 0xc9, 0x96, 0x44, 0x7d, 0x98, 0x92, 0xa5, 0xdd, 0x95, 0xed,
+	0x02, 0xae, 0x6b, 0xed, 0xd6, 0x6a, 0xe1, 0xba, 0xee, 0x87, 0xab, 0x2f, 0xd7, 0x2d, 0x5c, 0x57,
+	0x5d, 0x19, 0x2e, 0x5a, 0x18, 0x20, 0x46, 0xe4, 0x68, 0x97, 0x30, 0xc9, 0x21, 0x38, 0xb3, 0xbb,
+	0x92, 0x8b, 0x02, 0x2d, 0x5a, 0xc0, 0x6d, 0x2e, 0xf9, 0x00, 0x6c, 0x04, 0x39, 0x04, 0x46, 0x4e,
+	0x46, 0x80, 0x00, 0x3e, 0xf8, 0x1a, 0x20, 0x01, 0x12, 0xc0, 0x40, 0x2e, 0x86, 0x93, 0x43, 0x10,
+	0x20, 0x4e, 0x20, 0x1f, 0x1c, 0x04, 0x39, 0xe5, 0x2f, 0x08, 0x48, 0xce, 0x92, 0xc3, 0x5d, 0x8a,
+	0xa2, 0xe4, 0x5d, 0x27, 0x17, 0xcb, 0x4b, 0xbe, 0x99, 0xf7, 0xfb, 0xbd, 0xf7, 0xe6, 0xbd, 0x37,
+	0x8f, 0xf0, 0xc7, 0x2a, 0xa1, 0x26, 0xa1, 0x45, 0x4d, 0xa7, 0xcc, 0xd1, 0xd7, 0xab, 0x4c, 0x27,
+	0x56, 0xb1, 0x76, 0x66, 0x1d, 0x33, 0x74, 0xa6, 0x58, 0xc6, 0x16, 0xa6, 0x3a, 0x2d, 0xd8, 0x0e,
+	0x61, 0x44, 0x3a, 0xea, 0x8b, 0x16, 0x44, 0xd1, 0x02, 0x17, 0x9d, 0x1a, 0x2b, 0x93, 0x32, 0xf1,
+	0xe4, 0x8a, 0xee, 0xff, 0xfc, 0x25, 0x53, 0x59, 0xbe, 0xfb, 0x3a, 0xa2, 0x38, 0xd8, 0x55, 0x25,
+	0xba, 0xc5, 0xdf, 0x17, 0x92, 0xb4, 0x47, 0xf4, 0xf8, 0xf2, 0x93, 0xbe, 0xbc, 0xe2, 0x2b, 0xe2,
+	0x78, 0xfc, 0x57, 0x23, 0xc8, 0xd4, 0x2d, 0x52, 0xf4, 0xfe, 0xf5, 0x1f, 0x1d, 0x7b, 0x07, 0xc0,
+	0xf1, 0x65, 0x6c, 0xe0, 0x32, 0x62, 0xc4, 0xf9, 0xab, 0xce, 0x2a, 0x9a, 0x83, 0xea, 0x7f, 0xb0,
+	0x36, 0x88, 0xb4, 0x02, 0x47, 0xb4, 0xc6, 0x0b, 0x05, 0x69, 0x9a, 0x83, 0x29, 0x95, 0x41, 0x1e,
+	0x9c, 0xec, 0x5f, 0x94, 0x1f, 0x3f, 0x98, 0x1b, 0xe3, 0x3b, 0x2f, 0xf8, 0x6f, 0xd6, 0x98, 0xa3,
+	0x5b, 0xe5, 0xd2, 0x70, 0xb0, 0x84, 0x3f, 0x97, 0x96, 0xe0, 0x70, 0x9d, 0x6f, 0x1b, 0xec, 0xd2,
+	0xbd, 0xcb, 0x2e, 0x43, 0x8d, 0x15, 0xfc, 0xf1, 0xf9, 0xbe, 0xff, 0xdd, 0xcd, 0x75, 0x7d, 0x75,
+	0x37, 0xd7, 0x75, 0xec, 0x3d, 0x00, 0x73, 0xd7, 0x90, 0xa1, 0x6b, 0xae, 0x8e, 0x25, 0x62, 0x9a,
+	0x3a, 0xa5, 0x3a, 0xb1, 0x22, 0xc8, 0xaf, 0xc0, 0x91, 0x5a, 0x43, 0xa4, 0x09, 0xf9, 0xec, 0xe3,
+	0x07, 0x73, 0x33, 0x5c, 0x67, 0xb0, 0x4d, 0x13, 0x85, 0x5a, 0xd3, 0xf3, 0x76, 0x53, 0xf8, 0x00,
+	0xc0, 0x1f, 0x05, 0xba, 0xff, 0x6c, 0x63, 0xc7, 0xfd, 0x5b, 0xc2, 0x75, 0xe4, 0x68, 0x94, 0x4b,
+	0x97, 0xb0, 0x4a, 0x1c, 0xad, 0xed, 0x44, 0x16, 0xe0, 0x90, 0xe3, 0xeb, 0x49, 0xcd, 0xe3, 0xb0,
+	0x13, 0x01, 0x26, 0xd0, 0xf8, 0x10, 0xc0, 0xe9, 0x96, 0xc8, 0x59, 0xb3, 0x0d, 0x9d, 0x71, 0xf4,
+	0x6d, 0x0a, 0xa0, 0xab, 0xf0, 0x20, 0xb6, 0x98, 0xa3, 0x63, 0x17, 0x6c, 0xe6, 0xe4, 0xc0, 0x7c,
+	0xb1, 0x90, 0x70, 0xc8, 0x0a, 0x11, 0x24, 0x2b, 0x16, 0x73, 0xb6, 0x16, 0xfb, 0x1f, 0x3e, 0xc9,
+	0x75, 0xdd, 0x7b, 0x76, 0xff, 0x14, 0x28, 0x35, 0xb6, 0x12, 0x78, 0xdc, 0xea, 0x86, 0xb3, 0xa1,
+	0x3b, 0xaa, 0x8c, 0x32, 0x64, 0x69, 0x2e, 0x14, 0x9f, 0x78, 0x87, 0x5c, 0x71, 0x0b, 0xc0, 0x51,
+	0x12, 0x2a, 0x53, 0xb8, 0x99, 0x39, 0xc5, 0xe9, 0x06, 0x45, 0x37, 0x29, 0x04, 0xd4, 0x96, 0xb1,
+	0xba, 0x44, 0x74, 0x6b, 0xf1, 0x9c, 0xcb, 0xe7, 0xed, 0x2f, 0x72, 0x3f, 0x29, 0xeb, 0xac, 0x52,
+	0x5d, 0x2f, 0xa8, 0xc4, 0xe4, 0xe7, 0x9c, 0xff, 0x99, 0xa3, 0xda, 0x8d, 0x22, 0xdb, 0xb2, 0x31,
+	0x6d, 0xac, 0xa1, 0x3e, 0x7d, 0x89, 0xb4, 0xf0, 0x13, 0x2c, 0x71, 0x3b, 0x03, 0x0f, 0xfb, 0x4f,
+	0xd7, 0x2c, 0x64, 0xd3, 0x0a, 0x61, 0xd2, 0x04, 0xec, 0xad, 0x60, 0xbd, 0x5c, 0x61, 0x1e, 0xd7,
+	0x9e, 0x12, 0xff, 0x25, 0xfd, 0x11, 0xf6, 0x6d, 0x60, 0xac, 0xd8, 0x84, 0x18, 0x5e, 0x08, 0x0d,
+	0xcc, 0x9f, 0x48, 0xf4, 0xca, 0x45, 0x8c, 0x57, 0x09, 0x31, 0x22, 0xae, 0xd8, 0xf0, 0x9f, 0x49,
+	0x37, 0xe3, 0x2d, 0x91, 0xf1, 0x2c, 0xf1, 0xdb, 0xc4, 0x6d, 0x77, 0xf5, 0x9b, 0xa8, 0x30, 0x86,
+	0xbc, 0xf4, 0x3a, 0x80, 0xb3, 0x82, 0x5f, 0x55, 0xb5, 0x6a, 0x56, 0x0d, 0xc4, 0xb0, 0xa6, 0xa8,
+	0x41, 0x6e, 0xa1, 0x72, 0x8f, 0x07, 0x65, 0x21, 0x1d, 0x94, 0x85, 0x70, 0x93, 0x30, 0x3f, 0xb5,
+	0xa2, 0xc9, 0xd5, 0x12, 0x17, 0x88, 0x7e, 0xf9, 0x06, 0xc0, 0x6c, 0xb0, 0xfd, 0x0a, 0x55, 0x1d,
+	0x52, 0xc7, 0x5a, 0x67, 0xc3, 0xd3, 0x86, 0x07, 0x5f, 0x4c, 0x44, 0x36, 0xd4, 0x08, 0x74, 0x3f,
+	0x02, 0x70, 0x3a, 0x26, 0xc5, 0x2f, 0x21, 0xbb, 0x43, 0x64, 0xff, 0x02, 0xfb, 0x4c, 0xb4, 0xa9,
+	0x38, 0x88, 0x61, 0x9e, 0x0f, 0xcf, 0xba, 0x7c, 0x3e, 0x7b, 0x92, 0xe3, 0xe5, 0x9c, 0x6a, 0x37,
+	0x0a, 0x3a, 0x29, 0x9a, 0x88, 0x55, 0x0a, 0x97, 0x71, 0x19, 0xa9, 0x5b, 0xcb, 0x58, 0x7d, 0xfc,
+	0x60, 0x0e, 0x72, 0x4d, 0xcb, 0x58, 0xe5, 0x6c, 0x4c, 0xb4, 0x59, 0x42, 0x0c, 0x0b, 0x6c, 0x3e,
+	0x16, 0x0b, 0x96, 0xef, 0xb4, 0x3f, 0x55, 0x0d, 0xa6, 0xdb, 0x86, 0x8e, 0x9d, 0x0e, 0x11, 0xba,
+	0x06, 0xa1, 0x19, 0xe8, 0x78, 0x4e, 0x4a, 0xc2, 0x4e, 0x02, 0xab, 0xf7, 0x01, 0x9c, 0x5a, 0x30,
+	0x0c, 0xa2, 0x22, 0xf7, 0x10, 0xac, 0xd8, 0x44, 0xad, 0xac, 0x92, 0x7a, 0x40, 0x68, 0x05, 0x8e,
+	0xa8, 0xc4, 0xa2, 0xd8, 0xa2, 0x55, 0x9a, 0x3e, 0xf5, 0x07, 0x4b, 0x1a, 0x3c, 0x4a, 0xf0, 0x80,
+	0x4d, 0xea, 0x9c, 0xc2, 0xc0, 0xfc, 0x99, 0xc4, 0x03, 0x18, 0x07, 0x47, 0x3c, 0x70, 0xfe, 0x56,
+	0x02, 0x87, 0x57, 0x01, 0x9c, 0x09, 0xe3, 0xcc, 0xc1, 0xde, 0xda, 0x4b, 0x5e, 0x7e, 0xeb, 0x90,
+	0x5f, 0xc2, 0x6c, 0xda, 0x2d, 0x66, 0x53, 0x01, 0xd3, 0x1d, 0x00, 0x8f, 0xc5, 0xc4, 0xfe, 0xca,
+	0x26, 0x36, 0x6d, 0x16, 0xe4, 0x91, 0x4e, 0x00, 0xd3, 0xb0, 0x45, 0x4c, 0xff, 0xb4, 0xf7, 0x97,
+	0xf8, 0x2f, 0x01, 0xd8, 0xe7, 0x00, 0x1e, 0x4f, 0xe8, 0xbb, 0x90, 0xd1, 0x21, 0x64, 0xd7, 0x21,
+	0xac, 0x07, 0x3a, 0x52, 0xc5, 0x41, 0x1c, 0x38, 0x31, 0x0e, 0x84, 0xfd, 0x04, 0x7e, 0x4f, 0x80,
+	0xd0, 0x05, 0x5c, 0xd6, 0x37, 0x30, 0xd3, 0x4d, 0xdc, 0x9c, 0xbf, 0xdb, 0xce, 0x4e, 0x81, 0x7d,
+	0x06, 0xd7, 0xc5, 0xb9, 0x9d, 0x4b, 0x57, 0x64, 0x5a, 0x11, 0x8a, 0x14, 0x83, 0x4d, 0x05, 0x82,
+	0xef, 0x76, 0xc3, 0x99, 0xa0, 0x5d, 0x5b, 0x42, 0x8e, 0xa3, 0x37, 0xd7, 0x90, 0x36, 0xf5, 0x6b,
+	0xff, 0x68, 0x2e, 0x1d, 0x93, 0xb1, 0xa5, 0xc3, 0xab, 0x1b, 0x17, 0x79, 0xdd, 0x38, 0x99, 0xa2,
+	0x6e, 0xb8, 0x0b, 0xe8, 0x1b, 0xcf, 0xee, 0x9f, 0x1a, 0x34, 0xbc, 0x7c, 0xa5, 0xa8, 0xad, 0x55,
+	0x24, 0xde, 0x41, 0x99, 0x7d, 0x3b, 0x48, 0xb0, 0xdf, 0xd7, 0x00, 0x9e, 0x48, 0x53, 0xe3, 0xdb,
+	0x1e, 0x23, 0x15, 0x38, 0x20, 0xf4, 0x25, 0x3c, 0x4c, 0x7e, 0xf5, 0x1c, 0xbd, 0x88, 0x18, 0x29,
+	0xe2, 0xd6, 0x02, 0xd9, 0x6f, 0x01, 0xcc, 0x07, 0x9b, 0x5c, 0xd2, 0x29, 0x23, 0x8e, 0xae, 0xba,
+	0x67, 0xbc, 0x93, 0x3d, 0xc7, 0x04, 0xec, 0xb5, 0xb1, 0xa3, 0x13, 0xad, 0x91, 0x1d, 0xfd, 0x5f,
+	0xd2, 0xf5, 0x30, 0xa0, 0x32, 0x1e, 0xf9, 0x5f, 0xa4, 0x23, 0xdf, 0x82, 0x3b, 0xd2, 0x7d, 0xb6,
+	0xf6, 0x1d, 0x9f, 0x44, 0xea, 0x41, 0xd5, 0x71, 0xb0, 0xc5, 0x3a, 0xcb, 0xf8, 0x6f, 0xe2, 0x51,
+	0x71, 0x99, 0xfd, 0x3c, 0x1d, 0xb3, 0x28, 0xb8, 0x5d, 0x68, 0xbd, 0xd5, 0x0d, 0x8f, 0x06, 0x07,
+	0x7f, 0x8d, 0x21, 0x87, 0xe9, 0x56, 0xd9, 0xbd, 0x27, 0xb7, 0xf7, 0xd8, 0xc7, 0xda, 0xa6, 0x7b,
+	0xff, 0xb6, 0x59, 0x87, 0x87, 0x28, 0x07, 0xab, 0xe8, 0xd6, 0x06, 0xe1, 0xbe, 0x9f, 0x4f, 0xb4,
+	0x50, 0x2c, 0x4f, 0xd1, 0x3e, 0x83, 0x54, 0x78, 0x21, 0xd6, 0xdd, 0x6e, 0x38, 0x19, 0x40, 0x5b,
+	0x33, 0x10, 0xad, 0xac, 0xd4, 0x3c, 0x0b, 0xbf, 0xc8, 0x3e, 0x40, 0x38, 0x01, 0x99, 0xc8, 0x09,
+	0x20, 0x70, 0x3c, 0xd4, 0x4f, 0x5d, 0x74, 0x0a, 0x76, 0xe1, 0xc9, 0x3d, 0x9e, 0x4d, 0x7e, 0x9a,
+	0x2e, 0x6a, 0x42, 0x5a, 0xa2, 0x45, 0x46, 0x6b, 0xad, 0xef, 0x05, 0xc3, 0x3c, 0x9c, 0x84, 0x83,
+	0xbf, 0xf7, 0x47, 0x5c, 0x6b, 0x0c, 0x31, 0x2c, 0x5d, 0x84, 0xbd, 0x36, 0x72, 0x90, 0xe9, 0x1b,
+	0x60, 0x60, 0xfe, 0x78, 0xa2, 0xf2, 0x55, 0x4f, 0x54, 0xd4, 0xc7, 0x57, 0xb7, 0xf5, 0x06, 0x59,
+	0x87, 0x72, 0x18, 0xc2, 0xc1, 0xa8, 0xc6, 0x8d, 0x9a, 0xc6, 0x35, 0x32, 0x65, 0xd8, 0x88, 0x63,
+	0x24, 0x51, 0xd3, 0x84, 0x16, 0x27, 0x41, 0xdd, 0xb3, 0x63, 0x3b, 0xb8, 0xa6, 0x93, 0xaa, 0x37,
+	0x6f, 0xb3, 0x09, 0xc5, 0x8e, 0xdc, 0xb3, 0xdb, 0xd9, 0x69, 0x2c, 0x59, 0xe5, 0x2b, 0x76, 0xba,
+	0x01, 0x1f, 0xf8, 0xe1, 0xdc, 0x80, 0x7b, 0xbf, 0x87, 0x1b, 0xb0, 0xf4, 0x12, 0x80, 0xd3, 0x21,
+	0xb4, 0x4a, 0x90, 0xce, 0x03, 0x03, 0x1d, 0xf4, 0x50, 0xfd, 0x66, 0x9f, 0xe5, 0xa0, 0x15, 0xd1,
+	0x54, 0x6d, 0x47, 0x61, 0xe9, 0xdf, 0x00, 0x4e, 0x86, 0x60, 0x54, 0x3f, 0x03, 0x07, 0x48, 0xfa,
+	0x3c, 0x24, 0xe7, 0xf7, 0x93, 0xbe, 0x5b, 0x61, 0x1c, 0xa9, 0xc5, 0x4b, 0x4a, 0xff, 0x14, 0xe3,
+	0x3c, 0x92, 0x1d, 0xa9, 0xdc, 0x9f, 0xcf, 0xec, 0xda, 0x3e, 0x26, 0x94, 0x81, 0xf8, 0x68, 0x17,
+	0xe5, 0xa8, 0x54, 0x87, 0x13, 0xb1, 0x69, 0x88, 0xca, 0xd0, 0x53, 0x7e, 0x76, 0xaf, 0x79, 0xa8,
+	0x55, 0xf5, 0x58, 0x4c, 0x36, 0xa2, 0xd2, 0x1d, 0x00, 0x8f, 0x0b, 0xb6, 0x0f, 0x42, 0xa4, 0xf9,
+	0xac, 0x0f, 0x78, 0x30, 0x7e, 0x9d, 0xd2, 0x0b, 0xb1, 0xc3, 0x63, 0x11, 0x4c, 0xbe, 0x96, 0x2c,
+	0x4b, 0xa5, 0x7f, 0x01, 0x38, 0x19, 0x93, 0x79, 0xa8, 0x3b, 0x7b, 0xa4, 0xf2, 0xa0, 0x07, 0xe7,
+	0x97, 0x7b, 0x4b, 0x3d, 0xc2, 0x04, 0x35, 0x12, 0x13, 0x5a, 0xac, 0x20, 0x95, 0xfe, 0x0b, 0x60,
+	0x18, 0xb6, 0x0a, 0xe6, 0xd3, 0xa1, 0x20, 0x30, 0x0f, 0xe5, 0x33, 0xe9, 0xdb, 0xc5, 0xd8, 0xd9,
+	0x92, 0x88, 0x42, 0xae, 0xed, 0x20, 0xea, 0x59, 0x22, 0xd6, 0x45, 0x2a, 0xb2, 0xa9, 0x7c, 0x38,
+	0x85, 0x25, 0x92, 0x46, 0x3e, 0x3b, 0x9c, 0x0e, 0x51, 0xd0, 0x3f, 0xa1, 0xa1, 0x33, 0x54, 0xff,
+	0x8a, 0x13, 0x18, 0x62, 0x28, 0xc5, 0x09, 0x4d, 0xbc, 0x1f, 0xc5, 0x7b, 0x23, 0x2a, 0x29, 0xfd,
+	0x27, 0xe2, 0x0d, 0x95, 0x0f, 0x15, 0x14, 0xbf, 0xbe, 0x53, 0x79, 0x78, 0x4f, 0x69, 0x22, 0x66,
+	0x24, 0x11, 0xef, 0x8c, 0xa8, 0x24, 0x95, 0x5e, 0x03, 0x30, 0x17, 0xeb, 0x0c, 0xdc, 0x98, 0x23,
+	0x50, 0x79, 0xc4, 0x83, 0x72, 0x61, 0xaf, 0x2e, 0x69, 0x9a, 0x44, 0x88, 0x78, 0x66, 0x6a, 0x09,
+	0xe2, 0x5e, 0x83, 0xb8, 0xe1, 0x90, 0x9b, 0xd8, 0x52, 0x02, 0x39, 0x2a, 0x4b, 0xf9, 0x4c, 0xca,
+	0x26, 0xca, 0x5f, 0x1b, 0xbc, 0xa5, 0xd2, 0x6d, 0x00, 0xf3, 0x89, 0x49, 0x01, 0x19, 0x54, 0x1e,
+	0xf5, 0x58, 0xfe, 0x6e, 0xbf, 0x19, 0x01, 0x19, 0xad, 0x34, 0xb3, 0xb5, 0x24, 0x79, 0x2a, 0xad,
+	0x42, 0xc9, 0xc1, 0xeb, 0x5e, 0x05, 0x15, 0x88, 0x8e, 0xa5, 0x25, 0x3a, 0xc2, 0x17, 0x0b, 0x4c,
+	0x4f, 0x43, 0xc9, 0x6d, 0x95, 0x54, 0x62, 0x18, 0x58, 0x75, 0xc9, 0x5a, 0xc8, 0xc4, 0xf2, 0xb8,
+	0xdb, 0x66, 0x94, 0x86, 0x37, 0x30, 0x5e, 0x6a, 0xbc, 0xb8, 0x82, 0x4c, 0x2c, 0xfd, 0x3f, 0x52,
+	0x35, 0xfd, 0xf0, 0x57, 0xc2, 0x21, 0x1e, 0x95, 0x27, 0xf6, 0x92, 0x25, 0xe3, 0x27, 0x96, 0xf1,
+	0x45, 0xb3, 0x59, 0xd6, 0x6d, 0xe2, 0x87, 0x51, 0x30, 0x96, 0x53, 0xb0, 0x3b, 0x97, 0x93, 0x8f,
+	0x78, 0xcd, 0xde, 0xe9, 0xbd, 0xcc, 0xf2, 0x44, 0x75, 0x43, 0x28, 0xfa, 0x4e, 0xba, 0x09, 0x8f,
+	0x34, 0xeb, 0x50, 0xbc, 0x51, 0x1f, 0x95, 0xe5, 0x7c, 0x66, 0xd7, 0xeb, 0xe2, 0xce, 0x53, 0x4c,
+	0x51, 0xeb, 0x38, 0x8a, 0x11, 0xa3, 0xd2, 0x9b, 0x00, 0x9e, 0x08, 0x6d, 0x4d, 0xf8, 0xb7, 0x3c,
+	0xa5, 0xe9, 0x23, 0x1b, 0xa6, 0xf2, 0xa4, 0x87, 0x64, 0x31, 0x65, 0x2b, 0x97, 0xf0, 0x4d, 0x50,
+	0x04, 0x35, 0x5b, 0x4b, 0x5e, 0x81, 0xa9, 0xf4, 0x32, 0x80, 0x61, 0xbc, 0x2a, 0x8d, 0xb1, 0x50,
+	0xa4, 0xb5, 0x9b, 0xda, 0x4b, 0x97, 0xb9, 0xd3, 0x64, 0x4c, 0x84, 0x35, 0x5d, 0xdb, 0x59, 0x9a,
+	0x4a, 0x16, 0x1c, 0x77, 0xb5, 0x57, 0x2d, 0x9d, 0x6d, 0x29, 0x0c, 0x6d, 0xf2, 0xbe, 0x6e, 0x4b,
+	0x3e, 0x9a, 0xe2, 0xe3, 0xde, 0x52, 0x63, 0xe5, 0x55, 0xb4, 0xd9, 0xaa, 0x78, 0x54, 0x15, 0x5e,
+	0xfb, 0x1d, 0xdc, 0x56, 0x78, 0x95, 0x59, 0xbc, 0x70, 0x6f, 0x3b, 0x0b, 0x1e, 0x6e, 0x67, 0xc1,
+	0xa3, 0xed, 0x2c, 0xf8, 0x72, 0x3b, 0x0b, 0x5e, 0x79, 0x9a, 0xed, 0x7a, 0xf4, 0x34, 0xdb, 0xf5,
+	0xe9, 0xd3, 0x6c, 0xd7, 0xdf, 0x67, 0x23, 0x73, 0xf1, 0xcd, 0xe8, 0xe7, 0x76, 0x6f, 0x02, 0xb5,
+	0xde, 0xeb, 0x7d, 0x32, 0xff, 0xd9, 0x77, 0x03, 0x00, 0x9e, 0x1d, 0xff, 0x67, 0x10, 0x20, 0x00,
+	0x00,
+}
+
+func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ValidatorAccumulatedCommissions) > 0 {
-		for iNdEx := len(m.ValidatorAccumulatedCommissions) - 1; iNdEx >= 0; iNdEx-- {
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorWithdrawInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCommissionWithdrawInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionWithdrawInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOperatorRewardsAddressRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOperatorRewardsAddressRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOperatorRewardsAddressRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorWithdrawSplitRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorWithdrawSplitRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorWithdrawSplitRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ValidatorAccumulatedCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -851,9 +1275,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOutstandingRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOutstandingRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.OutstandingRewards) > 0 {
 		for iNdEx := len(m.OutstandingRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -865,20 +1319,57 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.PreviousProposer) > 0 {
-		i -= len(m.PreviousProposer)
-		copy(dAtA[i:], m.PreviousProposer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PreviousProposer)))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
-	if len(m.DelegatorWithdrawInfos) > 0 {
-		for iNdEx := len(m.DelegatorWithdrawInfos) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAccumulatedCommissions) > 0 {
+		for iNdEx := len(m.ValidatorAccumulatedCommissions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegatorWithdrawInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ValidatorAccumulatedCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OutstandingRewards) > 0 {
+		for iNdEx := len(m.OutstandingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutstandingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -899,220 +1390,3686 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorEscrowedRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DelegatorWithdrawInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ValidatorEscrowedRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEscrowedRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorOutstandingRewardsRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorCommissionCapRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionCapRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionCapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.OutstandingRewards) > 0 {
-		for _, e := range m.OutstandingRewards {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorAccumulatedCommissionRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+func (m *ValidatorRewardMultiplierRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Accumulated.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ValidatorHistoricalRewardsRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorRewardMultiplierRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardMultiplierRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.Period != 0 {
-		n += 1 + sovGenesis(uint64(m.Period))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Rewards.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorCurrentRewardsRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+func (m *AllocationEpochPowerRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Rewards.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DelegatorStartingInfoRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AllocationEpochPowerRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocationEpochPowerRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.Power.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.StartingInfo.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorSlashEventRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+func (m *ValidatorCreationHeightRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCreationHeightRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCreationHeightRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Height != 0 {
-		n += 1 + sovGenesis(uint64(m.Height))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Period != 0 {
-		n += 1 + sovGenesis(uint64(m.Period))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.ValidatorSlashEvent.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorCommissionExemptionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionExemptionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionExemptionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.FeePool.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.DelegatorWithdrawInfos) > 0 {
-		for _, e := range m.DelegatorWithdrawInfos {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.PreviousProposer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.OutstandingRewards) > 0 {
-		for _, e := range m.OutstandingRewards {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCommissionWithdrawalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ValidatorAccumulatedCommissions) > 0 {
-		for _, e := range m.ValidatorAccumulatedCommissions {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionWithdrawalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionWithdrawalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.ValidatorHistoricalRewards) > 0 {
-		for _, e := range m.ValidatorHistoricalRewards {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLifetimeCommissionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLifetimeCommissionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLifetimeCommissionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Lifetime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.ValidatorCurrentRewards) > 0 {
-		for _, e := range m.ValidatorCurrentRewards {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorCarriedRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorCarriedRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorCarriedRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.DelegatorStartingInfos) > 0 {
-		for _, e := range m.DelegatorStartingInfos {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorAccumulatedCommissionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAccumulatedCommissionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorAccumulatedCommissionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Accumulated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.ValidatorSlashEvents) > 0 {
-		for _, e := range m.ValidatorSlashEvents {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorHistoricalRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorHistoricalRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorHistoricalRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Period != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCurrentRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCurrentRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCurrentRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorStartingInfoRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorStartingInfoRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorStartingInfoRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StartingInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSlashEventRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashEventRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValidatorSlashEvent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Period != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommunityTaxHistory) > 0 {
+		for iNdEx := len(m.CommunityTaxHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityTaxHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.ValidatorLifetimeCommissions) > 0 {
+		for iNdEx := len(m.ValidatorLifetimeCommissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorLifetimeCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.ValidatorOperatorRewardsAddresses) > 0 {
+		for iNdEx := len(m.ValidatorOperatorRewardsAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorOperatorRewardsAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.AllocationEpochPowers) > 0 {
+		for iNdEx := len(m.AllocationEpochPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllocationEpochPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	{
+		size, err := m.AllocationEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if len(m.ValidatorRewardMultipliers) > 0 {
+		for iNdEx := len(m.ValidatorRewardMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRewardMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.FeeCollectorName) > 0 {
+		i -= len(m.FeeCollectorName)
+		copy(dAtA[i:], m.FeeCollectorName)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeCollectorName)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.RebatedValidators) > 0 {
+		for iNdEx := len(m.RebatedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RebatedValidators[iNdEx])
+			copy(dAtA[i:], m.RebatedValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RebatedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ValidatorCommissionWithdrawals) > 0 {
+		for iNdEx := len(m.ValidatorCommissionWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCommissionWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.FrozenValidators) > 0 {
+		for iNdEx := len(m.FrozenValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenValidators[iNdEx])
+			copy(dAtA[i:], m.FrozenValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ValidatorCommissionExemptions) > 0 {
+		for iNdEx := len(m.ValidatorCommissionExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCommissionExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ValidatorCreationHeights) > 0 {
+		for iNdEx := len(m.ValidatorCreationHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCreationHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.DelegatorCarriedRewards) > 0 {
+		for iNdEx := len(m.DelegatorCarriedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorCarriedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ValidatorCommissionCaps) > 0 {
+		for iNdEx := len(m.ValidatorCommissionCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCommissionCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ValidatorEscrowedRewards) > 0 {
+		for iNdEx := len(m.ValidatorEscrowedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorEscrowedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DelegatorWithdrawSplits) > 0 {
+		for iNdEx := len(m.DelegatorWithdrawSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorWithdrawSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ValidatorCommissionWithdrawInfos) > 0 {
+		for iNdEx := len(m.ValidatorCommissionWithdrawInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCommissionWithdrawInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ValidatorSlashEvents) > 0 {
+		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSlashEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DelegatorStartingInfos) > 0 {
+		for iNdEx := len(m.DelegatorStartingInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorStartingInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ValidatorCurrentRewards) > 0 {
+		for iNdEx := len(m.ValidatorCurrentRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCurrentRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ValidatorHistoricalRewards) > 0 {
+		for iNdEx := len(m.ValidatorHistoricalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorHistoricalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ValidatorAccumulatedCommissions) > 0 {
+		for iNdEx := len(m.ValidatorAccumulatedCommissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorAccumulatedCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OutstandingRewards) > 0 {
+		for iNdEx := len(m.OutstandingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutstandingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PreviousProposer) > 0 {
+		i -= len(m.PreviousProposer)
+		copy(dAtA[i:], m.PreviousProposer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PreviousProposer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DelegatorWithdrawInfos) > 0 {
+		for iNdEx := len(m.DelegatorWithdrawInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorWithdrawInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.FeePool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelegatorWithdrawInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorCommissionWithdrawInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorOperatorRewardsAddressRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatorWithdrawSplitRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorOutstandingRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.OutstandingRewards) > 0 {
+		for _, e := range m.OutstandingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.FeePool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OutstandingRewards) > 0 {
+		for _, e := range m.OutstandingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorAccumulatedCommissions) > 0 {
+		for _, e := range m.ValidatorAccumulatedCommissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorEscrowedRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorCommissionCapRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MaxRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorRewardMultiplierRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AllocationEpochPowerRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorCreationHeightRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ValidatorCommissionExemptionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorCommissionWithdrawalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Withdrawal.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorLifetimeCommissionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Lifetime.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DelegatorCarriedRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorAccumulatedCommissionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Accumulated.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorHistoricalRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovGenesis(uint64(m.Period))
+	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorCurrentRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DelegatorStartingInfoRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.StartingInfo.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorSlashEventRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Period != 0 {
+		n += 1 + sovGenesis(uint64(m.Period))
+	}
+	l = m.ValidatorSlashEvent.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeePool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DelegatorWithdrawInfos) > 0 {
+		for _, e := range m.DelegatorWithdrawInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.PreviousProposer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.OutstandingRewards) > 0 {
+		for _, e := range m.OutstandingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorAccumulatedCommissions) > 0 {
+		for _, e := range m.ValidatorAccumulatedCommissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorHistoricalRewards) > 0 {
+		for _, e := range m.ValidatorHistoricalRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorCurrentRewards) > 0 {
+		for _, e := range m.ValidatorCurrentRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorStartingInfos) > 0 {
+		for _, e := range m.DelegatorStartingInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorSlashEvents) > 0 {
+		for _, e := range m.ValidatorSlashEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorCommissionWithdrawInfos) > 0 {
+		for _, e := range m.ValidatorCommissionWithdrawInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorWithdrawSplits) > 0 {
+		for _, e := range m.DelegatorWithdrawSplits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorEscrowedRewards) > 0 {
+		for _, e := range m.ValidatorEscrowedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorCommissionCaps) > 0 {
+		for _, e := range m.ValidatorCommissionCaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorCarriedRewards) > 0 {
+		for _, e := range m.DelegatorCarriedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorCreationHeights) > 0 {
+		for _, e := range m.ValidatorCreationHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorCommissionExemptions) > 0 {
+		for _, e := range m.ValidatorCommissionExemptions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenValidators) > 0 {
+		for _, s := range m.FrozenValidators {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorCommissionWithdrawals) > 0 {
+		for _, e := range m.ValidatorCommissionWithdrawals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RebatedValidators) > 0 {
+		for _, s := range m.RebatedValidators {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.FeeCollectorName)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ValidatorRewardMultipliers) > 0 {
+		for _, e := range m.ValidatorRewardMultipliers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.AllocationEpoch.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.AllocationEpochPowers) > 0 {
+		for _, e := range m.AllocationEpochPowers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorOperatorRewardsAddresses) > 0 {
+		for _, e := range m.ValidatorOperatorRewardsAddresses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorLifetimeCommissions) > 0 {
+		for _, e := range m.ValidatorLifetimeCommissions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommunityTaxHistory) > 0 {
+		for _, e := range m.CommunityTaxHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorWithdrawInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorWithdrawInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCommissionWithdrawInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionWithdrawInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionWithdrawInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOperatorRewardsAddressRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOperatorRewardsAddressRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOperatorRewardsAddressRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorWithdrawSplitRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorWithdrawSplitRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorWithdrawSplitRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, WithdrawSplitEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutstandingRewards = append(m.OutstandingRewards, types.DecCoin{})
+			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutstandingRewards = append(m.OutstandingRewards, ValidatorOutstandingRewardsRecord{})
+			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAccumulatedCommissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAccumulatedCommissions = append(m.ValidatorAccumulatedCommissions, ValidatorAccumulatedCommissionRecord{})
+			if err := m.ValidatorAccumulatedCommissions[len(m.ValidatorAccumulatedCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEscrowedRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEscrowedRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEscrowedRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCommissionCapRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionCapRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionCapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewardMultiplierRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardMultiplierRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardMultiplierRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocationEpochPowerRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocationEpochPowerRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocationEpochPowerRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCreationHeightRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCreationHeightRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCreationHeightRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCommissionExemptionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionExemptionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionExemptionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCommissionWithdrawalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionWithdrawalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionWithdrawalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLifetimeCommissionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLifetimeCommissionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLifetimeCommissionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifetime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lifetime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorCarriedRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorCarriedRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorCarriedRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommissionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommissionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCurrentRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCurrentRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCurrentRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
+func (m *DelegatorStartingInfoRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1135,10 +5092,10 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorWithdrawInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorStartingInfoRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorWithdrawInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorStartingInfoRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1175,7 +5132,7 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1203,7 +5160,40 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1226,7 +5216,7 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1249,10 +5239,10 @@ func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewardsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSlashEventRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSlashEventRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1288,8 +5278,46 @@ func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1316,8 +5344,7 @@ func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutstandingRewards = append(m.OutstandingRewards, types.DecCoin{})
-			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorSlashEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1342,7 +5369,7 @@ func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1361,19 +5388,119 @@ func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommissionRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommissionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorWithdrawInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorWithdrawInfos = append(m.DelegatorWithdrawInfos, DelegatorWithdrawInfo{})
+			if err := m.DelegatorWithdrawInfos[len(m.DelegatorWithdrawInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousProposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1401,11 +5528,11 @@ func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.PreviousProposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accumulated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1432,65 +5559,16 @@ func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Accumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutstandingRewards = append(m.OutstandingRewards, ValidatorOutstandingRewardsRecord{})
+			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewardsRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAccumulatedCommissions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1500,29 +5578,31 @@ func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAccumulatedCommissions = append(m.ValidatorAccumulatedCommissions, ValidatorAccumulatedCommissionRecord{})
+			if err := m.ValidatorAccumulatedCommissions[len(m.ValidatorAccumulatedCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorHistoricalRewards", wireType)
 			}
-			m.Period = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1532,14 +5612,29 @@ func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorHistoricalRewards = append(m.ValidatorHistoricalRewards, ValidatorHistoricalRewardsRecord{})
+			if err := m.ValidatorHistoricalRewards[len(m.ValidatorHistoricalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCurrentRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1566,65 +5661,16 @@ func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorCurrentRewards = append(m.ValidatorCurrentRewards, ValidatorCurrentRewardsRecord{})
+			if err := m.ValidatorCurrentRewards[len(m.ValidatorCurrentRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorCurrentRewardsRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorCurrentRewardsRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorCurrentRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorStartingInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1634,27 +5680,29 @@ func (m *ValidatorCurrentRewardsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorStartingInfos = append(m.DelegatorStartingInfos, DelegatorStartingInfoRecord{})
+			if err := m.DelegatorStartingInfos[len(m.DelegatorStartingInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1678,68 +5726,19 @@ func (m *ValidatorCurrentRewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegatorStartingInfoRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorStartingInfoRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorStartingInfoRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEventRecord{})
+			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommissionWithdrawInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1749,29 +5748,31 @@ func (m *DelegatorStartingInfoRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorCommissionWithdrawInfos = append(m.ValidatorCommissionWithdrawInfos, ValidatorCommissionWithdrawInfo{})
+			if err := m.ValidatorCommissionWithdrawInfos[len(m.ValidatorCommissionWithdrawInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorWithdrawSplits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1781,27 +5782,29 @@ func (m *DelegatorStartingInfoRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorWithdrawSplits = append(m.DelegatorWithdrawSplits, DelegatorWithdrawSplitRecord{})
+			if err := m.DelegatorWithdrawSplits[len(m.DelegatorWithdrawSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorEscrowedRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1828,65 +5831,16 @@ func (m *DelegatorStartingInfoRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorEscrowedRewards = append(m.ValidatorEscrowedRewards, ValidatorEscrowedRewardsRecord{})
+			if err := m.ValidatorEscrowedRewards[len(m.ValidatorEscrowedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEventRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEventRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommissionCaps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1896,29 +5850,31 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorCommissionCaps = append(m.ValidatorCommissionCaps, ValidatorCommissionCapRecord{})
+			if err := m.ValidatorCommissionCaps[len(m.ValidatorCommissionCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorCarriedRewards", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1928,16 +5884,31 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.Period = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorCarriedRewards = append(m.DelegatorCarriedRewards, DelegatorCarriedRewardsRecord{})
+			if err := m.DelegatorCarriedRewards[len(m.DelegatorCarriedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCreationHeights", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1947,14 +5918,29 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorCreationHeights = append(m.ValidatorCreationHeights, ValidatorCreationHeightRecord{})
+			if err := m.ValidatorCreationHeights[len(m.ValidatorCreationHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommissionExemptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1981,65 +5967,16 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorSlashEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorCommissionExemptions = append(m.ValidatorCommissionExemptions, ValidatorCommissionExemptionRecord{})
+			if err := m.ValidatorCommissionExemptions[len(m.ValidatorCommissionExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenValidators", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2049,28 +5986,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FrozenValidators = append(m.FrozenValidators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommissionWithdrawals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2097,15 +6033,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorCommissionWithdrawals = append(m.ValidatorCommissionWithdrawals, ValidatorCommissionWithdrawalRecord{})
+			if err := m.ValidatorCommissionWithdrawals[len(m.ValidatorCommissionWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorWithdrawInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RebatedValidators", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2115,29 +6052,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorWithdrawInfos = append(m.DelegatorWithdrawInfos, DelegatorWithdrawInfo{})
-			if err := m.DelegatorWithdrawInfos[len(m.DelegatorWithdrawInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RebatedValidators = append(m.RebatedValidators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousProposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2165,11 +6100,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousProposer = string(dAtA[iNdEx:postIndex])
+			m.FeeCollectorName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewardMultipliers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2196,14 +6131,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutstandingRewards = append(m.OutstandingRewards, ValidatorOutstandingRewardsRecord{})
-			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorRewardMultipliers = append(m.ValidatorRewardMultipliers, ValidatorRewardMultiplierRecord{})
+			if err := m.ValidatorRewardMultipliers[len(m.ValidatorRewardMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAccumulatedCommissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationEpoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2230,14 +6165,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAccumulatedCommissions = append(m.ValidatorAccumulatedCommissions, ValidatorAccumulatedCommissionRecord{})
-			if err := m.ValidatorAccumulatedCommissions[len(m.ValidatorAccumulatedCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllocationEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorHistoricalRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationEpochPowers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2264,14 +6198,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorHistoricalRewards = append(m.ValidatorHistoricalRewards, ValidatorHistoricalRewardsRecord{})
-			if err := m.ValidatorHistoricalRewards[len(m.ValidatorHistoricalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllocationEpochPowers = append(m.AllocationEpochPowers, AllocationEpochPowerRecord{})
+			if err := m.AllocationEpochPowers[len(m.AllocationEpochPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCurrentRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOperatorRewardsAddresses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2298,14 +6232,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorCurrentRewards = append(m.ValidatorCurrentRewards, ValidatorCurrentRewardsRecord{})
-			if err := m.ValidatorCurrentRewards[len(m.ValidatorCurrentRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorOperatorRewardsAddresses = append(m.ValidatorOperatorRewardsAddresses, ValidatorOperatorRewardsAddressRecord{})
+			if err := m.ValidatorOperatorRewardsAddresses[len(m.ValidatorOperatorRewardsAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorStartingInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLifetimeCommissions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2332,14 +6266,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorStartingInfos = append(m.DelegatorStartingInfos, DelegatorStartingInfoRecord{})
-			if err := m.DelegatorStartingInfos[len(m.DelegatorStartingInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorLifetimeCommissions = append(m.ValidatorLifetimeCommissions, ValidatorLifetimeCommissionRecord{})
+			if err := m.ValidatorLifetimeCommissions[len(m.ValidatorLifetimeCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTaxHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2366,8 +6300,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEventRecord{})
-			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommunityTaxHistory = append(m.CommunityTaxHistory, CommunityTaxRecord{})
+			if err := m.CommunityTaxHistory[len(m.CommunityTaxHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ DistributionHooks = MultiDistributionHooks{}
+
+// sources of the funds added to the community pool, passed to the
+// AfterCommunityPoolFunded hook
+const (
+	// CommunityPoolSourceAllocation is the community pool cut of the fees
+	// allocated in BeginBlock
+	CommunityPoolSourceAllocation = "allocation"
+	// CommunityPoolSourceDecimalPool is the integer part of the decimal pool
+	// moved to the community pool
+	CommunityPoolSourceDecimalPool = "decimal_pool"
+)
+
+// combine multiple distribution hooks, all hook functions are run in array sequence
+type MultiDistributionHooks []DistributionHooks
+
+func NewMultiDistributionHooks(hooks ...DistributionHooks) MultiDistributionHooks {
+	return hooks
+}
+
+func (h MultiDistributionHooks) AfterValidatorRewardsAllocated(ctx context.Context, valAddr sdk.ValAddress, tokens sdk.DecCoins) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterValidatorRewardsAllocated(ctx, valAddr, tokens))
+	}
+
+	return errs
+}
+
+func (h MultiDistributionHooks) AfterCommunityPoolFunded(ctx context.Context, amount sdk.DecCoins, source string) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterCommunityPoolFunded(ctx, amount, source))
+	}
+
+	return errs
+}
@@ -48,17 +48,76 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height><period>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0a<valAddr_Bytes>: ValidatorCommissionWithdrawAddr
+//
+// - 0x0b<accAddr_Bytes>: DelegatorWithdrawSplit
+//
+// - 0x0c: AllocationRecord
+//
+// - 0x0d<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorRewardSample
+//
+// - 0x0e<valAddr_Bytes>: ValidatorEscrowedRewards
+//
+// - 0x0f<valAddr_Bytes>: ValidatorCommissionCap
+//
+// - 0x10<height>: DecimalPoolSample
+//
+// - 0x11<delAddr_Bytes>: DelegatorBlockWithdrawal
+//
+// - 0x12<delAddrLen (1 Byte)><delAddr_Bytes><valAddr_Bytes>: DelegatorCarriedRewards
+//
+// - 0x13<valAddr_Bytes>: ValidatorCreationHeight
+//
+// - 0x14<valAddr_Bytes>: ValidatorCommissionExemption
+//
+// - 0x15<valAddr_Bytes>: FrozenValidator
+//
+// - 0x16<valAddrLen (1 Byte)><valAddr_Bytes><height>: CommissionWithdrawal
+//
+// - 0x17<valAddr_Bytes>: RebatedValidator
+//
+// - 0x18: FeeCollectorName
+//
+// - 0x19<valAddr_Bytes>: ValidatorRewardMultiplier
+//
+// - 0x1a<consAddr_Bytes>: AllocationEpochPower
+//
+// - 0x1b: AllocationEpoch
 var (
-	FeePoolKey                           = collections.NewPrefix(0) // key for global distribution state
-	ProposerKey                          = collections.NewPrefix(1) // key for the proposer operator address
-	ValidatorOutstandingRewardsPrefix    = collections.NewPrefix(2) // key for outstanding rewards
-	DelegatorWithdrawAddrPrefix          = collections.NewPrefix(3) // key for delegator withdraw address
-	DelegatorStartingInfoPrefix          = collections.NewPrefix(4) // key for delegator starting info
-	ValidatorHistoricalRewardsPrefix     = collections.NewPrefix(5) // key for historical validators rewards / stake
-	ValidatorCurrentRewardsPrefix        = collections.NewPrefix(6) // key for current validator rewards
-	ValidatorAccumulatedCommissionPrefix = collections.NewPrefix(7) // key for accumulated validator commission
-	ValidatorSlashEventPrefix            = collections.NewPrefix(8) // key for validator slash fraction
-	ParamsKey                            = collections.NewPrefix(9) // key for distribution module params
+	FeePoolKey                            = collections.NewPrefix(0)  // key for global distribution state
+	ProposerKey                           = collections.NewPrefix(1)  // key for the proposer operator address
+	ValidatorOutstandingRewardsPrefix     = collections.NewPrefix(2)  // key for outstanding rewards
+	DelegatorWithdrawAddrPrefix           = collections.NewPrefix(3)  // key for delegator withdraw address
+	DelegatorStartingInfoPrefix           = collections.NewPrefix(4)  // key for delegator starting info
+	ValidatorHistoricalRewardsPrefix      = collections.NewPrefix(5)  // key for historical validators rewards / stake
+	ValidatorCurrentRewardsPrefix         = collections.NewPrefix(6)  // key for current validator rewards
+	ValidatorAccumulatedCommissionPrefix  = collections.NewPrefix(7)  // key for accumulated validator commission
+	ValidatorSlashEventPrefix             = collections.NewPrefix(8)  // key for validator slash fraction
+	ParamsKey                             = collections.NewPrefix(9)  // key for distribution module params
+	ValidatorCommissionWithdrawAddrPrefix = collections.NewPrefix(10) // key for validator commission withdraw address
+	DelegatorWithdrawSplitPrefix          = collections.NewPrefix(11) // key for delegator withdraw split
+	LastAllocationRecordKey               = collections.NewPrefix(12) // key for the record of the last allocation
+	ValidatorRewardSamplePrefix           = collections.NewPrefix(13) // key for validator reward samples
+	ValidatorEscrowedRewardsPrefix        = collections.NewPrefix(14) // key for escrowed rewards of jailed validators
+	ValidatorCommissionCapPrefix          = collections.NewPrefix(15) // key for validator commission caps
+	DecimalPoolSamplePrefix               = collections.NewPrefix(16) // key for decimal pool samples
+	DelegatorBlockWithdrawalPrefix        = collections.NewPrefix(17) // key for delegator withdrawals of the current block
+	DelegatorCarriedRewardsPrefix         = collections.NewPrefix(18) // key for delegation rewards carried over
+	ValidatorCreationHeightPrefix         = collections.NewPrefix(19) // key for validator creation heights
+	ValidatorCommissionExemptionPrefix    = collections.NewPrefix(20) // key for denoms exempt from validator commission
+	FrozenValidatorPrefix                 = collections.NewPrefix(21) // key for validators whose reward accrual is frozen
+	CommissionWithdrawalPrefix            = collections.NewPrefix(22) // key for recorded validator commission withdrawals
+	RebatedValidatorPrefix                = collections.NewPrefix(23) // key for validators whose commission is rebated
+	FeeCollectorNameKey                   = collections.NewPrefix(24) // key for the name of the fee collector module account
+	ValidatorRewardMultiplierPrefix       = collections.NewPrefix(25) // key for validator reward multipliers
+	AllocationEpochPowerPrefix            = collections.NewPrefix(26) // key for validator powers summed over the allocation epoch
+	AllocationEpochKey                    = collections.NewPrefix(27) // key for the progress of the allocation epoch
+	LastBlockFeesKey                      = collections.NewPrefix(28) // key for the fees allocated by the last allocation
+	ValidatorOperatorRewardsAddrPrefix    = collections.NewPrefix(29) // key for the addresses of validator operator self-delegation rewards
+	ValidatorLifetimeCommissionPrefix     = collections.NewPrefix(30) // key for the cumulative commission withdrawn by validators
+	CommunityTaxHistoryPrefix             = collections.NewPrefix(31) // key for the recorded community tax changes
+	CarriedRewardsPayoutQueuePrefix       = collections.NewPrefix(32) // key for the delegations whose carried rewards are paid out at the beginning of a block
 )
 
 // GetValidatorSlashEventAddressHeight creates the height from a validator's slash event key.
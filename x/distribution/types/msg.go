@@ -1,6 +1,8 @@
 package types
 
 import (
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,9 +10,21 @@ import (
 var (
 	_ sdk.Msg = (*MsgSetWithdrawAddress)(nil)
 	_ sdk.Msg = (*MsgWithdrawDelegatorReward)(nil)
+	_ sdk.Msg = (*MsgWithdrawAllDelegatorRewards)(nil)
+	_ sdk.Msg = (*MsgWithdrawAndCompound)(nil)
 	_ sdk.Msg = (*MsgWithdrawValidatorCommission)(nil)
+	_ sdk.Msg = (*MsgSetValidatorCommissionWithdrawAddress)(nil)
+	_ sdk.Msg = (*MsgSetWithdrawSplit)(nil)
+	_ sdk.Msg = (*MsgSetValidatorCommissionCap)(nil)
+	_ sdk.Msg = (*MsgSetValidatorCommissionExemptDenoms)(nil)
+	_ sdk.Msg = (*MsgSetValidatorRewardsFrozen)(nil)
+	_ sdk.Msg = (*MsgSetValidatorCommissionRebated)(nil)
+	_ sdk.Msg = (*MsgSetFeeCollectorName)(nil)
+	_ sdk.Msg = (*MsgSetValidatorRewardMultiplier)(nil)
+	_ sdk.Msg = (*MsgAllocateValidatorRewards)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
+	_ sdk.Msg = (*MsgCommunityPoolSpendBatch)(nil)
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
 )
@@ -29,12 +43,127 @@ func NewMsgWithdrawDelegatorReward(delAddr, valAddr string) *MsgWithdrawDelegato
 	}
 }
 
+func NewMsgWithdrawAllDelegatorRewards(delAddr string) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delAddr,
+	}
+}
+
+// NewMsgWithdrawAndCompound returns a new MsgWithdrawAndCompound with a
+// delegator and the validator its rewards are withdrawn from and compounded
+// to.
+func NewMsgWithdrawAndCompound(delAddr, valAddr string) *MsgWithdrawAndCompound {
+	return &MsgWithdrawAndCompound{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+	}
+}
+
 func NewMsgWithdrawValidatorCommission(valAddr string) *MsgWithdrawValidatorCommission {
 	return &MsgWithdrawValidatorCommission{
 		ValidatorAddress: valAddr,
 	}
 }
 
+// NewMsgSetValidatorCommissionWithdrawAddress returns a new
+// MsgSetValidatorCommissionWithdrawAddress with a validator and the address
+// its commission is withdrawn to.
+func NewMsgSetValidatorCommissionWithdrawAddress(valAddr, withdrawAddr string) *MsgSetValidatorCommissionWithdrawAddress {
+	return &MsgSetValidatorCommissionWithdrawAddress{
+		ValidatorAddress: valAddr,
+		WithdrawAddress:  withdrawAddr,
+	}
+}
+
+// NewMsgSetWithdrawSplit returns a new MsgSetWithdrawSplit with a delegator
+// and the addresses its rewards are split between.
+func NewMsgSetWithdrawSplit(delAddr string, entries []WithdrawSplitEntry) *MsgSetWithdrawSplit {
+	return &MsgSetWithdrawSplit{
+		DelegatorAddress: delAddr,
+		Entries:          entries,
+	}
+}
+
+// NewMsgSetValidatorCommissionCap returns a new MsgSetValidatorCommissionCap
+// with an authority, a validator and its maximum commission rate.
+func NewMsgSetValidatorCommissionCap(authority, valAddr string, maxRate math.LegacyDec) *MsgSetValidatorCommissionCap {
+	return &MsgSetValidatorCommissionCap{
+		Authority:        authority,
+		ValidatorAddress: valAddr,
+		MaxRate:          maxRate,
+	}
+}
+
+// NewMsgSetValidatorRewardsFrozen returns a new MsgSetValidatorRewardsFrozen
+// with an authority, a validator and whether its reward accrual is frozen.
+func NewMsgSetValidatorRewardsFrozen(authority, valAddr string, frozen bool) *MsgSetValidatorRewardsFrozen {
+	return &MsgSetValidatorRewardsFrozen{
+		Authority:        authority,
+		ValidatorAddress: valAddr,
+		Frozen:           frozen,
+	}
+}
+
+// NewMsgSetValidatorCommissionRebated returns a new
+// MsgSetValidatorCommissionRebated with an authority, a validator and whether
+// its commission is rebated.
+func NewMsgSetValidatorCommissionRebated(authority, valAddr string, rebated bool) *MsgSetValidatorCommissionRebated {
+	return &MsgSetValidatorCommissionRebated{
+		Authority:        authority,
+		ValidatorAddress: valAddr,
+		Rebated:          rebated,
+	}
+}
+
+// NewMsgSetFeeCollectorName returns a new MsgSetFeeCollectorName with an
+// authority and the name of the fee collector module account.
+func NewMsgSetFeeCollectorName(authority, feeCollectorName string) *MsgSetFeeCollectorName {
+	return &MsgSetFeeCollectorName{
+		Authority:        authority,
+		FeeCollectorName: feeCollectorName,
+	}
+}
+
+// NewMsgSetValidatorRewardMultiplier returns a new
+// MsgSetValidatorRewardMultiplier with an authority, a validator and the
+// multiplier applied to its rewards.
+func NewMsgSetValidatorRewardMultiplier(authority, valAddr string, multiplier math.LegacyDec) *MsgSetValidatorRewardMultiplier {
+	return &MsgSetValidatorRewardMultiplier{
+		Authority:        authority,
+		ValidatorAddress: valAddr,
+		Multiplier:       multiplier,
+	}
+}
+
+// NewMsgCommunityPoolSpendBatch returns a new MsgCommunityPoolSpendBatch with
+// an authority and the transfers from the community pool.
+func NewMsgCommunityPoolSpendBatch(authority string, spends []CommunityPoolSpendEntry) *MsgCommunityPoolSpendBatch {
+	return &MsgCommunityPoolSpendBatch{
+		Authority: authority,
+		Spends:    spends,
+	}
+}
+
+// NewMsgSetValidatorCommissionExemptDenoms returns a new
+// MsgSetValidatorCommissionExemptDenoms with a validator and the denoms its
+// commission is not charged on.
+func NewMsgSetValidatorCommissionExemptDenoms(valAddr string, denoms []string) *MsgSetValidatorCommissionExemptDenoms {
+	return &MsgSetValidatorCommissionExemptDenoms{
+		ValidatorAddress: valAddr,
+		Denoms:           denoms,
+	}
+}
+
+// NewMsgAllocateValidatorRewards returns a new MsgAllocateValidatorRewards
+// with an authority funding the rewards, a validator and the rewards amount.
+func NewMsgAllocateValidatorRewards(authority, valAddr string, amount sdk.Coins) *MsgAllocateValidatorRewards {
+	return &MsgAllocateValidatorRewards{
+		Authority:        authority,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
 // NewMsgFundCommunityPool returns a new MsgFundCommunityPool with a sender and
 // a funding amount.
 func NewMsgFundCommunityPool(amount sdk.Coins, depositor string) *MsgFundCommunityPool {
@@ -4,21 +4,140 @@ import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:                math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:          math.LegacyZeroDec(),            // no proposer reward
+		BonusProposerReward:         math.LegacyZeroDec(),            // no proposer reward
+		WithdrawAddrEnabled:         true,
+		DecimalPoolFlushThreshold:   math.ZeroInt(), // never flush
+		DistributionPaused:          false,
+		WithholdJailedRewards:       false,
+		MinCommissionRate:           math.LegacyZeroDec(), // validators' rates apply as is
+		BurnRate:                    math.LegacyZeroDec(), // no burn
+		MinSelfBondForRewards:       math.ZeroInt(),       // every validator is rewarded
+		SubsidyFloor:                nil,                  // no subsidy
+		DistributableDenoms:         nil,                  // every denom is distributable
+		RoundingMode:                RoundingModeTruncate, // shares are truncated
+		MaxWithdrawPerBlock:         nil,                  // no withdrawal cap
+		CommissionGracePeriodBlocks: 0,                    // no grace period
+		// the whole community pool cut goes to x/protocolpool
+		CommunityPoolRoutes: []CommunityPoolRoute{{Module: pooltypes.ModuleName, Weight: math.LegacyOneDec()}},
+		// the fees of a block with no bonded power go to the community pool
+		ZeroPowerStrategy: ZeroPowerStrategyCommunityPool,
+		// the decimal pool is not flushed periodically
+		DecimalPoolFlushInterval: 0,
+		// the commission withdrawals are not recorded
+		RecordCommissionHistory: false,
+		// no infra fund
+		InfraFundRate:   math.LegacyZeroDec(),
+		InfraFundModule: "",
+		// no commission rebate
+		CommissionRebateRate: math.LegacyZeroDec(),
+		// every withdrawal pays the rewards out
+		MinWithdrawAmount: nil,
+		// every validator of the bonded votes is rewarded
+		MaxRewardedValidators: 0,
+		// the fees are allocated every block
+		AllocationEpochBlocks: 1,
+		// the community pool funds of every denom go to x/protocolpool
+		CommunityPoolDenomDestinations: nil,
+		// the lifetime commission of the validators is not recorded
+		RecordLifetimeCommission: false,
+		// the community tax changes are not recorded
+		CommunityTaxHistoryLength: 0,
+		// the community pool cut is held in the fee pool
+		KeepCommunityFundsLocal: true,
+		// the withdraw address is removed with the last delegation
+		StickyWithdrawAddress: false,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+	if err := validateProposerReward("base", p.BaseProposerReward); err != nil {
+		return err
+	}
+	if err := validateProposerReward("bonus", p.BonusProposerReward); err != nil {
+		return err
+	}
+
+	// an unset proposer reward counts as zero
+	total := p.CommunityTax
+	if !p.BaseProposerReward.IsNil() {
+		total = total.Add(p.BaseProposerReward)
+	}
+	if !p.BonusProposerReward.IsNil() {
+		total = total.Add(p.BonusProposerReward)
+	}
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf(
+			"sum of community tax, base proposer reward and bonus proposer reward cannot be greater than one: %s", total,
+		)
+	}
+
+	if err := validateDecimalPoolFlushThreshold(p.DecimalPoolFlushThreshold); err != nil {
+		return err
+	}
+
+	if err := validateMinCommissionRate(p.MinCommissionRate); err != nil {
+		return err
+	}
+
+	if err := validateBurnRate(p.BurnRate); err != nil {
+		return err
+	}
+
+	if err := validateMinSelfBondForRewards(p.MinSelfBondForRewards); err != nil {
+		return err
+	}
+
+	if err := validateSubsidyFloor(p.SubsidyFloor); err != nil {
+		return err
+	}
+
+	if err := validateDistributableDenoms(p.DistributableDenoms); err != nil {
+		return err
+	}
+
+	if err := validateRoundingMode(p.RoundingMode); err != nil {
+		return err
+	}
+
+	if err := validateMaxWithdrawPerBlock(p.MaxWithdrawPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateCommunityPoolRoutes(p.CommunityPoolRoutes); err != nil {
+		return err
+	}
+
+	if err := validateCommunityPoolDenomDestinations(p.CommunityPoolDenomDestinations); err != nil {
+		return err
+	}
+
+	if err := validateInfraFund(p.InfraFundRate, p.InfraFundModule); err != nil {
+		return err
+	}
+
+	if err := validateCommissionRebateRate(p.CommissionRebateRate); err != nil {
+		return err
+	}
+
+	if err := validateMinWithdrawAmount(p.MinWithdrawAmount, p.MaxWithdrawPerBlock); err != nil {
+		return err
+	}
+
+	return validateZeroPowerStrategy(p.ZeroPowerStrategy)
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -39,3 +158,285 @@ func validateCommunityTax(i interface{}) error {
 
 	return nil
 }
+
+func validateProposerReward(name string, i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset proposer reward disables it
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s proposer reward must be positive: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s proposer reward too large: %s", name, v)
+	}
+
+	return nil
+}
+
+func validateDecimalPoolFlushThreshold(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset threshold disables the automatic flush
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("decimal pool flush threshold must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset minimum applies the validators' rates as is
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min commission rate must not be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min commission rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateBurnRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset burn rate disables the burn
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("burn rate must not be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("burn rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinSelfBondForRewards(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset minimum rewards every validator
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min self bond for rewards must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateSubsidyFloor(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid subsidy floor: %w", err)
+	}
+
+	return nil
+}
+
+func validateDistributableDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid distributable denom: %w", err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate distributable denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateRoundingMode(i interface{}) error {
+	v, ok := i.(RoundingMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := RoundingMode_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown rounding mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateZeroPowerStrategy(i interface{}) error {
+	v, ok := i.(ZeroPowerStrategy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := ZeroPowerStrategy_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown zero power strategy: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxWithdrawPerBlock(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid max withdraw per block: %w", err)
+	}
+
+	return nil
+}
+
+func validateCommunityPoolRoutes(i interface{}) error {
+	v, ok := i.([]CommunityPoolRoute)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// no route adds the whole cut to the community pool
+	if len(v) == 0 {
+		return nil
+	}
+
+	total := math.LegacyZeroDec()
+	seen := make(map[string]bool, len(v))
+	for _, route := range v {
+		if route.Module == "" {
+			return fmt.Errorf("community pool route module cannot be empty")
+		}
+		if seen[route.Module] {
+			return fmt.Errorf("duplicate community pool route: %s", route.Module)
+		}
+		seen[route.Module] = true
+
+		if route.Weight.IsNil() || !route.Weight.IsPositive() {
+			return fmt.Errorf("community pool route weight must be positive: %s", route.Weight)
+		}
+		total = total.Add(route.Weight)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("community pool route weights must add up to one: %s", total)
+	}
+
+	return nil
+}
+
+func validateCommunityPoolDenomDestinations(i interface{}) error {
+	v, ok := i.([]CommunityPoolDenomDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, destination := range v {
+		if err := sdk.ValidateDenom(destination.Denom); err != nil {
+			return fmt.Errorf("invalid community pool destination denom: %w", err)
+		}
+		if seen[destination.Denom] {
+			return fmt.Errorf("duplicate community pool destination denom: %s", destination.Denom)
+		}
+		seen[destination.Denom] = true
+
+		if destination.Module == "" {
+			return fmt.Errorf("community pool destination module of %s cannot be empty", destination.Denom)
+		}
+	}
+
+	return nil
+}
+
+func validateInfraFund(rate math.LegacyDec, module string) error {
+	// an unset infra fund rate disables the infra fund
+	if rate.IsNil() || rate.IsZero() {
+		return nil
+	}
+	if rate.IsNegative() {
+		return fmt.Errorf("infra fund rate must not be negative: %s", rate)
+	}
+	if rate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("infra fund rate too large: %s", rate)
+	}
+	if module == "" {
+		return fmt.Errorf("infra fund module cannot be empty")
+	}
+	if module == ModuleName {
+		return fmt.Errorf("infra fund module cannot be the %s module", ModuleName)
+	}
+
+	return nil
+}
+
+func validateCommissionRebateRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset commission rebate rate disables the rebates
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("commission rebate rate must not be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("commission rebate rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinWithdrawAmount(minWithdraw, maxWithdraw sdk.Coins) error {
+	if err := minWithdraw.Validate(); err != nil {
+		return fmt.Errorf("invalid min withdraw amount: %w", err)
+	}
+
+	// a minimum above the cap of its denom would never be paid out
+	for _, coin := range minWithdraw {
+		limit := maxWithdraw.AmountOf(coin.Denom)
+		if limit.IsPositive() && coin.Amount.GT(limit) {
+			return fmt.Errorf("min withdraw amount %s exceeds the max withdraw per block %s", coin, sdk.NewCoin(coin.Denom, limit))
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,9 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/distribution/types"
+	pooltypes "cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParams_ValidateBasic(t *testing.T) {
@@ -47,3 +50,267 @@ func TestParams_ValidateBasic(t *testing.T) {
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
+
+func TestParams_ValidateBasicDecimalPoolFlushThreshold(t *testing.T) {
+	p := types.DefaultParams()
+
+	p.DecimalPoolFlushThreshold = sdkmath.Int{}
+	require.NoError(t, p.ValidateBasic())
+
+	p.DecimalPoolFlushThreshold = sdkmath.NewInt(10)
+	require.NoError(t, p.ValidateBasic())
+
+	p.DecimalPoolFlushThreshold = sdkmath.NewInt(-1)
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicMinCommissionRate(t *testing.T) {
+	p := types.DefaultParams()
+
+	p.MinCommissionRate = sdkmath.LegacyDec{}
+	require.NoError(t, p.ValidateBasic())
+
+	p.MinCommissionRate = sdkmath.LegacyOneDec()
+	require.NoError(t, p.ValidateBasic())
+
+	p.MinCommissionRate = sdkmath.LegacyMustNewDecFromStr("1.01")
+	require.Error(t, p.ValidateBasic())
+
+	p.MinCommissionRate = sdkmath.LegacyMustNewDecFromStr("-0.01")
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicBurnRate(t *testing.T) {
+	p := types.DefaultParams()
+
+	p.BurnRate = sdkmath.LegacyDec{}
+	require.NoError(t, p.ValidateBasic())
+
+	p.BurnRate = sdkmath.LegacyOneDec()
+	require.NoError(t, p.ValidateBasic())
+
+	p.BurnRate = sdkmath.LegacyMustNewDecFromStr("1.01")
+	require.Error(t, p.ValidateBasic())
+
+	p.BurnRate = sdkmath.LegacyMustNewDecFromStr("-0.01")
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicInfraFund(t *testing.T) {
+	p := types.DefaultParams()
+
+	p.InfraFundRate = sdkmath.LegacyDec{}
+	require.NoError(t, p.ValidateBasic())
+
+	// the module is required by a positive rate only
+	p.InfraFundRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.Error(t, p.ValidateBasic())
+
+	p.InfraFundModule = "infra"
+	require.NoError(t, p.ValidateBasic())
+
+	p.InfraFundRate = sdkmath.LegacyOneDec()
+	require.NoError(t, p.ValidateBasic())
+
+	p.InfraFundRate = sdkmath.LegacyMustNewDecFromStr("1.01")
+	require.Error(t, p.ValidateBasic())
+
+	p.InfraFundRate = sdkmath.LegacyMustNewDecFromStr("-0.01")
+	require.Error(t, p.ValidateBasic())
+
+	p.InfraFundRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	p.InfraFundModule = types.ModuleName
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicCommissionRebateRate(t *testing.T) {
+	p := types.DefaultParams()
+
+	p.CommissionRebateRate = sdkmath.LegacyDec{}
+	require.NoError(t, p.ValidateBasic())
+
+	p.CommissionRebateRate = sdkmath.LegacyOneDec()
+	require.NoError(t, p.ValidateBasic())
+
+	p.CommissionRebateRate = sdkmath.LegacyMustNewDecFromStr("1.01")
+	require.Error(t, p.ValidateBasic())
+
+	p.CommissionRebateRate = sdkmath.LegacyMustNewDecFromStr("-0.01")
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicMinSelfBondForRewards(t *testing.T) {
+	p := types.DefaultParams()
+
+	p.MinSelfBondForRewards = sdkmath.Int{}
+	require.NoError(t, p.ValidateBasic())
+
+	p.MinSelfBondForRewards = sdkmath.NewInt(1000000)
+	require.NoError(t, p.ValidateBasic())
+
+	p.MinSelfBondForRewards = sdkmath.NewInt(-1)
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicSubsidyFloor(t *testing.T) {
+	p := types.DefaultParams()
+
+	p.SubsidyFloor = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, p.ValidateBasic())
+
+	p.SubsidyFloor = sdk.Coins{sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 100)}
+	require.Error(t, p.ValidateBasic())
+
+	p.SubsidyFloor = sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.ZeroInt()}}
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicProposerReward(t *testing.T) {
+	toDec := sdkmath.LegacyMustNewDecFromStr
+
+	tests := []struct {
+		name    string
+		base    sdkmath.LegacyDec
+		bonus   sdkmath.LegacyDec
+		wantErr bool
+	}{
+		{"unset", sdkmath.LegacyDec{}, sdkmath.LegacyDec{}, false},
+		{"zero", toDec("0"), toDec("0"), false},
+		{"success", toDec("0.01"), toDec("0.04"), false},
+		{"negative base proposer reward", toDec("-0.01"), toDec("0"), true},
+		{"negative bonus proposer reward", toDec("0"), toDec("-0.01"), true},
+		{"base proposer reward greater than 1", toDec("1.1"), toDec("0"), true},
+		{"total sum greater than 1", toDec("0.5"), toDec("0.49"), true},
+		{"total sum equal to 1", toDec("0.5"), toDec("0.48"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := types.DefaultParams()
+			p.BaseProposerReward = tt.base
+			p.BonusProposerReward = tt.bonus
+			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParams_ValidateBasicDistributableDenoms(t *testing.T) {
+	p := types.DefaultParams()
+
+	p.DistributableDenoms = []string{"stake", "atom"}
+	require.NoError(t, p.ValidateBasic())
+
+	p.DistributableDenoms = []string{"stake", "stake"}
+	require.Error(t, p.ValidateBasic())
+
+	p.DistributableDenoms = []string{"1invalid"}
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicRoundingMode(t *testing.T) {
+	p := types.DefaultParams()
+	require.Equal(t, types.RoundingModeTruncate, p.RoundingMode)
+
+	p.RoundingMode = types.RoundingModeRound
+	require.NoError(t, p.ValidateBasic())
+
+	p.RoundingMode = types.RoundingMode(2)
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicZeroPowerStrategy(t *testing.T) {
+	p := types.DefaultParams()
+	require.Equal(t, types.ZeroPowerStrategyCommunityPool, p.ZeroPowerStrategy)
+
+	p.ZeroPowerStrategy = types.ZeroPowerStrategyDecimalPool
+	require.NoError(t, p.ValidateBasic())
+
+	p.ZeroPowerStrategy = types.ZeroPowerStrategyCarry
+	require.NoError(t, p.ValidateBasic())
+
+	p.ZeroPowerStrategy = types.ZeroPowerStrategy(3)
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicMaxWithdrawPerBlock(t *testing.T) {
+	p := types.DefaultParams()
+
+	p.MaxWithdrawPerBlock = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, p.ValidateBasic())
+
+	p.MaxWithdrawPerBlock = sdk.Coins{{Denom: "stake", Amount: sdkmath.NewInt(-1)}}
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicMinWithdrawAmount(t *testing.T) {
+	p := types.DefaultParams()
+
+	p.MinWithdrawAmount = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, p.ValidateBasic())
+
+	p.MinWithdrawAmount = sdk.Coins{{Denom: "stake", Amount: sdkmath.NewInt(-1)}}
+	require.Error(t, p.ValidateBasic())
+
+	// the minimum cannot exceed the cap of its denom
+	p.MinWithdrawAmount = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	p.MaxWithdrawPerBlock = sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 10))
+	require.NoError(t, p.ValidateBasic())
+
+	p.MaxWithdrawPerBlock = sdk.NewCoins(sdk.NewInt64Coin("stake", 99))
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicCommunityPoolRoutes(t *testing.T) {
+	p := types.DefaultParams()
+	require.Equal(t, []types.CommunityPoolRoute{{Module: pooltypes.ModuleName, Weight: sdkmath.LegacyOneDec()}}, p.CommunityPoolRoutes)
+
+	p.CommunityPoolRoutes = nil
+	require.NoError(t, p.ValidateBasic())
+
+	p.CommunityPoolRoutes = []types.CommunityPoolRoute{
+		{Module: pooltypes.ModuleName, Weight: sdkmath.LegacyNewDecWithPrec(7, 1)},
+		{Module: "treasury", Weight: sdkmath.LegacyNewDecWithPrec(3, 1)},
+	}
+	require.NoError(t, p.ValidateBasic())
+
+	// the weights must add up to one
+	p.CommunityPoolRoutes[1].Weight = sdkmath.LegacyNewDecWithPrec(2, 1)
+	require.Error(t, p.ValidateBasic())
+
+	p.CommunityPoolRoutes[1].Weight = sdkmath.LegacyZeroDec()
+	require.Error(t, p.ValidateBasic())
+
+	p.CommunityPoolRoutes[0].Weight = sdkmath.LegacyOneDec()
+	require.Error(t, p.ValidateBasic())
+
+	p.CommunityPoolRoutes = []types.CommunityPoolRoute{{Module: "", Weight: sdkmath.LegacyOneDec()}}
+	require.Error(t, p.ValidateBasic())
+
+	p.CommunityPoolRoutes = []types.CommunityPoolRoute{
+		{Module: "treasury", Weight: sdkmath.LegacyNewDecWithPrec(5, 1)},
+		{Module: "treasury", Weight: sdkmath.LegacyNewDecWithPrec(5, 1)},
+	}
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicCommunityPoolDenomDestinations(t *testing.T) {
+	p := types.DefaultParams()
+	require.Empty(t, p.CommunityPoolDenomDestinations)
+
+	p.CommunityPoolDenomDestinations = []types.CommunityPoolDenomDestination{
+		{Denom: "stake", Module: "treasury"},
+		{Denom: "usdc", Module: pooltypes.ModuleName},
+	}
+	require.NoError(t, p.ValidateBasic())
+
+	p.CommunityPoolDenomDestinations[1].Module = ""
+	require.Error(t, p.ValidateBasic())
+
+	p.CommunityPoolDenomDestinations[1] = types.CommunityPoolDenomDestination{Denom: "1usdc", Module: "treasury"}
+	require.Error(t, p.ValidateBasic())
+
+	// a denom can have a single destination
+	p.CommunityPoolDenomDestinations[1] = types.CommunityPoolDenomDestination{Denom: "stake", Module: "insurance"}
+	require.Error(t, p.ValidateBasic())
+}
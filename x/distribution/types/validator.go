@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,3 +35,61 @@ func NewValidatorSlashEvent(validatorPeriod uint64, fraction sdkmath.LegacyDec)
 		Fraction:        fraction,
 	}
 }
+
+// create a new ValidatorCommissionCap
+func NewValidatorCommissionCap(maxRate sdkmath.LegacyDec) ValidatorCommissionCap {
+	return ValidatorCommissionCap{
+		MaxRate: maxRate,
+	}
+}
+
+// ValidateCommissionCap validates the maximum commission rate of a validator,
+// which must be between zero and one.
+func ValidateCommissionCap(maxRate sdkmath.LegacyDec) error {
+	if maxRate.IsNil() || maxRate.IsNegative() || maxRate.GT(sdkmath.LegacyOneDec()) {
+		return errors.Wrapf(ErrInvalidCommissionCap, "max rate must be between 0 and 1: %s", maxRate)
+	}
+
+	return nil
+}
+
+// create a new ValidatorRewardMultiplier
+func NewValidatorRewardMultiplier(multiplier sdkmath.LegacyDec) ValidatorRewardMultiplier {
+	return ValidatorRewardMultiplier{
+		Multiplier: multiplier,
+	}
+}
+
+// ValidateRewardMultiplier validates the reward multiplier of a validator,
+// which must not be negative.
+func ValidateRewardMultiplier(multiplier sdkmath.LegacyDec) error {
+	if multiplier.IsNil() || multiplier.IsNegative() {
+		return errors.Wrapf(ErrInvalidRewardMultiplier, "multiplier must not be negative: %s", multiplier)
+	}
+
+	return nil
+}
+
+// create a new ValidatorCommissionExemption
+func NewValidatorCommissionExemption(denoms ...string) ValidatorCommissionExemption {
+	return ValidatorCommissionExemption{
+		Denoms: denoms,
+	}
+}
+
+// ValidateCommissionExemptDenoms validates the denoms exempt from the
+// commission of a validator, which must be valid and distinct.
+func ValidateCommissionExemptDenoms(denoms []string) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errors.Wrapf(ErrInvalidCommissionExempt, "invalid denom %q: %s", denom, err)
+		}
+		if seen[denom] {
+			return errors.Wrapf(ErrInvalidCommissionExempt, "duplicate denom %q", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
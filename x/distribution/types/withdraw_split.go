@@ -0,0 +1,71 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewWithdrawSplit returns a new WithdrawSplit between the given entries.
+func NewWithdrawSplit(entries ...WithdrawSplitEntry) WithdrawSplit {
+	return WithdrawSplit{Entries: entries}
+}
+
+// NewWithdrawSplitEntry returns a new WithdrawSplitEntry with an address and
+// its weight.
+func NewWithdrawSplitEntry(addr string, weight math.LegacyDec) WithdrawSplitEntry {
+	return WithdrawSplitEntry{Address: addr, Weight: weight}
+}
+
+// Validate checks that the split has at least one entry, that every address is
+// set and appears once and that the weights are positive and sum to one.
+func (s WithdrawSplit) Validate() error {
+	if len(s.Entries) == 0 {
+		return errorsmod.Wrap(ErrInvalidWithdrawSplit, "split must have at least one entry")
+	}
+
+	total := math.LegacyZeroDec()
+	seen := make(map[string]bool, len(s.Entries))
+	for _, entry := range s.Entries {
+		if entry.Address == "" {
+			return errorsmod.Wrap(ErrInvalidWithdrawSplit, "empty address")
+		}
+		if seen[entry.Address] {
+			return errorsmod.Wrapf(ErrInvalidWithdrawSplit, "duplicate address %s", entry.Address)
+		}
+		seen[entry.Address] = true
+
+		if entry.Weight.IsNil() || !entry.Weight.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidWithdrawSplit, "weight of %s must be positive: %s", entry.Address, entry.Weight)
+		}
+		total = total.Add(entry.Weight)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidWithdrawSplit, "weights must sum to one: %s", total)
+	}
+
+	return nil
+}
+
+// Split splits the coins between the entries proportionally to their weights.
+// The share of every entry but the first is truncated per denom, the first
+// entry receives the remainder, so that the shares always add up to coins. The
+// split must be valid.
+func (s WithdrawSplit) Split(coins sdk.Coins) []sdk.Coins {
+	shares := make([]sdk.Coins, len(s.Entries))
+	remainder := coins
+	for i := 1; i < len(s.Entries); i++ {
+		share := sdk.NewCoins()
+		for _, coin := range coins {
+			amount := math.LegacyNewDecFromInt(coin.Amount).MulTruncate(s.Entries[i].Weight).TruncateInt()
+			share = share.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+		shares[i] = share
+		remainder = remainder.Sub(share...)
+	}
+	shares[0] = remainder
+
+	return shares
+}
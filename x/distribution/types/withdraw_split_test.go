@@ -0,0 +1,167 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	splitAddr1 = sdk.AccAddress("split_address_1_____").String()
+	splitAddr2 = sdk.AccAddress("split_address_2_____").String()
+	splitAddr3 = sdk.AccAddress("split_address_3_____").String()
+)
+
+func TestWithdrawSplitValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		split  types.WithdrawSplit
+		expErr bool
+	}{
+		{"no entries", types.NewWithdrawSplit(), true},
+		{"single entry", types.NewWithdrawSplit(types.NewWithdrawSplitEntry(splitAddr1, math.LegacyOneDec())), false},
+		{
+			"two-way split",
+			types.NewWithdrawSplit(
+				types.NewWithdrawSplitEntry(splitAddr1, math.LegacyNewDecWithPrec(7, 1)),
+				types.NewWithdrawSplitEntry(splitAddr2, math.LegacyNewDecWithPrec(3, 1)),
+			),
+			false,
+		},
+		{
+			"weights below one",
+			types.NewWithdrawSplit(
+				types.NewWithdrawSplitEntry(splitAddr1, math.LegacyNewDecWithPrec(5, 1)),
+				types.NewWithdrawSplitEntry(splitAddr2, math.LegacyNewDecWithPrec(4, 1)),
+			),
+			true,
+		},
+		{
+			"weights above one",
+			types.NewWithdrawSplit(
+				types.NewWithdrawSplitEntry(splitAddr1, math.LegacyNewDecWithPrec(5, 1)),
+				types.NewWithdrawSplitEntry(splitAddr2, math.LegacyNewDecWithPrec(6, 1)),
+			),
+			true,
+		},
+		{
+			"zero weight",
+			types.NewWithdrawSplit(
+				types.NewWithdrawSplitEntry(splitAddr1, math.LegacyOneDec()),
+				types.NewWithdrawSplitEntry(splitAddr2, math.LegacyZeroDec()),
+			),
+			true,
+		},
+		{
+			"negative weight",
+			types.NewWithdrawSplit(
+				types.NewWithdrawSplitEntry(splitAddr1, math.LegacyNewDec(2)),
+				types.NewWithdrawSplitEntry(splitAddr2, math.LegacyNewDec(-1)),
+			),
+			true,
+		},
+		{"nil weight", types.NewWithdrawSplit(types.WithdrawSplitEntry{Address: splitAddr1}), true},
+		{"empty address", types.NewWithdrawSplit(types.NewWithdrawSplitEntry("", math.LegacyOneDec())), true},
+		{
+			"duplicate address",
+			types.NewWithdrawSplit(
+				types.NewWithdrawSplitEntry(splitAddr1, math.LegacyNewDecWithPrec(5, 1)),
+				types.NewWithdrawSplitEntry(splitAddr1, math.LegacyNewDecWithPrec(5, 1)),
+			),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.split.Validate()
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidWithdrawSplit)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWithdrawSplitSplit(t *testing.T) {
+	third := math.LegacyOneDec().QuoInt64(3)
+
+	testCases := []struct {
+		name      string
+		split     types.WithdrawSplit
+		coins     sdk.Coins
+		expShares []sdk.Coins
+	}{
+		{
+			"two-way split",
+			types.NewWithdrawSplit(
+				types.NewWithdrawSplitEntry(splitAddr1, math.LegacyNewDecWithPrec(7, 1)),
+				types.NewWithdrawSplitEntry(splitAddr2, math.LegacyNewDecWithPrec(3, 1)),
+			),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 100)),
+			[]sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("atom", 7), sdk.NewInt64Coin("stake", 70)),
+				sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin("stake", 30)),
+			},
+		},
+		{
+			"two-way split with dust",
+			types.NewWithdrawSplit(
+				types.NewWithdrawSplitEntry(splitAddr1, math.LegacyNewDecWithPrec(5, 1)),
+				types.NewWithdrawSplitEntry(splitAddr2, math.LegacyNewDecWithPrec(5, 1)),
+			),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 11)),
+			[]sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 6)),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+			},
+		},
+		{
+			"three-way split with dust",
+			types.NewWithdrawSplit(
+				types.NewWithdrawSplitEntry(splitAddr1, math.LegacyOneDec().Sub(third).Sub(third)),
+				types.NewWithdrawSplitEntry(splitAddr2, third),
+				types.NewWithdrawSplitEntry(splitAddr3, third),
+			),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("stake", 100)),
+			[]sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("stake", 34)),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+			},
+		},
+		{
+			"no coins",
+			types.NewWithdrawSplit(
+				types.NewWithdrawSplitEntry(splitAddr1, math.LegacyNewDecWithPrec(5, 1)),
+				types.NewWithdrawSplitEntry(splitAddr2, math.LegacyNewDecWithPrec(5, 1)),
+			),
+			sdk.NewCoins(),
+			[]sdk.Coins{sdk.NewCoins(), sdk.NewCoins()},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.split.Validate())
+
+			shares := tc.split.Split(tc.coins)
+			require.Len(t, shares, len(tc.expShares))
+
+			total := sdk.NewCoins()
+			for i, share := range shares {
+				require.True(t, tc.expShares[i].Equal(share), "share %d: expected %s, got %s", i, tc.expShares[i], share)
+				total = total.Add(share...)
+			}
+			require.Equal(t, tc.coins, total)
+		})
+	}
+}